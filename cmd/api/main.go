@@ -1,13 +1,25 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"log"
+	nethttp "net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	_ "agencia-viagens/docs" // Importa a documentação gerada
+	"agencia-viagens/internal/armazenamento"
+	"agencia-viagens/internal/auth"
 	"agencia-viagens/internal/config"
 	"agencia-viagens/internal/delivery/http"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/notificacao"
 	"agencia-viagens/internal/repository"
 	"agencia-viagens/internal/usecase"
 
@@ -67,14 +79,169 @@ func main() {
 	viagemRepo := repository.NewViagemRepository(db)
 	veiculoRepo := repository.NewVeiculoRepository(db)
 	motoristaRepo := repository.NewMotoristaRepository(db)
+	grupoViagemRepo := repository.NewGrupoViagemRepository(db)
+	politicaRepo := repository.NewPoliticaCancelamentoRepository(db)
+	tabelaPrecoRepo := repository.NewTabelaPrecoRepository(db)
+	feriadoRepo := repository.NewFeriadoRepository(db)
+	cotacaoRepo := repository.NewCotacaoRepository(db)
+	preReservaRepo := repository.NewPreReservaRepository(db)
+	eventoViagemRepo := repository.NewEventoViagemRepository(db)
+	registroViagemRepo := repository.NewRegistroViagemRepository(db)
+	despesaViagemRepo := repository.NewDespesaViagemRepository(db)
+	ordemManutencaoRepo := repository.NewOrdemManutencaoRepository(db)
+	planoManutencaoRepo := repository.NewPlanoManutencaoRepository(db)
+	documentoVeiculoRepo := repository.NewDocumentoVeiculoRepository(db)
+	indisponibilidadeVeiculoRepo := repository.NewIndisponibilidadeVeiculoRepository(db)
+	abastecimentoRepo := repository.NewAbastecimentoRepository(db)
+	comodidadeRepo := repository.NewComodidadeRepository(db)
+	custoFixoVeiculoRepo := repository.NewCustoFixoVeiculoRepository(db)
+	clienteRepo := repository.NewClienteRepository(db)
+	anexoRepo := repository.NewAnexoRepository(db)
+	modeloChecklistRepo := repository.NewModeloChecklistRepository(db)
+	inspecaoVeiculoRepo := repository.NewInspecaoVeiculoRepository(db)
+	lancamentoHorasRepo := repository.NewLancamentoHorasRepository(db)
+	txManager := repository.NewTransactionManager(db)
+
+	// Inicializa notificações: e-mail quando houver SMTP configurado, log caso contrário
+	notificador := notificacao.NewLogNotificador()
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		notificador = notificacao.NewSMTPNotificador(host, os.Getenv("SMTP_PORT"),
+			os.Getenv("SMTP_USER"), os.Getenv("SMTP_PASSWORD"), os.Getenv("SMTP_FROM"))
+	}
+
+	// Direção prevista acima da qual a viagem exige revezamento de motoristas
+	limiteRevezamento := domain.LimiteDirecaoSemRevezamentoPadrao
+	if valor := os.Getenv("LIMITE_DIRECAO_SEM_REVEZAMENTO"); valor != "" {
+		limiteRevezamento, err = time.ParseDuration(valor)
+		if err != nil {
+			log.Fatalf("LIMITE_DIRECAO_SEM_REVEZAMENTO inválido: %v", err)
+		}
+	}
+
+	// Prazo após o fim da viagem em que vencimentos de documentos, manutenção e CNH geram aviso
+	antecedenciaAviso := domain.AntecedenciaAvisoConformidadePadrao
+	if valor := os.Getenv("ANTECEDENCIA_AVISO_CONFORMIDADE"); valor != "" {
+		antecedenciaAviso, err = time.ParseDuration(valor)
+		if err != nil {
+			log.Fatalf("ANTECEDENCIA_AVISO_CONFORMIDADE inválido: %v", err)
+		}
+	}
+
+	// Jornadas diária e semanal dos motoristas além das quais o trabalho conta como hora extra
+	jornada := domain.JornadaTrabalhoPadrao()
+	if valor := os.Getenv("JORNADA_DIARIA"); valor != "" {
+		jornada.Diaria, err = time.ParseDuration(valor)
+		if err != nil || jornada.Diaria <= 0 {
+			log.Fatalf("JORNADA_DIARIA inválida: %q", valor)
+		}
+	}
+	if valor := os.Getenv("JORNADA_SEMANAL"); valor != "" {
+		jornada.Semanal, err = time.ParseDuration(valor)
+		if err != nil || jornada.Semanal <= 0 {
+			log.Fatalf("JORNADA_SEMANAL inválida: %q", valor)
+		}
+	}
+
+	// Queda do km/l em relação à mediana do veículo a partir da qual o consumo é anômalo (ex.: 0.2 para 20%)
+	toleranciaConsumo := domain.ToleranciaConsumoAnomaloPadrao
+	if valor := os.Getenv("TOLERANCIA_CONSUMO_ANOMALO"); valor != "" {
+		toleranciaConsumo, err = strconv.ParseFloat(valor, 64)
+		if err != nil || toleranciaConsumo <= 0 || toleranciaConsumo >= 1 {
+			log.Fatalf("TOLERANCIA_CONSUMO_ANOMALO inválida: %q", valor)
+		}
+	}
+
+	// Inicializa o armazenamento de anexos: bucket S3 (ou MinIO) quando ARMAZENAMENTO=s3, diretório local caso contrário
+	var arquivos armazenamento.Armazenamento
+	if os.Getenv("ARMAZENAMENTO") == "s3" {
+		arquivos, err = armazenamento.NewS3Armazenamento(armazenamento.ConfigS3{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Regiao:    os.Getenv("S3_REGIAO"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		})
+	} else {
+		diretorio := os.Getenv("ANEXOS_DIR")
+		if diretorio == "" {
+			diretorio = "./anexos"
+		}
+		arquivos, err = armazenamento.NewLocalArmazenamento(diretorio)
+	}
+	if err != nil {
+		log.Fatalf("Erro ao inicializar armazenamento de anexos: %v", err)
+	}
+
+	// Tamanho máximo de cada anexo, em bytes
+	tamanhoMaximoAnexo := domain.TamanhoMaximoAnexoPadrao
+	if valor := os.Getenv("ANEXO_TAMANHO_MAXIMO"); valor != "" {
+		tamanhoMaximoAnexo, err = strconv.ParseInt(valor, 10, 64)
+		if err != nil || tamanhoMaximoAnexo <= 0 {
+			log.Fatalf("ANEXO_TAMANHO_MAXIMO inválido: %q", valor)
+		}
+	}
+
+	// Validade dos links de download de anexos
+	validadeLinkAnexo := 15 * time.Minute
+	if valor := os.Getenv("ANEXO_VALIDADE_LINK"); valor != "" {
+		validadeLinkAnexo, err = time.ParseDuration(valor)
+		if err != nil {
+			log.Fatalf("ANEXO_VALIDADE_LINK inválido: %v", err)
+		}
+	}
+
+	// Chave que assina os links de download
+	chaveLinkAnexo := chaveOuAleatoria("ANEXO_CHAVE_LINK", os.Getenv("ANEXO_CHAVE_LINK"), env)
+
+	// Chave que assina os tokens de acesso
+	auth.DefinirChave(chaveOuAleatoria("JWT_SECRET", cfg.JWT.Secret, env))
+
+	// Credenciais do administrador; sem elas, o login ADMIN fica desabilitado
+	adminCPF, adminSenha := os.Getenv("ADMIN_CPF"), os.Getenv("ADMIN_SENHA")
+	if adminCPF == "" || adminSenha == "" {
+		log.Println("ADMIN_CPF ou ADMIN_SENHA não definida; login ADMIN desabilitado")
+	}
+	auth.DefinirAdmin(adminCPF, adminSenha)
 
 	// Inicializa casos de uso
-	viagemUseCase := usecase.NewViagemUseCase(viagemRepo, veiculoRepo, motoristaRepo)
-	veiculoUseCase := usecase.NewVeiculoUseCase(veiculoRepo)
+	viagemUseCase := usecase.NewViagemUseCase(viagemRepo, veiculoRepo, motoristaRepo, politicaRepo, cotacaoRepo, preReservaRepo, eventoViagemRepo, documentoVeiculoRepo, indisponibilidadeVeiculoRepo, txManager, limiteRevezamento, antecedenciaAviso)
+	veiculoUseCase := usecase.NewVeiculoUseCase(veiculoRepo, comodidadeRepo)
 	motoristaUseCase := usecase.NewMotoristaUseCase(motoristaRepo)
+	grupoViagemUseCase := usecase.NewGrupoViagemUseCase(grupoViagemRepo, viagemRepo, veiculoRepo, motoristaRepo, politicaRepo, eventoViagemRepo, txManager, viagemUseCase)
+	politicaUseCase := usecase.NewPoliticaCancelamentoUseCase(politicaRepo)
+	cotacaoUseCase := usecase.NewCotacaoUseCase(cotacaoRepo, tabelaPrecoRepo, feriadoRepo)
+	atribuicaoUseCase := usecase.NewAtribuicaoUseCase(viagemRepo, veiculoRepo, motoristaRepo, limiteRevezamento)
+	preReservaUseCase := usecase.NewPreReservaUseCase(preReservaRepo, viagemRepo, veiculoRepo, motoristaRepo, eventoViagemRepo, txManager, notificador, viagemUseCase)
+	bancoHorasUseCase := usecase.NewBancoHorasUseCase(lancamentoHorasRepo, motoristaRepo, viagemRepo, registroViagemRepo, jornada)
+	operacaoViagemUseCase := usecase.NewOperacaoViagemUseCase(viagemRepo, veiculoRepo, registroViagemRepo, eventoViagemRepo, inspecaoVeiculoRepo, txManager, bancoHorasUseCase)
+	despesaViagemUseCase := usecase.NewDespesaViagemUseCase(despesaViagemRepo, viagemRepo, motoristaRepo, anexoRepo)
+	manutencaoUseCase := usecase.NewManutencaoUseCase(ordemManutencaoRepo, planoManutencaoRepo, veiculoRepo, txManager)
+	documentoVeiculoUseCase := usecase.NewDocumentoVeiculoUseCase(documentoVeiculoRepo, veiculoRepo, txManager)
+	indisponibilidadeVeiculoUseCase := usecase.NewIndisponibilidadeVeiculoUseCase(indisponibilidadeVeiculoRepo, veiculoRepo, viagemRepo)
+	abastecimentoUseCase := usecase.NewAbastecimentoUseCase(abastecimentoRepo, veiculoRepo, motoristaRepo, viagemRepo, toleranciaConsumo)
+	comodidadeUseCase := usecase.NewComodidadeUseCase(comodidadeRepo)
+	custoVeiculoUseCase := usecase.NewCustoVeiculoUseCase(custoFixoVeiculoRepo, veiculoRepo, viagemRepo, despesaViagemRepo, abastecimentoRepo, ordemManutencaoRepo)
+	checklistUseCase := usecase.NewChecklistUseCase(modeloChecklistRepo, inspecaoVeiculoRepo, viagemRepo, veiculoRepo, manutencaoUseCase, txManager)
+	anexoUseCase := usecase.NewAnexoUseCase(anexoRepo, veiculoRepo, motoristaRepo, viagemRepo, clienteRepo, despesaViagemRepo, arquivos, tamanhoMaximoAnexo, chaveLinkAnexo, validadeLinkAnexo)
+
+	// Lança no banco de horas as viagens concluídas no check-out que ainda não estão nele
+	lancadas, err := bancoHorasUseCase.LancarViagensPendentes(context.Background())
+	if err != nil {
+		log.Fatalf("Erro ao lançar viagens no banco de horas: %v", err)
+	}
+	if lancadas > 0 {
+		log.Printf("%d viagens concluídas lançadas no banco de horas", lancadas)
+	}
+
+	// Cancelado em SIGINT/SIGTERM para encerrar a expiração e o servidor juntos
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Expira as pré-reservas vencidas em segundo plano
+	go preReservaUseCase.IniciarExpiracaoAutomatica(ctx, time.Minute)
 
 	// Inicializa handlers HTTP
-	handler := http.NewHandler(viagemUseCase, veiculoUseCase, motoristaUseCase)
+	handler := http.NewHandler(viagemUseCase, veiculoUseCase, motoristaUseCase, grupoViagemUseCase, politicaUseCase, cotacaoUseCase, atribuicaoUseCase, preReservaUseCase, operacaoViagemUseCase, despesaViagemUseCase, manutencaoUseCase, documentoVeiculoUseCase, indisponibilidadeVeiculoUseCase, abastecimentoUseCase, comodidadeUseCase, custoVeiculoUseCase, anexoUseCase, checklistUseCase, bancoHorasUseCase)
 
 	// Configura o router
 	router := gin.Default()
@@ -89,7 +256,41 @@ func main() {
 	addr := fmt.Sprintf("%s:%s", os.Getenv("SERVER_HOST"), os.Getenv("SERVER_PORT"))
 	log.Printf("Servidor iniciado em %s", addr)
 	log.Printf("Documentação Swagger disponível em http://%s/swagger/index.html", addr)
-	if err := router.Run(addr); err != nil {
-		log.Fatalf("Erro ao iniciar servidor: %v", err)
+	srv := &nethttp.Server{Addr: addr, Handler: router}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+			log.Fatalf("Erro ao iniciar servidor: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	log.Printf("Encerrando servidor...")
+
+	// Aguarda as requisições em andamento terminarem
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Fatalf("Erro ao encerrar servidor: %v", err)
+	}
+}
+
+// chaveOuAleatoria retorna a chave definida na variável de ambiente informada.
+// Sem ela, interrompe a inicialização em produção; nos demais ambientes, gera
+// uma chave aleatória, e o que ela assinou deixa de valer quando a aplicação
+// reinicia.
+func chaveOuAleatoria(variavel, valor, env string) []byte {
+	if valor != "" {
+		return []byte(valor)
+	}
+	if env == "production" {
+		log.Fatalf("%s é obrigatória em produção", variavel)
+	}
+
+	chave := make([]byte, 32)
+	if _, err := rand.Read(chave); err != nil {
+		log.Fatalf("Erro ao gerar chave aleatória para %s: %v", variavel, err)
 	}
+	log.Printf("%s não definida; usando chave aleatória", variavel)
+	return chave
 }
@@ -0,0 +1,100 @@
+package armazenamento
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrArquivoNaoEncontrado indica que não há arquivo gravado com a chave informada
+var ErrArquivoNaoEncontrado = errors.New("arquivo não encontrado no armazenamento")
+
+// Armazenamento grava e lê o conteúdo dos arquivos enviados ao sistema. A
+// chave identifica o arquivo e pode conter "/" para organizá-lo em pastas.
+type Armazenamento interface {
+	Salvar(ctx context.Context, chave string, conteudo []byte, contentType string) error
+	Abrir(ctx context.Context, chave string) (io.ReadCloser, error)
+	Remover(ctx context.Context, chave string) error
+}
+
+// localArmazenamento grava os arquivos em um diretório do servidor
+type localArmazenamento struct {
+	diretorio string
+}
+
+// NewLocalArmazenamento cria um armazenamento no diretório informado, criado
+// se ainda não existir
+func NewLocalArmazenamento(diretorio string) (Armazenamento, error) {
+	if err := os.MkdirAll(diretorio, 0o750); err != nil {
+		return nil, fmt.Errorf("erro ao criar diretório de armazenamento %s: %w", diretorio, err)
+	}
+	return &localArmazenamento{diretorio: diretorio}, nil
+}
+
+func (a *localArmazenamento) Salvar(_ context.Context, chave string, conteudo []byte, _ string) error {
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(caminho), 0o750); err != nil {
+		return err
+	}
+
+	// Grava em arquivo temporário e renomeia, para que uma leitura simultânea
+	// nunca encontre o arquivo pela metade
+	temporario, err := os.CreateTemp(filepath.Dir(caminho), ".envio-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temporario.Name())
+
+	if _, err := temporario.Write(conteudo); err != nil {
+		temporario.Close()
+		return err
+	}
+	if err := temporario.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temporario.Name(), caminho)
+}
+
+func (a *localArmazenamento) Abrir(_ context.Context, chave string) (io.ReadCloser, error) {
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return nil, err
+	}
+
+	arquivo, err := os.Open(caminho)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrArquivoNaoEncontrado
+	}
+	return arquivo, err
+}
+
+func (a *localArmazenamento) Remover(_ context.Context, chave string) error {
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(caminho); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// caminho converte a chave em caminho dentro do diretório, recusando chaves
+// que sairiam dele
+func (a *localArmazenamento) caminho(chave string) (string, error) {
+	relativo := filepath.Clean(filepath.FromSlash(chave))
+	if relativo == "." || filepath.IsAbs(relativo) || relativo == ".." ||
+		strings.HasPrefix(relativo, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("chave de armazenamento inválida: %q", chave)
+	}
+	return filepath.Join(a.diretorio, relativo), nil
+}
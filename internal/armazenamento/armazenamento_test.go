@@ -0,0 +1,93 @@
+package armazenamento
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalArmazenamentoCaminho(t *testing.T) {
+	diretorio := t.TempDir()
+	a := &localArmazenamento{diretorio: diretorio}
+
+	casos := []struct {
+		chave    string
+		caminho  string
+		invalida bool
+	}{
+		{chave: "veiculos/123/crlv.pdf", caminho: filepath.Join(diretorio, "veiculos", "123", "crlv.pdf")},
+		{chave: "veiculos/../motoristas/cnh.pdf", caminho: filepath.Join(diretorio, "motoristas", "cnh.pdf")},
+		{chave: "./foto.png", caminho: filepath.Join(diretorio, "foto.png")},
+		{chave: "", invalida: true},
+		{chave: ".", invalida: true},
+		{chave: "..", invalida: true},
+		{chave: "../fora.pdf", invalida: true},
+		{chave: "veiculos/../../fora.pdf", invalida: true},
+		{chave: "/etc/passwd", invalida: true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.chave, func(t *testing.T) {
+			caminho, err := a.caminho(c.chave)
+			if c.invalida {
+				if err == nil {
+					t.Errorf("caminho(%q) = %q, esperado erro", c.chave, caminho)
+				}
+				return
+			}
+			if err != nil || caminho != c.caminho {
+				t.Errorf("caminho(%q) = %q, %v, esperado %q", c.chave, caminho, err, c.caminho)
+			}
+		})
+	}
+}
+
+func TestLocalArmazenamento(t *testing.T) {
+	diretorio := t.TempDir()
+	a, err := NewLocalArmazenamento(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testarArmazenamento(t, a, "anexos/teste.txt")
+
+	if err := a.Salvar(context.Background(), "../fora.txt", []byte("x"), "text/plain"); err == nil {
+		t.Error("chave fora do diretório deveria ser recusada")
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(diretorio), "fora.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Error("arquivo gravado fora do diretório")
+	}
+}
+
+// testarArmazenamento grava, lê e remove um arquivo com a chave informada
+func testarArmazenamento(t *testing.T, a Armazenamento, chave string) {
+	t.Helper()
+	ctx := context.Background()
+	conteudo := []byte("conteúdo do anexo")
+
+	if err := a.Salvar(ctx, chave, conteudo, "text/plain"); err != nil {
+		t.Fatalf("Salvar: %v", err)
+	}
+
+	arquivo, err := a.Abrir(ctx, chave)
+	if err != nil {
+		t.Fatalf("Abrir: %v", err)
+	}
+	lido, err := io.ReadAll(arquivo)
+	arquivo.Close()
+	if err != nil || string(lido) != string(conteudo) {
+		t.Errorf("conteúdo lido = %q, %v, esperado %q", lido, err, conteudo)
+	}
+
+	if err := a.Remover(ctx, chave); err != nil {
+		t.Fatalf("Remover: %v", err)
+	}
+	if _, err := a.Abrir(ctx, chave); !errors.Is(err, ErrArquivoNaoEncontrado) {
+		t.Errorf("Abrir depois de remover = %v, esperado %v", err, ErrArquivoNaoEncontrado)
+	}
+	if err := a.Remover(ctx, chave); err != nil {
+		t.Errorf("remover arquivo inexistente: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package armazenamento
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ConfigS3 identifica o bucket de um serviço compatível com S3, como AWS S3 ou
+// MinIO. Endpoint inclui o esquema, por exemplo http://localhost:9000.
+type ConfigS3 struct {
+	Endpoint  string
+	Regiao    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// s3Armazenamento grava os arquivos em um bucket S3, endereçado por caminho
+// (endpoint/bucket/chave), com requisições assinadas pelo AWS Signature V4
+type s3Armazenamento struct {
+	cfg     ConfigS3
+	cliente *http.Client
+}
+
+// NewS3Armazenamento cria um armazenamento no bucket informado
+func NewS3Armazenamento(cfg ConfigS3) (Armazenamento, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("endpoint, bucket e credenciais do S3 são obrigatórios")
+	}
+	if _, err := url.Parse(cfg.Endpoint); err != nil {
+		return nil, fmt.Errorf("endpoint do S3 inválido: %w", err)
+	}
+	if cfg.Regiao == "" {
+		cfg.Regiao = "us-east-1"
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+
+	return &s3Armazenamento{
+		cfg:     cfg,
+		cliente: &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+func (a *s3Armazenamento) Salvar(ctx context.Context, chave string, conteudo []byte, contentType string) error {
+	req, err := a.requisicao(ctx, http.MethodPut, chave, conteudo)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := a.cliente.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao enviar arquivo ao S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return erroS3("enviar", resp)
+	}
+	return nil
+}
+
+func (a *s3Armazenamento) Abrir(ctx context.Context, chave string) (io.ReadCloser, error) {
+	req, err := a.requisicao(ctx, http.MethodGet, chave, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.cliente.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao baixar arquivo do S3: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrArquivoNaoEncontrado
+	default:
+		defer resp.Body.Close()
+		return nil, erroS3("baixar", resp)
+	}
+}
+
+func (a *s3Armazenamento) Remover(ctx context.Context, chave string) error {
+	req, err := a.requisicao(ctx, http.MethodDelete, chave, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.cliente.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao remover arquivo do S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// O S3 responde 204 mesmo quando o objeto não existe
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return erroS3("remover", resp)
+	}
+	return nil
+}
+
+// requisicao monta a requisição ao objeto e a assina
+func (a *s3Armazenamento) requisicao(ctx context.Context, metodo, chave string, corpo []byte) (*http.Request, error) {
+	caminho := "/" + a.cfg.Bucket + "/" + codificarCaminhoS3(chave)
+	req, err := http.NewRequestWithContext(ctx, metodo, a.cfg.Endpoint+caminho, bytes.NewReader(corpo))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(corpo))
+
+	a.assinar(req, caminho, corpo, time.Now().UTC())
+	return req, nil
+}
+
+// assinar adiciona à requisição os cabeçalhos do AWS Signature V4
+func (a *s3Armazenamento) assinar(req *http.Request, caminho string, corpo []byte, agora time.Time) {
+	data := agora.Format("20060102")
+	momento := agora.Format("20060102T150405Z")
+	hashCorpo := sha256Hex(corpo)
+
+	req.Header.Set("X-Amz-Date", momento)
+	req.Header.Set("X-Amz-Content-Sha256", hashCorpo)
+
+	cabecalhosAssinados := "host;x-amz-content-sha256;x-amz-date"
+	requisicaoCanonica := strings.Join([]string{
+		req.Method,
+		caminho,
+		"", // sem query string
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + hashCorpo,
+		"x-amz-date:" + momento,
+		"",
+		cabecalhosAssinados,
+		hashCorpo,
+	}, "\n")
+
+	escopo := data + "/" + a.cfg.Regiao + "/s3/aws4_request"
+	textoAssinado := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		momento,
+		escopo,
+		sha256Hex([]byte(requisicaoCanonica)),
+	}, "\n")
+
+	chave := hmacSHA256([]byte("AWS4"+a.cfg.SecretKey), data)
+	chave = hmacSHA256(chave, a.cfg.Regiao)
+	chave = hmacSHA256(chave, "s3")
+	chave = hmacSHA256(chave, "aws4_request")
+	assinatura := hex.EncodeToString(hmacSHA256(chave, textoAssinado))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.cfg.AccessKey, escopo, cabecalhosAssinados, assinatura))
+}
+
+// codificarCaminhoS3 codifica a chave como o S3 espera na requisição
+// canônica: tudo exceto letras, números, "-", "_", "." e "~" vira %XX,
+// preservando as barras
+func codificarCaminhoS3(chave string) string {
+	var b strings.Builder
+	for _, c := range []byte(chave) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(dados []byte) string {
+	soma := sha256.Sum256(dados)
+	return hex.EncodeToString(soma[:])
+}
+
+func hmacSHA256(chave []byte, texto string) []byte {
+	mac := hmac.New(sha256.New, chave)
+	mac.Write([]byte(texto))
+	return mac.Sum(nil)
+}
+
+// erroS3 descreve a resposta de erro do S3, que traz o código no corpo em XML
+func erroS3(operacao string, resp *http.Response) error {
+	corpo, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("erro ao %s arquivo no S3: %s: %s", operacao, resp.Status, strings.TrimSpace(string(corpo)))
+}
@@ -0,0 +1,44 @@
+package armazenamento
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestS3Armazenamento roda contra um bucket real (AWS S3 ou MinIO) configurado
+// pelas mesmas variáveis da aplicação e é ignorado sem S3_ENDPOINT
+func TestS3Armazenamento(t *testing.T) {
+	endpoint := os.Getenv("S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("S3_ENDPOINT não definido")
+	}
+
+	a, err := NewS3Armazenamento(ConfigS3{
+		Endpoint:  endpoint,
+		Regiao:    os.Getenv("S3_REGIAO"),
+		Bucket:    os.Getenv("S3_BUCKET"),
+		AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("S3_SECRET_KEY"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Espaço e acento exercitam a codificação da chave na assinatura
+	testarArmazenamento(t, a, fmt.Sprintf("teste/%d/comprovante ação.txt", time.Now().UnixNano()))
+}
+
+func TestCodificarCaminhoS3(t *testing.T) {
+	casos := map[string]string{
+		"veiculos/123/crlv.pdf": "veiculos/123/crlv.pdf",
+		"a b+c.txt":             "a%20b%2Bc.txt",
+		"ação~1.pdf":            "a%C3%A7%C3%A3o~1.pdf",
+	}
+	for chave, esperado := range casos {
+		if codificado := codificarCaminhoS3(chave); codificado != esperado {
+			t.Errorf("codificarCaminhoS3(%q) = %q, esperado %q", chave, codificado, esperado)
+		}
+	}
+}
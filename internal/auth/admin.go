@@ -0,0 +1,26 @@
+package auth
+
+import "crypto/subtle"
+
+// Credenciais do administrador, definidas na inicialização por DefinirAdmin.
+// Sem elas, nenhum token ADMIN é emitido.
+var admin struct {
+	cpf   string
+	senha string
+}
+
+// Define as credenciais com que o administrador obtém o token ADMIN
+func DefinirAdmin(cpf, senha string) {
+	admin.cpf = cpf
+	admin.senha = senha
+}
+
+// AutenticarAdmin confere o CPF e a senha informados com os do administrador
+func AutenticarAdmin(cpf, senha string) bool {
+	if admin.cpf == "" || admin.senha == "" {
+		return false
+	}
+	cpfOK := subtle.ConstantTimeCompare([]byte(cpf), []byte(admin.cpf)) == 1
+	senhaOK := subtle.ConstantTimeCompare([]byte(senha), []byte(admin.senha)) == 1
+	return cpfOK && senhaOK
+}
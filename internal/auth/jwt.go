@@ -7,7 +7,22 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtKey = []byte("sua_chave_secreta_supersegura") // Troque por uma chave forte em produção
+// Chave que assina e valida os tokens, definida na inicialização por DefinirChave
+var jwtKey []byte
+
+var ErrChaveNaoDefinida = errors.New("chave de assinatura dos tokens não definida")
+
+// Define a chave que assina e valida os tokens JWT
+func DefinirChave(chave []byte) {
+	jwtKey = chave
+}
+
+// Perfis de usuário
+const (
+	ProfileAdmin     = "ADMIN"
+	ProfileMotorista = "MOTORISTA"
+	ProfileCliente   = "CLIENTE"
+)
 
 // Claims personalizados
 // Profile pode ser: ADMIN, MOTORISTA, CLIENTE
@@ -21,6 +36,9 @@ type Claims struct {
 
 // Gera um token JWT
 func GenerateJWT(userID, name, profile string, duration time.Duration) (string, error) {
+	if len(jwtKey) == 0 {
+		return "", ErrChaveNaoDefinida
+	}
 	expirationTime := time.Now().Add(duration)
 	claims := &Claims{
 		UserID:  userID,
@@ -37,6 +55,9 @@ func GenerateJWT(userID, name, profile string, duration time.Duration) (string,
 
 // Valida e retorna os claims do token
 func ValidateJWT(tokenStr string) (*Claims, error) {
+	if len(jwtKey) == 0 {
+		return nil, ErrChaveNaoDefinida
+	}
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
 		return jwtKey, nil
@@ -88,7 +88,7 @@ func Load() (*Config, error) {
 
 	// Configurações JWT
 	jwtConfig := JWTConfig{
-		Secret:     getEnv("JWT_SECRET", "your-secret-key"),
+		Secret:     getEnv("JWT_SECRET", ""),
 		Expiration: getEnv("JWT_EXPIRATION", "24h"),
 	}
 
@@ -19,7 +19,7 @@ func NewJWTConfig() JWTConfig {
 	refreshExpiration, _ := time.ParseDuration(getEnv("JWT_REFRESH_EXPIRATION", "720h")) // 30 dias
 
 	return JWTConfig{
-		Secret:           getEnv("JWT_SECRET", "your-secret-key"),
+		Secret:           getEnv("JWT_SECRET", ""),
 		Expiration:       expiration,
 		RefreshExpiration: refreshExpiration,
 		Issuer:           getEnv("JWT_ISSUER", "agencia-viagens"),
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"agencia-viagens/internal/delivery/http/middleware"
+	"agencia-viagens/internal/delivery/http/model"
 	"agencia-viagens/internal/domain"
 	"agencia-viagens/internal/usecase"
 
@@ -15,22 +17,73 @@ type Handler struct {
 	viagemUseCase    *usecase.ViagemUseCase
 	veiculoUseCase   *usecase.VeiculoUseCase
 	motoristaUseCase *usecase.MotoristaUseCase
+
+	grupoViagemUseCase       *usecase.GrupoViagemUseCase
+	politicaUseCase          *usecase.PoliticaCancelamentoUseCase
+	cotacaoUseCase           *usecase.CotacaoUseCase
+	atribuicaoUseCase        *usecase.AtribuicaoUseCase
+	preReservaUseCase        *usecase.PreReservaUseCase
+	operacaoUseCase          *usecase.OperacaoViagemUseCase
+	despesaUseCase           *usecase.DespesaViagemUseCase
+	manutencaoUseCase        *usecase.ManutencaoUseCase
+	documentoUseCase         *usecase.DocumentoVeiculoUseCase
+	indisponibilidadeUseCase *usecase.IndisponibilidadeVeiculoUseCase
+	abastecimentoUseCase     *usecase.AbastecimentoUseCase
+	comodidadeUseCase        *usecase.ComodidadeUseCase
+	custoVeiculoUseCase      *usecase.CustoVeiculoUseCase
+	anexoUseCase             *usecase.AnexoUseCase
+	checklistUseCase         *usecase.ChecklistUseCase
+	bancoHorasUseCase        *usecase.BancoHorasUseCase
 }
 
 func NewHandler(
 	viagemUseCase *usecase.ViagemUseCase,
 	veiculoUseCase *usecase.VeiculoUseCase,
 	motoristaUseCase *usecase.MotoristaUseCase,
+	grupoViagemUseCase *usecase.GrupoViagemUseCase,
+	politicaUseCase *usecase.PoliticaCancelamentoUseCase,
+	cotacaoUseCase *usecase.CotacaoUseCase,
+	atribuicaoUseCase *usecase.AtribuicaoUseCase,
+	preReservaUseCase *usecase.PreReservaUseCase,
+	operacaoUseCase *usecase.OperacaoViagemUseCase,
+	despesaUseCase *usecase.DespesaViagemUseCase,
+	manutencaoUseCase *usecase.ManutencaoUseCase,
+	documentoUseCase *usecase.DocumentoVeiculoUseCase,
+	indisponibilidadeUseCase *usecase.IndisponibilidadeVeiculoUseCase,
+	abastecimentoUseCase *usecase.AbastecimentoUseCase,
+	comodidadeUseCase *usecase.ComodidadeUseCase,
+	custoVeiculoUseCase *usecase.CustoVeiculoUseCase,
+	anexoUseCase *usecase.AnexoUseCase,
+	checklistUseCase *usecase.ChecklistUseCase,
+	bancoHorasUseCase *usecase.BancoHorasUseCase,
 ) *Handler {
 	return &Handler{
-		viagemUseCase:    viagemUseCase,
-		veiculoUseCase:   veiculoUseCase,
-		motoristaUseCase: motoristaUseCase,
+		viagemUseCase:            viagemUseCase,
+		veiculoUseCase:           veiculoUseCase,
+		motoristaUseCase:         motoristaUseCase,
+		grupoViagemUseCase:       grupoViagemUseCase,
+		politicaUseCase:          politicaUseCase,
+		cotacaoUseCase:           cotacaoUseCase,
+		atribuicaoUseCase:        atribuicaoUseCase,
+		preReservaUseCase:        preReservaUseCase,
+		operacaoUseCase:          operacaoUseCase,
+		despesaUseCase:           despesaUseCase,
+		manutencaoUseCase:        manutencaoUseCase,
+		documentoUseCase:         documentoUseCase,
+		indisponibilidadeUseCase: indisponibilidadeUseCase,
+		abastecimentoUseCase:     abastecimentoUseCase,
+		comodidadeUseCase:        comodidadeUseCase,
+		custoVeiculoUseCase:      custoVeiculoUseCase,
+		anexoUseCase:             anexoUseCase,
+		checklistUseCase:         checklistUseCase,
+		bancoHorasUseCase:        bancoHorasUseCase,
 	}
 }
 
 func (h *Handler) InitRoutes(router *gin.Engine) {
 	api := router.Group("/api/v1")
+	// Identifica o usuário, quando houver token, para o histórico das viagens
+	api.Use(middleware.AuthOptional())
 
 	// Rota de login
 	api.POST("/auth/login", LoginHandler)
@@ -39,34 +92,192 @@ func (h *Handler) InitRoutes(router *gin.Engine) {
 	viagens := api.Group("/viagens")
 	{
 		viagens.POST("", h.CriarViagem)
+		viagens.POST("/cotacao", h.CotarViagem)
+		viagens.POST("/sugestoes", h.SugerirAtribuicoes)
+		viagens.GET("/cotacao/:id", h.BuscarCotacao)
 		viagens.GET("", h.ListarViagens)
 		viagens.GET("/:id", h.BuscarViagem)
+		viagens.GET("/:id/eventos", h.ListarEventosViagem)
+		viagens.POST("/:id/check-in", h.CheckInViagem)
+		viagens.POST("/:id/check-out", h.CheckOutViagem)
+		viagens.GET("/:id/registros", h.ListarRegistrosViagem)
+		viagens.POST("/:id/inspecoes", h.RegistrarInspecao)
+		viagens.GET("/:id/inspecoes", h.ListarInspecoes)
+		viagens.POST("/:id/despesas", h.RegistrarDespesa)
+		viagens.GET("/:id/despesas", h.ListarDespesasViagem)
+		viagens.GET("/:id/rentabilidade", h.RentabilidadeViagem)
 		viagens.PUT("/:id", h.AtualizarViagem)
+		viagens.POST("/:id/reagendar", h.ReagendarViagem)
 		viagens.DELETE("/:id", h.CancelarViagem)
 	}
 
+	// Rotas de Despesas de Viagem
+	despesas := api.Group("/despesas")
+	{
+		despesas.POST("/:id/aprovar", h.AprovarDespesa)
+		despesas.POST("/:id/rejeitar", h.RejeitarDespesa)
+		despesas.POST("/:id/reembolsar", h.ReembolsarDespesa)
+	}
+
+	// Rotas de Grupos de Viagem
+	grupos := api.Group("/grupos")
+	{
+		grupos.POST("", h.CriarGrupoViagem)
+		grupos.GET("", h.ListarGruposViagem)
+		grupos.GET("/:id", h.BuscarGrupoViagem)
+		grupos.POST("/:id/reagendar", h.ReagendarGrupoViagem)
+		grupos.DELETE("/:id", h.CancelarGrupoViagem)
+	}
+
+	// Rotas de Pré-reservas
+	preReservas := api.Group("/pre-reservas")
+	{
+		preReservas.POST("", h.CriarPreReserva)
+		preReservas.GET("", h.ListarPreReservas)
+		preReservas.GET("/:id", h.BuscarPreReserva)
+		preReservas.POST("/:id/confirmar", h.ConfirmarPreReserva)
+		preReservas.DELETE("/:id", h.LiberarPreReserva)
+	}
+
+	// Rotas de Políticas de Cancelamento
+	politicas := api.Group("/politicas-cancelamento")
+	{
+		politicas.POST("", h.CriarPoliticaCancelamento)
+		politicas.GET("", h.ListarPoliticasCancelamento)
+		politicas.GET("/:id", h.BuscarPoliticaCancelamento)
+		politicas.PUT("/:id", h.AtualizarPoliticaCancelamento)
+		politicas.DELETE("/:id", h.RemoverPoliticaCancelamento)
+	}
+
+	// Rotas de Tabelas de Preço
+	tabelas := api.Group("/tabelas-preco")
+	{
+		tabelas.POST("", h.CriarTabelaPreco)
+		tabelas.GET("", h.ListarTabelasPreco)
+		tabelas.GET("/:id", h.BuscarTabelaPreco)
+		tabelas.PUT("/:id", h.AtualizarTabelaPreco)
+		tabelas.DELETE("/:id", h.RemoverTabelaPreco)
+	}
+
+	// Rotas de Feriados
+	feriados := api.Group("/feriados")
+	{
+		feriados.POST("", h.CriarFeriado)
+		feriados.GET("", h.ListarFeriados)
+		feriados.DELETE("/:id", h.RemoverFeriado)
+	}
+
 	// Rotas de Veículos
 	veiculos := api.Group("/veiculos")
 	{
 		veiculos.POST("", h.CriarVeiculo)
+		veiculos.GET("/documentacao-vencida", h.ListarVeiculosDocumentacaoVencida)
+		veiculos.GET("/rentabilidade", h.RelatorioRentabilidadeFrota)
 		veiculos.GET("/:id", h.BuscarVeiculo)
 		veiculos.PUT("/:id", h.AtualizarVeiculo)
 		veiculos.DELETE("/:id", h.RemoverVeiculo)
+		veiculos.POST("/:id/manutencoes", h.AbrirOrdemManutencao)
+		veiculos.GET("/:id/manutencoes", h.HistoricoManutencao)
+		veiculos.POST("/:id/planos-manutencao", h.CriarPlanoManutencao)
+		veiculos.GET("/:id/planos-manutencao", h.ListarPlanosManutencao)
+		veiculos.POST("/:id/documentos", h.RegistrarDocumentoVeiculo)
+		veiculos.GET("/:id/documentos", h.ListarDocumentosVeiculo)
+		veiculos.POST("/:id/indisponibilidades", h.ProgramarIndisponibilidade)
+		veiculos.GET("/:id/indisponibilidades", h.ListarIndisponibilidades)
+		veiculos.POST("/:id/abastecimentos", h.RegistrarAbastecimento)
+		veiculos.GET("/:id/abastecimentos", h.ListarAbastecimentos)
+		veiculos.POST("/:id/custos-fixos", h.RegistrarCustoFixo)
+		veiculos.GET("/:id/custos-fixos", h.ListarCustosFixos)
 		veiculos.GET("/", middleware.AuthRequired(), h.ListarVeiculos)
 	}
 
+	// Rotas de Manutenção
+	manutencoes := api.Group("/manutencoes")
+	{
+		manutencoes.GET("/:id", h.BuscarOrdemManutencao)
+		manutencoes.POST("/:id/iniciar", h.IniciarOrdemManutencao)
+		manutencoes.POST("/:id/concluir", h.ConcluirOrdemManutencao)
+		manutencoes.POST("/:id/cancelar", h.CancelarOrdemManutencao)
+	}
+	api.DELETE("/planos-manutencao/:id", h.RemoverPlanoManutencao)
+
+	// Rotas de Documentos de Veículos
+	documentos := api.Group("/documentos-veiculo")
+	{
+		documentos.GET("/:id", h.BuscarDocumentoVeiculo)
+		documentos.PUT("/:id", h.AtualizarDocumentoVeiculo)
+		documentos.DELETE("/:id", h.RemoverDocumentoVeiculo)
+	}
+
+	api.DELETE("/indisponibilidades-veiculo/:id", h.RemoverIndisponibilidade)
+	api.DELETE("/custos-fixos-veiculo/:id", h.RemoverCustoFixo)
+
+	// Rotas de Abastecimentos
+	abastecimentos := api.Group("/abastecimentos")
+	{
+		abastecimentos.POST("/importar", h.ImportarExtratoCombustivel)
+		abastecimentos.GET("/consumo", h.RelatorioConsumo)
+		abastecimentos.DELETE("/:id", h.RemoverAbastecimento)
+	}
+
+	// Rotas do catálogo de comodidades
+	comodidades := api.Group("/comodidades")
+	{
+		comodidades.POST("", h.CriarComodidade)
+		comodidades.GET("", h.ListarComodidades)
+		comodidades.DELETE("/:id", h.RemoverComodidade)
+	}
+
+	// Rotas dos checklists de inspeção de saída
+	checklists := api.Group("/checklists")
+	{
+		checklists.GET("", h.ListarModelosChecklist)
+		checklists.GET("/:tipo", h.BuscarModeloChecklist)
+		checklists.PUT("/:tipo", h.DefinirModeloChecklist)
+		checklists.DELETE("/:tipo", h.RemoverModeloChecklist)
+	}
+
+	// Rotas de Anexos. O download é autorizado pela assinatura do link.
+	anexos := api.Group("/anexos")
+	{
+		anexos.POST("", middleware.AuthRequired(), h.EnviarAnexo)
+		anexos.GET("", middleware.AuthRequired(), h.ListarAnexos)
+		anexos.GET("/:id/link", middleware.AuthRequired(), h.GerarLinkAnexo)
+		anexos.GET("/:id/download", h.BaixarAnexo)
+		anexos.DELETE("/:id", middleware.AuthRequired(), h.RemoverAnexo)
+	}
+
 	// Rotas de Motoristas
 	motoristas := api.Group("/motoristas")
 	{
 		motoristas.POST("", h.CriarMotorista)
 		motoristas.GET("", h.ListarMotoristas)
+		motoristas.GET("/banco-horas-excedido", h.ListarBancoHorasExcedido)
 		motoristas.GET("/:id", h.BuscarMotorista)
+		motoristas.GET("/:id/reembolsos", h.ResumoReembolsoMotorista)
+		motoristas.GET("/:id/banco-horas", h.ExtratoBancoHoras)
+		motoristas.POST("/:id/banco-horas/ajustes", middleware.AuthRequired(), h.AjustarBancoHoras)
+		motoristas.POST("/:id/banco-horas/compensacoes", h.CompensarBancoHoras)
 		motoristas.PUT("/:id", h.AtualizarMotorista)
 		motoristas.DELETE("/:id", h.RemoverMotorista)
 	}
 }
 
 // Handlers de Viagem
+// @Summary      Cria uma viagem
+// @Description  Com auto_atribuir=true, veiculo_id e motorista_id podem ser omitidos e são preenchidos com a melhor sugestão disponível. Viagens cuja direção prevista (direcao_prevista_minutos, ou todo o período se omitida) excede o limite sem revezamento exigem motorista_secundario_id. Viagens que desrespeitam o tempo de direção e descanso dos motoristas (Lei 13.103) só são aceitas com justificativa_jornada informada por um ADMIN.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        auto_atribuir query bool false "Atribui veículo e motorista automaticamente"
+// @Param        viagem body domain.Viagem true "Dados da viagem"
+// @Success      201 {object} domain.Viagem
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      403 {object} map[string]string "Liberação da jornada restrita a ADMIN"
+// @Failure      409 {object} map[string]string "Sem veículo ou motorista disponível"
+// @Failure      422 {object} map[string]interface{} "Violações das regras de jornada do motorista ou pendências de documentação, manutenção ou CNH"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens [post]
 func (h *Handler) CriarViagem(c *gin.Context) {
 	var viagem domain.Viagem
 	if err := c.ShouldBindJSON(&viagem); err != nil {
@@ -74,22 +285,85 @@ func (h *Handler) CriarViagem(c *gin.Context) {
 		return
 	}
 
+	if c.Query("auto_atribuir") == "true" {
+		if err := h.atribuicaoUseCase.AtribuirMelhor(c.Request.Context(), &viagem); err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, usecase.ErrNenhumaAtribuicaoPossivel):
+				status = http.StatusConflict
+			case errors.Is(err, usecase.ErrDataInvalida):
+				status = http.StatusBadRequest
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	if err := h.viagemUseCase.Criar(c.Request.Context(), &viagem); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+
+		var domainErr *domain.DomainError
+		switch {
+		case errors.Is(err, usecase.ErrCotacaoNaoEncontrada):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrCotacaoExpirada), errors.Is(err, domain.ErrCotacaoJaConvertida):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.As(err, &domainErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
 	c.JSON(http.StatusCreated, viagem)
 }
 
+// @Summary      Busca viagens
+// @Description  Retorna uma página de viagens filtradas e o total encontrado. O período seleciona as viagens que ocupam qualquer parte dele; origem e destino buscam por trecho do texto.
+// @Tags         viagens
+// @Produce      json
+// @Param        offset       query int    false "Deslocamento" default(0)
+// @Param        limit        query int    false "Tamanho da página (máx. 100)" default(20)
+// @Param        status       query string false "Status da viagem"
+// @Param        data_inicio  query string false "Início do período (RFC 3339)"
+// @Param        data_fim     query string false "Fim do período (RFC 3339)"
+// @Param        veiculo_id   query string false "ID do veículo" format(uuid)
+// @Param        motorista_id query string false "ID do motorista" format(uuid)
+// @Param        cliente_id   query string false "ID do cliente" format(uuid)
+// @Param        origem       query string false "Trecho da origem"
+// @Param        destino      query string false "Trecho do destino"
+// @Param        ordenar_por  query string false "Campo de ordenação" Enums(data_inicio, data_fim, valor, status, created_at) default(data_inicio)
+// @Param        ordem        query string false "Direção da ordenação" Enums(asc, desc) default(desc)
+// @Success      200 {object} model.ListViagensResponse
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens [get]
 func (h *Handler) ListarViagens(c *gin.Context) {
-	viagens, err := h.viagemUseCase.Listar(c.Request.Context())
+	var params model.ViagemQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	viagens, total, err := h.viagemUseCase.Listar(c.Request.Context(), params.ToFiltro())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		status := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrDataInvalida) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, viagens)
+	c.JSON(http.StatusOK, model.NewListViagensResponse(viagens, total))
 }
 
 func (h *Handler) BuscarViagem(c *gin.Context) {
@@ -115,21 +389,138 @@ func (h *Handler) AtualizarViagem(c *gin.Context) {
 		return
 	}
 
-	var viagem domain.Viagem
-	if err := c.ShouldBindJSON(&viagem); err != nil {
+	var req model.UpdateViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	viagem.ID = id
-	if err := h.viagemUseCase.Atualizar(c.Request.Context(), &viagem); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	viagem, err := h.viagemUseCase.Atualizar(c.Request.Context(), req.ToDomain(id))
+	if err != nil {
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+
+		var domainErr *domain.DomainError
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, usecase.ErrViagemNaoEncontrada):
+			status = http.StatusNotFound
+		case errors.As(err, &domainErr):
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, viagem)
 }
 
+// @Summary      Reagenda uma viagem
+// @Description  Move a viagem para o novo período mantendo veículo e motorista. Se algum deles estiver ocupado, responde 409 com as viagens e pré-reservas conflitantes, os veículos e motoristas livres no novo período e os horários livres mais próximos para os recursos originais.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Param        periodo body model.ReagendarViagemRequest true "Novo período"
+// @Success      200 {object} model.ViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      403 {object} map[string]string "Liberação da jornada restrita a ADMIN"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      409 {object} model.ConflitoReagendamentoResponse "Recursos ocupados no novo período"
+// @Failure      422 {object} map[string]interface{} "Violações das regras de jornada do motorista ou pendências de documentação, manutenção ou CNH"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/reagendar [post]
+func (h *Handler) ReagendarViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.ReagendarViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	viagem, err := h.viagemUseCase.Reagendar(c.Request.Context(), id, req.DataInicio, req.DataFim, req.JustificativaJornada)
+	if err != nil {
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+
+		var conflito *domain.ConflitoReagendamento
+		var domainErr *domain.DomainError
+		switch {
+		case errors.As(err, &conflito):
+			c.JSON(http.StatusConflict, model.NewConflitoReagendamentoResponse(conflito))
+		case errors.Is(err, usecase.ErrViagemNaoEncontrada):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, usecase.ErrDataInvalida), errors.As(err, &domainErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewViagemResponse(viagem))
+}
+
+// responderErroJornada responde às violações das regras de jornada do
+// motorista. Retorna falso se o erro for de outro tipo.
+func responderErroJornada(c *gin.Context, err error) bool {
+	var erroJornada *domain.ErroJornada
+	switch {
+	case errors.As(err, &erroJornada):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "violacoes": erroJornada.Violacoes})
+	case errors.Is(err, usecase.ErrLiberacaoJornadaNaoPermitida):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
+
+// responderErroConformidade responde aos impedimentos de documentação,
+// manutenção ou CNH do veículo e dos motoristas. Retorna falso se o erro for
+// de outro tipo.
+func responderErroConformidade(c *gin.Context, err error) bool {
+	var erroConformidade *domain.ErroConformidade
+	if !errors.As(err, &erroConformidade) {
+		return false
+	}
+	c.JSON(http.StatusUnprocessableEntity, gin.H{
+		"error":     err.Error(),
+		"bloqueios": erroConformidade.Bloqueios,
+		"avisos":    erroConformidade.Avisos,
+	})
+	return true
+}
+
+// @Summary      Cancela uma viagem
+// @Description  Cancela a viagem agendada e calcula a taxa e o reembolso pela política de cancelamento do cliente. A viagem em andamento é encerrada pelo check-out.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Param        cancelamento body model.CancelarViagemRequest true "Motivo do cancelamento"
+// @Success      200 {object} model.ViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id} [delete]
 func (h *Handler) CancelarViagem(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -137,26 +528,93 @@ func (h *Handler) CancelarViagem(c *gin.Context) {
 		return
 	}
 
-	if err := h.viagemUseCase.Cancelar(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	var req model.CancelarViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	viagem, err := h.viagemUseCase.Cancelar(c.Request.Context(), id, req.Motivo)
+	if err != nil {
+		var domainErr *domain.DomainError
+		switch {
+		case errors.Is(err, usecase.ErrViagemNaoEncontrada):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.As(err, &domainErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewViagemResponse(viagem))
+}
+
+// @Summary      Histórico de uma viagem
+// @Description  Retorna os eventos da viagem em ordem cronológica, com o autor e os valores antes e depois de cada alteração
+// @Tags         viagens
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Success      200 {array}  model.EventoViagemResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/eventos [get]
+func (h *Handler) ListarEventosViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	eventos, err := h.viagemUseCase.Eventos(c.Request.Context(), id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrViagemNaoEncontrada) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.EventoViagemResponse, len(eventos))
+	for i, e := range eventos {
+		response[i] = model.NewEventoViagemResponse(e)
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // Handlers de Veículo
-// @Summary      Lista todos os veículos
-// @Description  Retorna a lista de veículos cadastrados
+// @Summary      Lista os veículos
+// @Description  Retorna uma página de veículos filtrados por status, tipo e comodidades. Com comodidades, retorna apenas os veículos que oferecem todas as informadas.
 // @Tags         veiculos
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        offset      query int    false "Deslocamento" default(0)
+// @Param        limit       query int    false "Tamanho da página (máx. 100)" default(100)
+// @Param        status      query string false "Status do veículo"
+// @Param        tipo        query string false "Tipo do veículo"
+// @Param        comodidades query string false "Códigos das comodidades, separados por vírgula"
 // @Success      200 {array}  domain.Veiculo
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
 // @Failure      500 {object} map[string]string "Erro interno"
 // @Router       /veiculos [get]
 func (h *Handler) ListarVeiculos(c *gin.Context) {
-	veiculos, err := h.veiculoUseCase.Listar(c.Request.Context())
+	var params model.VeiculoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	veiculos, err := h.veiculoUseCase.Listar(c.Request.Context(), params.ToFiltro())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -193,13 +651,14 @@ func (h *Handler) BuscarVeiculo(c *gin.Context) {
 }
 
 // @Summary      Cria um novo veículo
-// @Description  Cadastra um novo veículo no sistema
+// @Description  Cadastra um novo veículo no sistema. A placa é aceita no padrão antigo ou Mercosul, com ou sem hífen, e gravada em maiúsculas sem hífen. RENAVAM e chassi são conferidos pelo dígito verificador, e o fabricante e o ano-modelo do chassi devem corresponder à marca e ao ano informados; erros de validação indicam o campo.
 // @Tags         veiculos
 // @Accept       json
 // @Produce      json
 // @Param        veiculo body domain.Veiculo true "Dados do veículo"
 // @Success      201 {object} domain.Veiculo
 // @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Placa já cadastrada"
 // @Failure      500 {object} map[string]string "Erro interno"
 // @Router       /veiculos [post]
 func (h *Handler) CriarVeiculo(c *gin.Context) {
@@ -210,7 +669,7 @@ func (h *Handler) CriarVeiculo(c *gin.Context) {
 	}
 
 	if err := h.veiculoUseCase.Criar(c.Request.Context(), &veiculo); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		responderErroVeiculo(c, err)
 		return
 	}
 
@@ -227,6 +686,7 @@ func (h *Handler) CriarVeiculo(c *gin.Context) {
 // @Success      200 {object} domain.Veiculo
 // @Failure      400 {object} map[string]string "Dados inválidos"
 // @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      409 {object} map[string]string "Placa já cadastrada"
 // @Failure      500 {object} map[string]string "Erro interno"
 // @Router       /veiculos/{id} [put]
 func (h *Handler) AtualizarVeiculo(c *gin.Context) {
@@ -244,7 +704,7 @@ func (h *Handler) AtualizarVeiculo(c *gin.Context) {
 
 	veiculo.ID = id
 	if err := h.veiculoUseCase.Atualizar(c.Request.Context(), &veiculo); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		responderErroVeiculo(c, err)
 		return
 	}
 
@@ -270,13 +730,41 @@ func (h *Handler) RemoverVeiculo(c *gin.Context) {
 	}
 
 	if err := h.veiculoUseCase.Remover(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(statusErroVeiculo(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// responderErroVeiculo responde ao erro do cadastro de veículo, indicando o
+// campo quando a validação é de um campo específico
+func responderErroVeiculo(c *gin.Context, err error) {
+	var erroCampo *domain.ErroCampo
+	if errors.As(err, &erroCampo) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "campo": erroCampo.Campo})
+		return
+	}
+	c.JSON(statusErroVeiculo(err), gin.H{"error": err.Error()})
+}
+
+func statusErroVeiculo(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrPlacaJaCadastrada):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrPlacaInvalida),
+		errors.Is(err, usecase.ErrCapacidadeInvalida),
+		errors.Is(err, usecase.ErrComodidadeDesconhecida),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // Handlers de Motorista
 func (h *Handler) CriarMotorista(c *gin.Context) {
 	var motorista domain.Motorista
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Registra um abastecimento do veículo
+// @Description  Lança litros, preço, odômetro e posto do abastecimento. Sem viagem informada, o abastecimento é vinculado à viagem do veículo em curso na data e, sem motorista, ao motorista principal dela. Sem tanque_cheio, considera que o tanque foi completado.
+// @Tags         abastecimentos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Param        abastecimento body model.RegistrarAbastecimentoRequest true "Dados do abastecimento"
+// @Success      201 {object} model.AbastecimentoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo, motorista ou viagem não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/abastecimentos [post]
+func (h *Handler) RegistrarAbastecimento(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistrarAbastecimentoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	abastecimento := req.ToDomain(id)
+	if err := h.abastecimentoUseCase.Registrar(c.Request.Context(), abastecimento); err != nil {
+		c.JSON(statusErroAbastecimento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewAbastecimentoResponse(abastecimento))
+}
+
+// @Summary      Lista os abastecimentos do veículo
+// @Tags         abastecimentos
+// @Produce      json
+// @Param        id          path  string true "ID do veículo" format(uuid)
+// @Param        data_inicio query string true "Início do período (RFC 3339)"
+// @Param        data_fim    query string true "Fim do período (RFC 3339)"
+// @Success      200 {array}  model.AbastecimentoResponse
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/abastecimentos [get]
+func (h *Handler) ListarAbastecimentos(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var params model.PeriodoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	abastecimentos, err := h.abastecimentoUseCase.Listar(c.Request.Context(), id, params.DataInicio, params.DataFim)
+	if err != nil {
+		c.JSON(statusErroAbastecimento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.AbastecimentoResponse, len(abastecimentos))
+	for i, a := range abastecimentos {
+		response[i] = model.NewAbastecimentoResponse(a)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Remove um abastecimento
+// @Tags         abastecimentos
+// @Param        id path string true "ID do abastecimento" format(uuid)
+// @Success      204 "Abastecimento removido"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Abastecimento não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /abastecimentos/{id} [delete]
+func (h *Handler) RemoverAbastecimento(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.abastecimentoUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroAbastecimento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      Importa o extrato do cartão combustível
+// @Description  Lança os abastecimentos de um CSV separado por vírgula ou ponto e vírgula. O cabeçalho deve trazer data, placa, litros, odometro e preco_litro ou valor_total; posto, cpf_motorista e tanque_cheio (S/N) são opcionais. Linhas já lançadas (mesmo veículo, data e odômetro) são ignoradas e linhas com erro são relatadas sem interromper a importação.
+// @Tags         abastecimentos
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        arquivo formData file true "Extrato em CSV"
+// @Success      200 {object} domain.ResultadoImportacao
+// @Failure      400 {object} map[string]string "Arquivo inválido"
+// @Failure      413 {object} map[string]string "Arquivo muito grande"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /abastecimentos/importar [post]
+func (h *Handler) ImportarExtratoCombustivel(c *gin.Context) {
+	limitarUpload(c, usecase.TamanhoMaximoExtrato)
+
+	arquivo, err := c.FormFile("arquivo")
+	if err != nil {
+		if uploadExcedido(err) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": usecase.ErrExtratoMuitoGrande.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "arquivo do extrato é obrigatório"})
+		return
+	}
+
+	if arquivo.Size > usecase.TamanhoMaximoExtrato {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": usecase.ErrExtratoMuitoGrande.Error()})
+		return
+	}
+
+	extrato, err := arquivo.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer extrato.Close()
+
+	resultado, err := h.abastecimentoUseCase.Importar(c.Request.Context(), extrato)
+	if err != nil {
+		c.JSON(statusErroAbastecimento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resultado)
+}
+
+// @Summary      Relatório de consumo de combustível
+// @Description  Calcula o km/l do período por veículo, motorista e rota pelo método de tanque cheio a tanque cheio. Trechos com km/l abaixo da mediana do veículo além da tolerância configurada são apontados como anomalias, indicando desvio de combustível ou problema mecânico.
+// @Tags         abastecimentos
+// @Produce      json
+// @Param        data_inicio query string true  "Início do período (RFC 3339)"
+// @Param        data_fim    query string true  "Fim do período (RFC 3339)"
+// @Param        veiculo_id  query string false "Restringe a um veículo" format(uuid)
+// @Success      200 {object} domain.RelatorioConsumo
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /abastecimentos/consumo [get]
+func (h *Handler) RelatorioConsumo(c *gin.Context) {
+	var params model.ConsumoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	relatorio, err := h.abastecimentoUseCase.RelatorioConsumo(c.Request.Context(), params.Veiculo(),
+		params.DataInicio, params.DataFim)
+	if err != nil {
+		c.JSON(statusErroAbastecimento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, relatorio)
+}
+
+func statusErroAbastecimento(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrMotoristaNaoEncontrado),
+		errors.Is(err, usecase.ErrViagemNaoEncontrada),
+		errors.Is(err, usecase.ErrAbastecimentoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrExtratoMuitoGrande):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, usecase.ErrExtratoInvalido),
+		errors.Is(err, usecase.ErrDataInvalida),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,245 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Envia um anexo
+// @Description  Anexa um arquivo (CNH, CRLV, contrato, comprovante, foto) a um veículo, motorista, viagem ou cliente. Aceita PDF, JPEG e PNG, identificados pelo conteúdo, até o tamanho máximo configurado.
+// @Tags         anexos
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entidade    formData string true "Cadastro dono do anexo" Enums(VEICULO, MOTORISTA, VIAGEM, CLIENTE)
+// @Param        entidade_id formData string true "ID do cadastro" format(uuid)
+// @Param        tipo        formData string true "Tipo do anexo" Enums(CNH, CRLV, CONTRATO, COMPROVANTE, FOTO, OUTRO)
+// @Param        arquivo     formData file   true "Arquivo"
+// @Success      201 {object} domain.Anexo
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      401 {object} map[string]string "Não autenticado"
+// @Failure      404 {object} map[string]string "Cadastro não encontrado"
+// @Failure      413 {object} map[string]string "Arquivo muito grande"
+// @Failure      415 {object} map[string]string "Formato não permitido"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /anexos [post]
+func (h *Handler) EnviarAnexo(c *gin.Context) {
+	limitarUpload(c, h.anexoUseCase.TamanhoMaximo())
+
+	var form model.EnviarAnexoForm
+	if err := c.ShouldBind(&form); err != nil {
+		if uploadExcedido(err) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": usecase.ErrAnexoMuitoGrande.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	arquivo, err := c.FormFile("arquivo")
+	if err != nil {
+		if uploadExcedido(err) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": usecase.ErrAnexoMuitoGrande.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "arquivo é obrigatório"})
+		return
+	}
+
+	conteudo, err := arquivo.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer conteudo.Close()
+
+	anexo := form.ToDomain(arquivo.Filename)
+	if err := h.anexoUseCase.Enviar(c.Request.Context(), anexo, conteudo); err != nil {
+		c.JSON(statusErroAnexo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, anexo)
+}
+
+// @Summary      Lista os anexos de um cadastro
+// @Tags         anexos
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entidade    query string true "Cadastro dono dos anexos" Enums(VEICULO, MOTORISTA, VIAGEM, CLIENTE)
+// @Param        entidade_id query string true "ID do cadastro" format(uuid)
+// @Success      200 {array}  domain.Anexo
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      401 {object} map[string]string "Não autenticado"
+// @Failure      404 {object} map[string]string "Cadastro não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /anexos [get]
+func (h *Handler) ListarAnexos(c *gin.Context) {
+	var params model.AnexoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	anexos, err := h.anexoUseCase.Listar(c.Request.Context(),
+		domain.EntidadeAnexo(params.Entidade), uuid.MustParse(params.EntidadeID))
+	if err != nil {
+		c.JSON(statusErroAnexo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, anexos)
+}
+
+// @Summary      Gera o link de download do anexo
+// @Description  O link dispensa autenticação e expira após o prazo configurado. Pode ser repassado a quem não tem acesso ao sistema.
+// @Tags         anexos
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID do anexo" format(uuid)
+// @Success      200 {object} model.LinkAnexoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      401 {object} map[string]string "Não autenticado"
+// @Failure      404 {object} map[string]string "Anexo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /anexos/{id}/link [get]
+func (h *Handler) GerarLinkAnexo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	link, err := h.anexoUseCase.GerarLink(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroAnexo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	query := url.Values{}
+	query.Set("expira", strconv.FormatInt(link.ExpiraEm.Unix(), 10))
+	query.Set("assinatura", link.Assinatura)
+	c.JSON(http.StatusOK, model.LinkAnexoResponse{
+		URL:      fmt.Sprintf("/api/v1/anexos/%s/download?%s", id, query.Encode()),
+		ExpiraEm: link.ExpiraEm,
+	})
+}
+
+// @Summary      Baixa o anexo
+// @Description  Autorizado pela assinatura do link gerado em /anexos/{id}/link, sem token.
+// @Tags         anexos
+// @Produce      application/pdf
+// @Produce      image/jpeg
+// @Produce      image/png
+// @Param        id         path  string true "ID do anexo" format(uuid)
+// @Param        expira     query int    true "Expiração do link (Unix)"
+// @Param        assinatura query string true "Assinatura do link"
+// @Success      200 {file} file
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      403 {object} map[string]string "Link inválido ou expirado"
+// @Failure      404 {object} map[string]string "Anexo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /anexos/{id}/download [get]
+func (h *Handler) BaixarAnexo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var params model.DownloadAnexoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	anexo, conteudo, err := h.anexoUseCase.Baixar(c.Request.Context(), id, params.Expira, params.Assinatura)
+	if err != nil {
+		c.JSON(statusErroAnexo(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer conteudo.Close()
+
+	c.DataFromReader(http.StatusOK, anexo.Tamanho, anexo.ContentType, conteudo, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": anexo.NomeArquivo}),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, no-store",
+	})
+}
+
+// @Summary      Remove um anexo
+// @Tags         anexos
+// @Security     BearerAuth
+// @Param        id path string true "ID do anexo" format(uuid)
+// @Success      204 "Anexo removido"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      401 {object} map[string]string "Não autenticado"
+// @Failure      404 {object} map[string]string "Anexo não encontrado"
+// @Failure      409 {object} map[string]string "Anexo é comprovante de uma despesa"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /anexos/{id} [delete]
+func (h *Handler) RemoverAnexo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.anexoUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroAnexo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// folgaMultipart é o espaço além do arquivo reservado aos cabeçalhos e demais
+// campos do formulário multipart
+const folgaMultipart = 1 << 20
+
+// limitarUpload recusa corpos maiores que o arquivo permitido antes que o
+// formulário multipart seja lido, evitando gravar uploads enormes em disco
+func limitarUpload(c *gin.Context, tamanhoMaximo int64) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, tamanhoMaximo+folgaMultipart)
+}
+
+// uploadExcedido indica se a leitura do formulário parou no limite do corpo
+func uploadExcedido(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
+
+func statusErroAnexo(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrAnexoNaoEncontrado),
+		errors.Is(err, usecase.ErrEntidadeAnexoNaoEncontrada),
+		errors.Is(err, usecase.ErrArquivoAnexoIndisponivel):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrLinkAnexoInvalido),
+		errors.Is(err, usecase.ErrLinkAnexoExpirado):
+		return http.StatusForbidden
+	case errors.Is(err, usecase.ErrAnexoEmUso):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrAnexoMuitoGrande):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrTipoConteudoAnexoNaoPermitido):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, usecase.ErrAnexoVazio),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
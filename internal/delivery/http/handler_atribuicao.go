@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary      Sugere veículo e motorista para uma viagem
+// @Description  Retorna os pares veículo/motorista livres no período, ordenados pela adequação: ocupação do veículo, categoria da CNH, descanso e carga horária do motorista, manutenção prevista e distância até a origem
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        solicitacao body model.SugestaoAtribuicaoRequest true "Dados da viagem"
+// @Success      200 {array}  model.SugestaoAtribuicaoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/sugestoes [post]
+func (h *Handler) SugerirAtribuicoes(c *gin.Context) {
+	var req model.SugestaoAtribuicaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sugestoes, err := h.atribuicaoUseCase.Sugerir(c.Request.Context(), req.ToDomain(), req.Limite)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrDataInvalida) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.SugestaoAtribuicaoResponse, len(sugestoes))
+	for i, s := range sugestoes {
+		response[i] = model.NewSugestaoAtribuicaoResponse(s)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -11,9 +11,9 @@ import (
 
 // LoginRequest representa os dados necessários para autenticação
 type LoginRequest struct {
-	CPF    string `json:"cpf" binding:"required" example:"12345678900"`                            // CPF do usuário
-	Senha  string `json:"senha" binding:"required" example:"senha123"`                             // Senha do usuário
-	Perfil string `json:"perfil" binding:"required" example:"MOTORISTA" enums:"MOTORISTA,CLIENTE"` // Perfil do usuário
+	CPF    string `json:"cpf" binding:"required" example:"12345678900"`                                  // CPF do usuário
+	Senha  string `json:"senha" binding:"required" example:"senha123"`                                   // Senha do usuário
+	Perfil string `json:"perfil" binding:"required" example:"MOTORISTA" enums:"MOTORISTA,CLIENTE,ADMIN"` // Perfil do usuário
 }
 
 // LoginResponse representa a resposta do endpoint de login
@@ -22,7 +22,7 @@ type LoginResponse struct {
 }
 
 // @Summary      Autentica um usuário
-// @Description  Realiza a autenticação de um usuário (motorista ou cliente) e retorna um token JWT
+// @Description  Realiza a autenticação de um usuário (motorista, cliente ou administrador) e retorna um token JWT. O perfil ADMIN exige as credenciais configuradas em ADMIN_CPF e ADMIN_SENHA.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -39,18 +39,26 @@ func LoginHandler(c *gin.Context) {
 		return
 	}
 
-	// Simulação: aceita qualquer senha para CPF válido
-	if req.Perfil != "MOTORISTA" && req.Perfil != "CLIENTE" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Perfil inválido"})
-		return
-	}
-
-	// Aqui você faria a validação real no banco de dados
-	// Exemplo: buscar motorista/cliente pelo CPF e comparar senha (hash)
 	userID := req.CPF
 	name := "Usuário Exemplo"
 	profile := req.Perfil
 
+	switch req.Perfil {
+	case auth.ProfileMotorista, auth.ProfileCliente:
+		// Simulação: aceita qualquer senha para CPF válido
+		// Aqui você faria a validação real no banco de dados
+		// Exemplo: buscar motorista/cliente pelo CPF e comparar senha (hash)
+	case auth.ProfileAdmin:
+		if !auth.AutenticarAdmin(req.CPF, req.Senha) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Credenciais inválidas"})
+			return
+		}
+		name = "Administrador"
+	default:
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Perfil inválido"})
+		return
+	}
+
 	token, err := auth.GenerateJWT(userID, name, profile, 24*time.Hour)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar token"})
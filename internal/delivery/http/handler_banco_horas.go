@@ -0,0 +1,167 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Extrato do banco de horas do motorista
+// @Description  Lista os lançamentos do período (viagens concluídas, ajustes e compensações) e os resume por dia, semana ou mês: minutos trabalhados, noturnos, horas extras além das jornadas diária e semanal, acréscimo da hora noturna reduzida e saldo acumulado. Os valores são em minutos.
+// @Tags         motoristas
+// @Produce      json
+// @Param        id          path  string true  "ID do motorista" format(uuid)
+// @Param        data_inicio query string true  "Início do período (RFC 3339)"
+// @Param        data_fim    query string true  "Fim do período (RFC 3339)"
+// @Param        agrupamento query string false "Agrupamento dos períodos" Enums(DIA, SEMANA, MES) default(SEMANA)
+// @Success      200 {object} domain.ExtratoBancoHoras
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      404 {object} map[string]string "Motorista não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /motoristas/{id}/banco-horas [get]
+func (h *Handler) ExtratoBancoHoras(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var params model.ExtratoBancoHorasQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	extrato, err := h.bancoHorasUseCase.Extrato(c.Request.Context(), id, params.DataInicio, params.DataFim,
+		domain.AgrupamentoHoras(params.Agrupamento))
+	if err != nil {
+		c.JSON(statusErroBancoHoras(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, extrato)
+}
+
+// @Summary      Ajusta o banco de horas do motorista
+// @Description  Credita (minutos positivos) ou debita (negativos) o banco de horas. Somente ADMIN; a justificativa em descricao é obrigatória.
+// @Tags         motoristas
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id     path string                       true "ID do motorista" format(uuid)
+// @Param        ajuste body model.LancamentoHorasRequest true "Ajuste"
+// @Success      201 {object} domain.LancamentoHoras
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      403 {object} map[string]string "Usuário não é ADMIN"
+// @Failure      404 {object} map[string]string "Motorista não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /motoristas/{id}/banco-horas/ajustes [post]
+func (h *Handler) AjustarBancoHoras(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.LancamentoHorasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ajuste, err := h.bancoHorasUseCase.LancarAjuste(c.Request.Context(), id, req.Data, req.Minutos, req.Descricao)
+	if err != nil {
+		c.JSON(statusErroBancoHoras(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, ajuste)
+}
+
+// @Summary      Registra folga compensatória do motorista
+// @Description  Debita do banco de horas os minutos de folga. A compensação não pode passar do saldo acumulado até o dia da folga.
+// @Tags         motoristas
+// @Accept       json
+// @Produce      json
+// @Param        id          path string                       true "ID do motorista" format(uuid)
+// @Param        compensacao body model.LancamentoHorasRequest true "Compensação"
+// @Success      201 {object} domain.LancamentoHoras
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Motorista não encontrado"
+// @Failure      409 {object} map[string]string "Saldo insuficiente"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /motoristas/{id}/banco-horas/compensacoes [post]
+func (h *Handler) CompensarBancoHoras(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.LancamentoHorasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	compensacao, err := h.bancoHorasUseCase.LancarCompensacao(c.Request.Context(), id, req.Data, req.Minutos, req.Descricao)
+	if err != nil {
+		c.JSON(statusErroBancoHoras(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, compensacao)
+}
+
+// @Summary      Motoristas com banco de horas excedido
+// @Description  Lista os motoristas cujo saldo no banco de horas passa do limite, do maior saldo para o menor
+// @Tags         motoristas
+// @Produce      json
+// @Param        limite_horas query int true "Limite do saldo, em horas"
+// @Success      200 {array}  domain.Motorista
+// @Failure      400 {object} map[string]string "Limite inválido"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /motoristas/banco-horas-excedido [get]
+func (h *Handler) ListarBancoHorasExcedido(c *gin.Context) {
+	var params model.BancoHorasExcedidoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	motoristas, err := h.bancoHorasUseCase.MotoristasExcedidos(c.Request.Context(), params.LimiteHoras)
+	if err != nil {
+		c.JSON(statusErroBancoHoras(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, motoristas)
+}
+
+func statusErroBancoHoras(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrMotoristaNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrAjusteBancoHorasNaoPermitido):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrSaldoBancoHorasInsuficiente):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrLimiteBancoHorasInvalido),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+	"agencia-viagens/internal/validator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Define o checklist de um tipo de veículo
+// @Description  Substitui os itens da inspeção de saída do tipo de veículo. Itens críticos reprovados impedem o início da viagem. Com abrir_manutencao, a inspeção com itens reprovados abre uma ordem de manutenção corretiva.
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        tipo   path string                              true "Tipo do veículo" Enums(VAN, ONIBUS, MICRO_ONIBUS)
+// @Param        modelo body model.DefinirModeloChecklistRequest true "Itens do checklist"
+// @Success      200 {object} domain.ModeloChecklist
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /checklists/{tipo} [put]
+func (h *Handler) DefinirModeloChecklist(c *gin.Context) {
+	tipo := domain.TipoVeiculo(c.Param("tipo"))
+	if err := validator.ValidarTipoVeiculo(tipo); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req model.DefinirModeloChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	modelo := req.ToDomain(tipo)
+	if err := h.checklistUseCase.DefinirModelo(c.Request.Context(), modelo); err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, modelo)
+}
+
+// @Summary      Lista os checklists configurados
+// @Tags         checklists
+// @Produce      json
+// @Success      200 {array}  domain.ModeloChecklist
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /checklists [get]
+func (h *Handler) ListarModelosChecklist(c *gin.Context) {
+	modelos, err := h.checklistUseCase.ListarModelos(c.Request.Context())
+	if err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, modelos)
+}
+
+// @Summary      Busca o checklist de um tipo de veículo
+// @Description  Sem checklist configurado para o tipo, retorna o checklist padrão (pneus, luzes, extintor, cintos, cronotacógrafo e limpeza).
+// @Tags         checklists
+// @Produce      json
+// @Param        tipo path string true "Tipo do veículo" Enums(VAN, ONIBUS, MICRO_ONIBUS)
+// @Success      200 {object} domain.ModeloChecklist
+// @Failure      400 {object} map[string]string "Tipo inválido"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /checklists/{tipo} [get]
+func (h *Handler) BuscarModeloChecklist(c *gin.Context) {
+	tipo := domain.TipoVeiculo(c.Param("tipo"))
+	if err := validator.ValidarTipoVeiculo(tipo); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	modelo, err := h.checklistUseCase.BuscarModelo(c.Request.Context(), tipo)
+	if err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, modelo)
+}
+
+// @Summary      Remove o checklist de um tipo de veículo
+// @Description  O tipo de veículo volta a usar o checklist padrão.
+// @Tags         checklists
+// @Param        tipo path string true "Tipo do veículo" Enums(VAN, ONIBUS, MICRO_ONIBUS)
+// @Success      204 "Checklist removido"
+// @Failure      400 {object} map[string]string "Tipo inválido"
+// @Failure      404 {object} map[string]string "Tipo sem checklist configurado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /checklists/{tipo} [delete]
+func (h *Handler) RemoverModeloChecklist(c *gin.Context) {
+	tipo := domain.TipoVeiculo(c.Param("tipo"))
+	if err := validator.ValidarTipoVeiculo(tipo); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.checklistUseCase.RemoverModelo(c.Request.Context(), tipo); err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      Registra a inspeção de saída de uma viagem
+// @Description  O motorista confere cada item do checklist do tipo do veículo antes da partida. Todos os itens devem ser respondidos. Com item crítico reprovado, o check-in fica bloqueado até nova inspeção aprovada.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        id       path string                         true "ID da viagem" format(uuid)
+// @Param        inspecao body model.RegistrarInspecaoRequest true "Conferência dos itens"
+// @Success      201 {object} domain.InspecaoVeiculo
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      409 {object} map[string]string "Viagem não está agendada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/inspecoes [post]
+func (h *Handler) RegistrarInspecao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistrarInspecaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	inspecao, err := h.checklistUseCase.RegistrarInspecao(c.Request.Context(), id, req.Respostas(), req.Observacoes)
+	if err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, inspecao)
+}
+
+// @Summary      Lista as inspeções de saída de uma viagem
+// @Tags         viagens
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Success      200 {array}  domain.InspecaoVeiculo
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/inspecoes [get]
+func (h *Handler) ListarInspecoes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	inspecoes, err := h.checklistUseCase.ListarInspecoes(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroChecklist(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, inspecoes)
+}
+
+func statusErroChecklist(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrViagemNaoEncontrada),
+		errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrModeloChecklistNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrViagemNaoInspecionavel):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Inclui uma comodidade no catálogo
+// @Description  O código (letras maiúsculas, números e _) é o que os veículos informam em comodidades e as viagens em comodidades_exigidas.
+// @Tags         comodidades
+// @Accept       json
+// @Produce      json
+// @Param        comodidade body model.CriarComodidadeRequest true "Código, nome e se é recurso de acessibilidade"
+// @Success      201 {object} domain.Comodidade
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Código já cadastrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /comodidades [post]
+func (h *Handler) CriarComodidade(c *gin.Context) {
+	var req model.CriarComodidadeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comodidade := req.ToDomain()
+	if err := h.comodidadeUseCase.Criar(c.Request.Context(), comodidade); err != nil {
+		c.JSON(statusErroComodidade(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, comodidade)
+}
+
+// @Summary      Lista o catálogo de comodidades
+// @Tags         comodidades
+// @Produce      json
+// @Success      200 {array}  domain.Comodidade
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /comodidades [get]
+func (h *Handler) ListarComodidades(c *gin.Context) {
+	comodidades, err := h.comodidadeUseCase.Listar(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, comodidades)
+}
+
+// @Summary      Remove uma comodidade do catálogo
+// @Tags         comodidades
+// @Param        id path string true "ID da comodidade" format(uuid)
+// @Success      204 "Comodidade removida"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Comodidade não encontrada"
+// @Failure      409 {object} map[string]string "Comodidade em uso por veículos ou viagens"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /comodidades/{id} [delete]
+func (h *Handler) RemoverComodidade(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.comodidadeUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroComodidade(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func statusErroComodidade(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrComodidadeNaoEncontrada):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrComodidadeJaCadastrada),
+		errors.Is(err, usecase.ErrComodidadeEmUso):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
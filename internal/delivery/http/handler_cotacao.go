@@ -0,0 +1,286 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Cota uma viagem
+// @Description  Calcula o preço da viagem pela tabela do cliente e retorna o detalhamento. A cotação pode ser convertida em viagem enquanto válida, informando cotacao_id na criação da viagem.
+// @Tags         cotacoes
+// @Accept       json
+// @Produce      json
+// @Param        cotacao body model.CotacaoRequest true "Dados da viagem"
+// @Success      201 {object} model.CotacaoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Tabela de preços não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/cotacao [post]
+func (h *Handler) CotarViagem(c *gin.Context) {
+	var req model.CotacaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cotacao := req.ToDomain()
+	if err := h.cotacaoUseCase.Cotar(c.Request.Context(), cotacao); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewCotacaoResponse(cotacao))
+}
+
+// @Summary      Busca uma cotação pelo ID
+// @Tags         cotacoes
+// @Produce      json
+// @Param        id path string true "ID da cotação" format(uuid)
+// @Success      200 {object} model.CotacaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Cotação não encontrada"
+// @Router       /viagens/cotacao/{id} [get]
+func (h *Handler) BuscarCotacao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	cotacao, err := h.cotacaoUseCase.BuscarPorID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Cotação não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewCotacaoResponse(cotacao))
+}
+
+// @Summary      Cria uma tabela de preços
+// @Description  Cadastra a tabela padrão (sem cliente) ou a tabela de um cliente para um tipo de veículo
+// @Tags         tabelas-preco
+// @Accept       json
+// @Produce      json
+// @Param        tabela body model.CreateTabelaPrecoRequest true "Dados da tabela"
+// @Success      201 {object} model.TabelaPrecoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Tabela já cadastrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /tabelas-preco [post]
+func (h *Handler) CriarTabelaPreco(c *gin.Context) {
+	var req model.CreateTabelaPrecoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tabela := req.ToDomain(req.ClienteID, req.TipoVeiculo)
+	if err := h.cotacaoUseCase.CriarTabela(c.Request.Context(), tabela); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewTabelaPrecoResponse(tabela))
+}
+
+// @Summary      Lista as tabelas de preço
+// @Tags         tabelas-preco
+// @Produce      json
+// @Success      200 {array}  model.TabelaPrecoResponse
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /tabelas-preco [get]
+func (h *Handler) ListarTabelasPreco(c *gin.Context) {
+	tabelas, err := h.cotacaoUseCase.ListarTabelas(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.TabelaPrecoResponse, len(tabelas))
+	for i := range tabelas {
+		response[i] = model.NewTabelaPrecoResponse(&tabelas[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Busca uma tabela de preços pelo ID
+// @Tags         tabelas-preco
+// @Produce      json
+// @Param        id path string true "ID da tabela" format(uuid)
+// @Success      200 {object} model.TabelaPrecoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Tabela não encontrada"
+// @Router       /tabelas-preco/{id} [get]
+func (h *Handler) BuscarTabelaPreco(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	tabela, err := h.cotacaoUseCase.BuscarTabela(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tabela não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewTabelaPrecoResponse(tabela))
+}
+
+// @Summary      Atualiza os valores de uma tabela de preços
+// @Description  O cliente e o tipo de veículo da tabela não podem ser alterados
+// @Tags         tabelas-preco
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da tabela" format(uuid)
+// @Param        tabela body model.TabelaPrecoRequest true "Valores da tabela"
+// @Success      200 {object} model.TabelaPrecoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Tabela não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /tabelas-preco/{id} [put]
+func (h *Handler) AtualizarTabelaPreco(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.TabelaPrecoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tabela := req.ToDomain(nil, "")
+	tabela.ID = id
+	if err := h.cotacaoUseCase.AtualizarTabela(c.Request.Context(), tabela); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewTabelaPrecoResponse(tabela))
+}
+
+// @Summary      Remove uma tabela de preços
+// @Tags         tabelas-preco
+// @Param        id path string true "ID da tabela" format(uuid)
+// @Success      204 "No Content"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Tabela não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /tabelas-preco/{id} [delete]
+func (h *Handler) RemoverTabelaPreco(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.cotacaoUseCase.RemoverTabela(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      Cadastra um feriado
+// @Description  Viagens que passam por feriados recebem o adicional de feriado da tabela de preços
+// @Tags         feriados
+// @Accept       json
+// @Produce      json
+// @Param        feriado body model.CreateFeriadoRequest true "Dados do feriado"
+// @Success      201 {object} domain.Feriado
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /feriados [post]
+func (h *Handler) CriarFeriado(c *gin.Context) {
+	var req model.CreateFeriadoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	feriado := domain.NewFeriado(req.Data, req.Descricao)
+	if err := h.cotacaoUseCase.CriarFeriado(c.Request.Context(), feriado); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, feriado)
+}
+
+// @Summary      Lista os feriados
+// @Tags         feriados
+// @Produce      json
+// @Success      200 {array}  domain.Feriado
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /feriados [get]
+func (h *Handler) ListarFeriados(c *gin.Context) {
+	feriados, err := h.cotacaoUseCase.ListarFeriados(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, feriados)
+}
+
+// @Summary      Remove um feriado
+// @Tags         feriados
+// @Param        id path string true "ID do feriado" format(uuid)
+// @Success      204 "No Content"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Feriado não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /feriados/{id} [delete]
+func (h *Handler) RemoverFeriado(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.cotacaoUseCase.RemoverFeriado(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroCotacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// statusErroCotacao traduz os erros de cotações e tabelas de preço em status HTTP
+func statusErroCotacao(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrCotacaoNaoEncontrada),
+		errors.Is(err, usecase.ErrTabelaPrecoNaoEncontrada),
+		errors.Is(err, usecase.ErrFeriadoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrTabelaPrecoDuplicada):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,207 @@
+package http
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Registra um custo fixo do veículo
+// @Description  Custos mensais que independem do uso, como seguro, depreciação e financiamento. São rateados por dia no relatório de rentabilidade.
+// @Tags         veiculos
+// @Accept       json
+// @Produce      json
+// @Param        id    path string                          true "ID do veículo" format(uuid)
+// @Param        custo body model.RegistrarCustoFixoRequest true "Tipo, valor mensal e vigência"
+// @Success      201 {object} domain.CustoFixoVeiculo
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/custos-fixos [post]
+func (h *Handler) RegistrarCustoFixo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistrarCustoFixoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	custo := req.ToDomain(id)
+	if err := h.custoVeiculoUseCase.RegistrarCustoFixo(c.Request.Context(), custo); err != nil {
+		c.JSON(statusErroCustoVeiculo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, custo)
+}
+
+// @Summary      Lista os custos fixos do veículo
+// @Tags         veiculos
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Success      200 {array}  domain.CustoFixoVeiculo
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/custos-fixos [get]
+func (h *Handler) ListarCustosFixos(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	custos, err := h.custoVeiculoUseCase.ListarCustosFixos(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroCustoVeiculo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, custos)
+}
+
+// @Summary      Remove um custo fixo do veículo
+// @Tags         veiculos
+// @Param        id path string true "ID do custo fixo" format(uuid)
+// @Success      204 "Custo fixo removido"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Custo fixo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /custos-fixos-veiculo/{id} [delete]
+func (h *Handler) RemoverCustoFixo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.custoVeiculoUseCase.RemoverCustoFixo(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroCustoVeiculo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      Rentabilidade da frota
+// @Description  Receita das viagens concluídas e taxas de cancelamento contra despesas de viagem, combustível, manutenção e custos fixos de cada veículo no período, com custo e receita por km, margem e utilização. Os veículos vêm do pior para o melhor resultado. Com formato=csv, retorna planilha separada por ponto e vírgula.
+// @Tags         veiculos
+// @Produce      json
+// @Produce      text/csv
+// @Param        data_inicio query string true  "Início do período (RFC 3339)"
+// @Param        data_fim    query string true  "Fim do período (RFC 3339)"
+// @Param        tipo        query string false "Tipo do veículo"
+// @Param        formato     query string false "Formato da resposta" Enums(json, csv) default(json)
+// @Success      200 {object} domain.RelatorioRentabilidadeFrota
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/rentabilidade [get]
+func (h *Handler) RelatorioRentabilidadeFrota(c *gin.Context) {
+	var params model.RentabilidadeQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	relatorio, err := h.custoVeiculoUseCase.RelatorioRentabilidade(c.Request.Context(),
+		domain.TipoVeiculo(params.Tipo), params.DataInicio, params.DataFim)
+	if err != nil {
+		c.JSON(statusErroCustoVeiculo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if params.Formato != "csv" {
+		c.JSON(http.StatusOK, relatorio)
+		return
+	}
+
+	nome := fmt.Sprintf("rentabilidade-frota-%s-%s.csv",
+		relatorio.DataInicio.Format("2006-01-02"), relatorio.DataFim.Format("2006-01-02"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, nome))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	if err := escreverRentabilidadeCSV(c.Writer, relatorio); err != nil {
+		c.Error(err)
+	}
+}
+
+// escreverRentabilidadeCSV gera a planilha no formato aberto pelo Excel em
+// português: BOM UTF-8, ponto e vírgula como separador e vírgula decimal
+func escreverRentabilidadeCSV(w io.Writer, relatorio *domain.RelatorioRentabilidadeFrota) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	planilha := csv.NewWriter(w)
+	planilha.Comma = ';'
+
+	cabecalho := []string{"Placa", "Modelo", "Tipo", "Ano", "Viagens", "Km rodados", "Receita",
+		"Despesas de viagem", "Combustível", "Manutenção", "Custos fixos", "Custo total", "Resultado",
+		"Margem (%)", "Custo por km", "Receita por km", "Utilização (%)"}
+	if err := planilha.Write(cabecalho); err != nil {
+		return err
+	}
+
+	for _, r := range relatorio.Veiculos {
+		if err := planilha.Write(linhaRentabilidadeCSV(r, r.Placa, r.Modelo, string(r.Tipo), strconv.Itoa(r.Ano))); err != nil {
+			return err
+		}
+	}
+	if err := planilha.Write(linhaRentabilidadeCSV(relatorio.Total, "TOTAL", "", "", "")); err != nil {
+		return err
+	}
+
+	planilha.Flush()
+	return planilha.Error()
+}
+
+// linhaRentabilidadeCSV monta a linha da planilha a partir das colunas de
+// identificação do veículo
+func linhaRentabilidadeCSV(r *domain.RentabilidadeVeiculo, identificacao ...string) []string {
+	linha := append(identificacao, strconv.Itoa(r.Viagens), strconv.Itoa(r.KmRodados))
+	for _, valor := range []float64{r.Receita, r.Despesas, r.Combustivel, r.Manutencao, r.CustosFixos,
+		r.CustoTotal, r.Resultado, r.Margem, r.CustoPorKm, r.ReceitaPorKm, r.Utilizacao} {
+		linha = append(linha, decimalCSV(valor))
+	}
+	return linha
+}
+
+// decimalCSV formata o valor com duas casas e vírgula decimal
+func decimalCSV(valor float64) string {
+	return strings.Replace(strconv.FormatFloat(valor, 'f', 2, 64), ".", ",", 1)
+}
+
+func statusErroCustoVeiculo(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrCustoFixoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrDataInvalida),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,239 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Lança uma despesa na viagem
+// @Description  Registra pedágio, combustível, alimentação, estacionamento ou outro gasto da viagem, pago pelo motorista ou com o cartão da empresa. Despesas pagas pelo motorista exigem comprovante_id, o anexo do tipo COMPROVANTE enviado para a viagem, e aguardam aprovação para reembolso. Sem motorista_id, a despesa é do motorista principal.
+// @Tags         despesas
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Param        despesa body model.RegistrarDespesaRequest true "Dados da despesa"
+// @Success      201 {object} model.DespesaViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      409 {object} map[string]string "Viagem cancelada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/despesas [post]
+func (h *Handler) RegistrarDespesa(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistrarDespesaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	despesa := req.ToDomain(id)
+	if err := h.despesaUseCase.Registrar(c.Request.Context(), despesa); err != nil {
+		c.JSON(statusErroDespesa(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewDespesaViagemResponse(despesa))
+}
+
+// @Summary      Lista as despesas de uma viagem
+// @Tags         despesas
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Success      200 {array}  model.DespesaViagemResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/despesas [get]
+func (h *Handler) ListarDespesasViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	despesas, err := h.despesaUseCase.Listar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroDespesa(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.DespesaViagemResponse, len(despesas))
+	for i, d := range despesas {
+		response[i] = model.NewDespesaViagemResponse(d)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Calcula a rentabilidade de uma viagem
+// @Description  Subtrai do valor da viagem as despesas lançadas, exceto as rejeitadas, e detalha o total por categoria
+// @Tags         despesas
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Success      200 {object} domain.Rentabilidade
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/rentabilidade [get]
+func (h *Handler) RentabilidadeViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	rentabilidade, err := h.despesaUseCase.Rentabilidade(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroDespesa(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rentabilidade)
+}
+
+// @Summary      Aprova uma despesa
+// @Description  Restrito a ADMIN
+// @Tags         despesas
+// @Produce      json
+// @Param        id path string true "ID da despesa" format(uuid)
+// @Success      200 {object} model.DespesaViagemResponse
+// @Failure      403 {object} map[string]string "Aprovação restrita a ADMIN"
+// @Failure      404 {object} map[string]string "Despesa não encontrada"
+// @Failure      409 {object} map[string]string "Despesa já avaliada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /despesas/{id}/aprovar [post]
+func (h *Handler) AprovarDespesa(c *gin.Context) {
+	h.avaliarDespesa(c, h.despesaUseCase.Aprovar)
+}
+
+// @Summary      Rejeita uma despesa
+// @Description  Restrito a ADMIN
+// @Tags         despesas
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da despesa" format(uuid)
+// @Param        rejeicao body model.RejeitarDespesaRequest true "Motivo da rejeição"
+// @Success      200 {object} model.DespesaViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      403 {object} map[string]string "Rejeição restrita a ADMIN"
+// @Failure      404 {object} map[string]string "Despesa não encontrada"
+// @Failure      409 {object} map[string]string "Despesa já avaliada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /despesas/{id}/rejeitar [post]
+func (h *Handler) RejeitarDespesa(c *gin.Context) {
+	var req model.RejeitarDespesaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.avaliarDespesa(c, func(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error) {
+		return h.despesaUseCase.Rejeitar(ctx, id, req.Motivo)
+	})
+}
+
+// @Summary      Registra o reembolso de uma despesa
+// @Description  Marca como reembolsada uma despesa aprovada paga pelo motorista. Restrito a ADMIN.
+// @Tags         despesas
+// @Produce      json
+// @Param        id path string true "ID da despesa" format(uuid)
+// @Success      200 {object} model.DespesaViagemResponse
+// @Failure      400 {object} map[string]string "Despesa paga com cartão da empresa"
+// @Failure      403 {object} map[string]string "Reembolso restrito a ADMIN"
+// @Failure      404 {object} map[string]string "Despesa não encontrada"
+// @Failure      409 {object} map[string]string "Despesa não aprovada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /despesas/{id}/reembolsar [post]
+func (h *Handler) ReembolsarDespesa(c *gin.Context) {
+	h.avaliarDespesa(c, h.despesaUseCase.Reembolsar)
+}
+
+// @Summary      Resumo de reembolso do motorista
+// @Description  Totaliza as despesas pagas pelo motorista realizadas no período: pendentes de aprovação, aprovadas a reembolsar, reembolsadas e rejeitadas
+// @Tags         despesas
+// @Produce      json
+// @Param        id          path  string true "ID do motorista" format(uuid)
+// @Param        data_inicio query string true "Início do período (RFC 3339)"
+// @Param        data_fim    query string true "Fim do período (RFC 3339)"
+// @Success      200 {object} model.ResumoReembolsoResponse
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      404 {object} map[string]string "Motorista não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /motoristas/{id}/reembolsos [get]
+func (h *Handler) ResumoReembolsoMotorista(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var params model.PeriodoQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resumo, err := h.despesaUseCase.ResumoReembolso(c.Request.Context(), id, params.DataInicio, params.DataFim)
+	if err != nil {
+		c.JSON(statusErroDespesa(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewResumoReembolsoResponse(resumo))
+}
+
+func (h *Handler) avaliarDespesa(c *gin.Context,
+	avaliar func(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error)) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	despesa, err := avaliar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroDespesa(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDespesaViagemResponse(despesa))
+}
+
+func statusErroDespesa(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrViagemNaoEncontrada),
+		errors.Is(err, usecase.ErrMotoristaNaoEncontrado),
+		errors.Is(err, usecase.ErrDespesaNaoEncontrada):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrAvaliacaoDespesaNaoPermitida):
+		return http.StatusForbidden
+	case errors.Is(err, usecase.ErrDespesaViagemCancelada),
+		errors.Is(err, domain.ErrDespesaJaAvaliada),
+		errors.Is(err, domain.ErrDespesaNaoAprovada):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrDataInvalida),
+		errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
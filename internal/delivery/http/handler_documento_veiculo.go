@@ -0,0 +1,193 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Cadastra um documento do veículo
+// @Description  Registra CRLV, apólice de seguro, registro na ANTT, certificado do cronotacógrafo ou autorização estadual (com uf) com sua validade. Documentos renovados são cadastrados como novos; em cada tipo vale o de maior validade. A documentação do veículo só é válida com todos os documentos obrigatórios vigentes.
+// @Tags         documentos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Param        documento body model.RegistrarDocumentoVeiculoRequest true "Dados do documento"
+// @Success      201 {object} model.DocumentoVeiculoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/documentos [post]
+func (h *Handler) RegistrarDocumentoVeiculo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistrarDocumentoVeiculoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	documento := req.ToDomain(id)
+	if err := h.documentoUseCase.Registrar(c.Request.Context(), documento); err != nil {
+		c.JSON(statusErroDocumento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewDocumentoVeiculoResponse(documento))
+}
+
+// @Summary      Lista os documentos do veículo
+// @Tags         documentos
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Success      200 {array}  model.DocumentoVeiculoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/documentos [get]
+func (h *Handler) ListarDocumentosVeiculo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	documentos, err := h.documentoUseCase.Listar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroDocumento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.DocumentoVeiculoResponse, len(documentos))
+	for i, d := range documentos {
+		response[i] = model.NewDocumentoVeiculoResponse(d)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Lista os veículos com documentação vencida
+// @Description  Retorna os veículos a que falta algum documento obrigatório dentro da validade
+// @Tags         documentos
+// @Produce      json
+// @Success      200 {array}  model.VeiculoResponse
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/documentacao-vencida [get]
+func (h *Handler) ListarVeiculosDocumentacaoVencida(c *gin.Context) {
+	veiculos, err := h.documentoUseCase.ListarVeiculosDocumentacaoVencida(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.VeiculoResponse, len(veiculos))
+	for i, v := range veiculos {
+		response[i] = model.NewVeiculoResponse(v)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Busca um documento de veículo
+// @Tags         documentos
+// @Produce      json
+// @Param        id path string true "ID do documento" format(uuid)
+// @Success      200 {object} model.DocumentoVeiculoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Documento não encontrado"
+// @Router       /documentos-veiculo/{id} [get]
+func (h *Handler) BuscarDocumentoVeiculo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	documento, err := h.documentoUseCase.BuscarPorID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroDocumento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDocumentoVeiculoResponse(documento))
+}
+
+// @Summary      Corrige um documento de veículo
+// @Description  Corrige número, datas e arquivo do documento. O veículo e o tipo não mudam.
+// @Tags         documentos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do documento" format(uuid)
+// @Param        documento body model.AtualizarDocumentoVeiculoRequest true "Dados do documento"
+// @Success      200 {object} model.DocumentoVeiculoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Documento não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /documentos-veiculo/{id} [put]
+func (h *Handler) AtualizarDocumentoVeiculo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.AtualizarDocumentoVeiculoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	documento := req.ToDomain(id)
+	if err := h.documentoUseCase.Atualizar(c.Request.Context(), documento); err != nil {
+		c.JSON(statusErroDocumento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDocumentoVeiculoResponse(documento))
+}
+
+// @Summary      Remove um documento de veículo
+// @Tags         documentos
+// @Param        id path string true "ID do documento" format(uuid)
+// @Success      204 "Documento removido"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Documento não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /documentos-veiculo/{id} [delete]
+func (h *Handler) RemoverDocumentoVeiculo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.documentoUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroDocumento(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func statusErroDocumento(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrDocumentoVeiculoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Cria uma reserva de grupo
+// @Description  Reserva os veículos e motoristas necessários para o grupo e divide os passageiros entre as viagens
+// @Tags         grupos
+// @Accept       json
+// @Produce      json
+// @Param        grupo body model.CreateGrupoViagemRequest true "Dados do grupo"
+// @Success      201 {object} model.GrupoViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Recursos insuficientes"
+// @Failure      422 {object} map[string]interface{} "Violações das regras de jornada do motorista ou pendências de documentação, manutenção ou CNH"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /grupos [post]
+func (h *Handler) CriarGrupoViagem(c *gin.Context) {
+	var req model.CreateGrupoViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grupo := req.ToDomain()
+	if err := h.grupoViagemUseCase.Criar(c.Request.Context(), grupo); err != nil {
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+		c.JSON(statusErroGrupo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewGrupoViagemResponse(grupo))
+}
+
+// @Summary      Lista as reservas de grupo
+// @Description  Retorna uma página das reservas de grupo, das mais recentes para as mais antigas
+// @Tags         grupos
+// @Produce      json
+// @Param        offset query int false "Deslocamento" default(0)
+// @Param        limit  query int false "Tamanho da página (máx. 100)" default(20)
+// @Success      200 {array}  model.GrupoViagemResponse
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /grupos [get]
+func (h *Handler) ListarGruposViagem(c *gin.Context) {
+	var params model.GrupoViagemQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grupos, err := h.grupoViagemUseCase.Listar(c.Request.Context(), params.Offset, params.Limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.GrupoViagemResponse, len(grupos))
+	for i := range grupos {
+		response[i] = model.NewGrupoViagemResponse(&grupos[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Busca uma reserva de grupo pelo ID
+// @Description  Retorna o grupo com todas as suas viagens
+// @Tags         grupos
+// @Produce      json
+// @Param        id path string true "ID do grupo" format(uuid)
+// @Success      200 {object} model.GrupoViagemResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Grupo não encontrado"
+// @Router       /grupos/{id} [get]
+func (h *Handler) BuscarGrupoViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	grupo, err := h.grupoViagemUseCase.BuscarPorID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Grupo não encontrado"})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewGrupoViagemResponse(grupo))
+}
+
+// @Summary      Reagenda uma reserva de grupo
+// @Description  Move todas as viagens do grupo para o novo período, mantendo veículos e motoristas
+// @Tags         grupos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do grupo" format(uuid)
+// @Param        periodo body model.ReagendarGrupoViagemRequest true "Novo período"
+// @Success      200 {object} model.GrupoViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Grupo não encontrado"
+// @Failure      409 {object} map[string]string "Recursos indisponíveis"
+// @Failure      422 {object} map[string]interface{} "Violações das regras de jornada do motorista ou pendências de documentação, manutenção ou CNH"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /grupos/{id}/reagendar [post]
+func (h *Handler) ReagendarGrupoViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.ReagendarGrupoViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grupo, err := h.grupoViagemUseCase.Reagendar(c.Request.Context(), id, req.DataInicio, req.DataFim)
+	if err != nil {
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+		c.JSON(statusErroGrupo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewGrupoViagemResponse(grupo))
+}
+
+// @Summary      Cancela uma reserva de grupo
+// @Description  Cancela o grupo e todas as suas viagens não concluídas, aplicando a política de cancelamento
+// @Tags         grupos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do grupo" format(uuid)
+// @Param        cancelamento body model.CancelarViagemRequest true "Motivo do cancelamento"
+// @Success      200 {object} model.GrupoViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Grupo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /grupos/{id} [delete]
+func (h *Handler) CancelarGrupoViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.CancelarViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grupo, err := h.grupoViagemUseCase.Cancelar(c.Request.Context(), id, req.Motivo)
+	if err != nil {
+		c.JSON(statusErroGrupo(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewGrupoViagemResponse(grupo))
+}
+
+// statusErroGrupo traduz os erros do caso de uso de grupos em status HTTP
+func statusErroGrupo(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrGrupoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrCapacidadeGrupoInsuficiente),
+		errors.Is(err, usecase.ErrMotoristasInsuficientesGrupo),
+		errors.Is(err, usecase.ErrVeiculoIndisponivel),
+		errors.Is(err, usecase.ErrMotoristaIndisponivel),
+		errors.Is(err, usecase.ErrGrupoCancelado),
+		errors.Is(err, domain.ErrCNHVenceDuranteViagem):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrDataInvalida), errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Programa uma indisponibilidade do veículo
+// @Description  Reserva o veículo para manutenção planejada, vistoria ou uso interno no período. O veículo deixa de aparecer nas buscas de disponibilidade e não pode ser reservado para viagens no período.
+// @Tags         veiculos
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Param        indisponibilidade body model.ProgramarIndisponibilidadeRequest true "Motivo e período"
+// @Success      201 {object} model.IndisponibilidadeVeiculoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      409 {object} map[string]string "Veículo com viagem agendada no período"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/indisponibilidades [post]
+func (h *Handler) ProgramarIndisponibilidade(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.ProgramarIndisponibilidadeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	indisponibilidade := req.ToDomain(id)
+	if err := h.indisponibilidadeUseCase.Programar(c.Request.Context(), indisponibilidade); err != nil {
+		c.JSON(statusErroIndisponibilidade(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewIndisponibilidadeVeiculoResponse(indisponibilidade))
+}
+
+// @Summary      Lista as indisponibilidades do veículo
+// @Description  Retorna as indisponibilidades que ocupam o período. Sem início, parte de agora; sem fim, cobre um ano.
+// @Tags         veiculos
+// @Produce      json
+// @Param        id          path  string true  "ID do veículo" format(uuid)
+// @Param        data_inicio query string false "Início do período (RFC 3339)"
+// @Param        data_fim    query string false "Fim do período (RFC 3339)"
+// @Success      200 {array}  model.IndisponibilidadeVeiculoResponse
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/indisponibilidades [get]
+func (h *Handler) ListarIndisponibilidades(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var params model.IndisponibilidadesQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	inicio, fim := params.Periodo()
+	indisponibilidades, err := h.indisponibilidadeUseCase.Listar(c.Request.Context(), id, inicio, fim)
+	if err != nil {
+		c.JSON(statusErroIndisponibilidade(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.IndisponibilidadeVeiculoResponse, len(indisponibilidades))
+	for i, d := range indisponibilidades {
+		response[i] = model.NewIndisponibilidadeVeiculoResponse(d)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Remove uma indisponibilidade do veículo
+// @Tags         veiculos
+// @Param        id path string true "ID da indisponibilidade" format(uuid)
+// @Success      204 "Indisponibilidade removida"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Indisponibilidade não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /indisponibilidades-veiculo/{id} [delete]
+func (h *Handler) RemoverIndisponibilidade(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.indisponibilidadeUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroIndisponibilidade(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func statusErroIndisponibilidade(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrIndisponibilidadeNaoEncontrada):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrIndisponibilidadeConflitaViagem):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
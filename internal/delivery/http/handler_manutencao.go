@@ -0,0 +1,265 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Abre uma ordem de manutenção
+// @Description  Agenda uma manutenção preventiva ou corretiva do veículo, opcionalmente vinculada a um plano de manutenção. Sem agendada_para, a ordem é agendada para agora.
+// @Tags         manutencao
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Param        ordem body model.AbrirOrdemManutencaoRequest true "Dados da ordem"
+// @Success      201 {object} model.OrdemManutencaoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo ou plano não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/manutencoes [post]
+func (h *Handler) AbrirOrdemManutencao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.AbrirOrdemManutencaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ordem := req.ToDomain(id)
+	if err := h.manutencaoUseCase.AbrirOrdem(c.Request.Context(), ordem); err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewOrdemManutencaoResponse(ordem))
+}
+
+// @Summary      Histórico de manutenção do veículo
+// @Description  Retorna as ordens de manutenção do veículo, das mais recentes para as mais antigas, e o custo total das concluídas
+// @Tags         manutencao
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Success      200 {object} model.HistoricoManutencaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/manutencoes [get]
+func (h *Handler) HistoricoManutencao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	ordens, err := h.manutencaoUseCase.Historico(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewHistoricoManutencaoResponse(id, ordens))
+}
+
+// @Summary      Busca uma ordem de manutenção
+// @Tags         manutencao
+// @Produce      json
+// @Param        id path string true "ID da ordem" format(uuid)
+// @Success      200 {object} model.OrdemManutencaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Ordem não encontrada"
+// @Router       /manutencoes/{id} [get]
+func (h *Handler) BuscarOrdemManutencao(c *gin.Context) {
+	h.operarOrdemManutencao(c, h.manutencaoUseCase.BuscarOrdem)
+}
+
+// @Summary      Inicia uma ordem de manutenção
+// @Description  Coloca a ordem agendada em andamento e o veículo em manutenção, fora das buscas de disponibilidade
+// @Tags         manutencao
+// @Produce      json
+// @Param        id path string true "ID da ordem" format(uuid)
+// @Success      200 {object} model.OrdemManutencaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Ordem não encontrada"
+// @Failure      409 {object} map[string]string "Ordem não agendada ou veículo em viagem"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /manutencoes/{id}/iniciar [post]
+func (h *Handler) IniciarOrdemManutencao(c *gin.Context) {
+	h.operarOrdemManutencao(c, h.manutencaoUseCase.IniciarOrdem)
+}
+
+// @Summary      Conclui uma ordem de manutenção
+// @Description  Registra os custos finais e o odômetro no serviço. O plano atendido recomeça a contagem, o próximo vencimento do veículo é recalculado e o veículo volta a ficar disponível se não houver outra ordem em andamento.
+// @Tags         manutencao
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da ordem" format(uuid)
+// @Param        conclusao body model.ConcluirOrdemManutencaoRequest true "Custos e odômetro"
+// @Success      200 {object} model.OrdemManutencaoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Ordem não encontrada"
+// @Failure      409 {object} map[string]string "Ordem não está em andamento"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /manutencoes/{id}/concluir [post]
+func (h *Handler) ConcluirOrdemManutencao(c *gin.Context) {
+	var req model.ConcluirOrdemManutencaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.operarOrdemManutencao(c, func(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error) {
+		return h.manutencaoUseCase.ConcluirOrdem(ctx, id, req.Odometro, req.CustoPecas, req.CustoMaoDeObra)
+	})
+}
+
+// @Summary      Cancela uma ordem de manutenção
+// @Description  Cancela a ordem agendada ou em andamento. O veículo volta a ficar disponível se não houver outra ordem em andamento.
+// @Tags         manutencao
+// @Produce      json
+// @Param        id path string true "ID da ordem" format(uuid)
+// @Success      200 {object} model.OrdemManutencaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Ordem não encontrada"
+// @Failure      409 {object} map[string]string "Ordem já concluída ou cancelada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /manutencoes/{id}/cancelar [post]
+func (h *Handler) CancelarOrdemManutencao(c *gin.Context) {
+	h.operarOrdemManutencao(c, h.manutencaoUseCase.CancelarOrdem)
+}
+
+// @Summary      Cria um plano de manutenção
+// @Description  Define a manutenção preventiva periódica do veículo a cada intervalo de meses, de quilômetros ou o que vencer primeiro. A contagem começa na data e no odômetro atuais.
+// @Tags         manutencao
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Param        plano body model.CriarPlanoManutencaoRequest true "Dados do plano"
+// @Success      201 {object} model.PlanoManutencaoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/planos-manutencao [post]
+func (h *Handler) CriarPlanoManutencao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.CriarPlanoManutencaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	plano, veiculo, err := h.manutencaoUseCase.CriarPlano(c.Request.Context(), id, req.Descricao, req.IntervaloMeses, req.IntervaloKm)
+	if err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewPlanoManutencaoResponse(plano, veiculo))
+}
+
+// @Summary      Lista os planos de manutenção do veículo
+// @Description  Retorna os planos com o próximo vencimento por data e por odômetro e se já venceram
+// @Tags         manutencao
+// @Produce      json
+// @Param        id path string true "ID do veículo" format(uuid)
+// @Success      200 {array}  model.PlanoManutencaoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Veículo não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /veiculos/{id}/planos-manutencao [get]
+func (h *Handler) ListarPlanosManutencao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	planos, veiculo, err := h.manutencaoUseCase.ListarPlanos(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.PlanoManutencaoResponse, len(planos))
+	for i, p := range planos {
+		response[i] = model.NewPlanoManutencaoResponse(p, veiculo)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Remove um plano de manutenção
+// @Tags         manutencao
+// @Param        id path string true "ID do plano" format(uuid)
+// @Success      204 "Plano removido"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Plano não encontrado"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /planos-manutencao/{id} [delete]
+func (h *Handler) RemoverPlanoManutencao(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.manutencaoUseCase.RemoverPlano(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) operarOrdemManutencao(c *gin.Context,
+	operar func(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error)) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	ordem, err := operar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroManutencao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOrdemManutencaoResponse(ordem))
+}
+
+func statusErroManutencao(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrOrdemManutencaoNaoEncontrada),
+		errors.Is(err, usecase.ErrPlanoManutencaoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrOrdemNaoIniciavel),
+		errors.Is(err, domain.ErrOrdemNaoEmAndamento),
+		errors.Is(err, domain.ErrOrdemNaoCancelavel),
+		errors.Is(err, domain.ErrVeiculoEmViagem):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Registra o check-in de uma viagem
+// @Description  Inicia a viagem agendada com a leitura do odômetro, o nível de combustível, o horário real de saída e fotos opcionais. O odômetro do veículo é atualizado. Exige inspeção de saída do veículo sem itens críticos reprovados.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Param        registro body model.RegistroViagemRequest true "Leituras de saída"
+// @Success      200 {object} model.ViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      409 {object} map[string]string "Viagem não está agendada ou inspeção de saída pendente ou reprovada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/check-in [post]
+func (h *Handler) CheckInViagem(c *gin.Context) {
+	h.registrarOperacao(c, h.operacaoUseCase.CheckIn)
+}
+
+// @Summary      Registra o check-out de uma viagem
+// @Description  Conclui a viagem em andamento com as leituras de chegada e calcula a quilometragem percorrida desde o check-in. O odômetro do veículo é atualizado.
+// @Tags         viagens
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Param        registro body model.RegistroViagemRequest true "Leituras de chegada"
+// @Success      200 {object} model.ViagemResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      409 {object} map[string]string "Viagem não está em andamento"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/check-out [post]
+func (h *Handler) CheckOutViagem(c *gin.Context) {
+	h.registrarOperacao(c, h.operacaoUseCase.CheckOut)
+}
+
+// @Summary      Lista os registros de uma viagem
+// @Description  Retorna o check-in e o check-out da viagem com as leituras informadas
+// @Tags         viagens
+// @Produce      json
+// @Param        id path string true "ID da viagem" format(uuid)
+// @Success      200 {array}  model.RegistroViagemResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Viagem não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /viagens/{id}/registros [get]
+func (h *Handler) ListarRegistrosViagem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	registros, err := h.operacaoUseCase.Registros(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroOperacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.RegistroViagemResponse, len(registros))
+	for i, r := range registros {
+		response[i] = model.NewRegistroViagemResponse(r)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) registrarOperacao(c *gin.Context,
+	registrar func(ctx context.Context, id uuid.UUID, leitura domain.LeituraViagem) (*domain.Viagem, error)) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.RegistroViagemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	viagem, err := registrar(c.Request.Context(), id, req.ToDomain())
+	if err != nil {
+		c.JSON(statusErroOperacao(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewViagemResponse(viagem))
+}
+
+func statusErroOperacao(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrViagemNaoEncontrada),
+		errors.Is(err, usecase.ErrVeiculoNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrCheckInNaoRegistrado),
+		errors.Is(err, domain.ErrViagemNaoIniciavel),
+		errors.Is(err, domain.ErrViagemNaoEmAndamento),
+		errors.Is(err, domain.ErrVeiculoEmManutencao),
+		errors.Is(err, domain.ErrInspecaoSaidaPendente),
+		errors.Is(err, domain.ErrInspecaoSaidaReprovada):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Cria uma política de cancelamento
+// @Description  Cadastra a política padrão (sem cliente) ou a política de um cliente
+// @Tags         politicas-cancelamento
+// @Accept       json
+// @Produce      json
+// @Param        politica body model.CreatePoliticaCancelamentoRequest true "Dados da política"
+// @Success      201 {object} model.PoliticaCancelamentoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Política já cadastrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /politicas-cancelamento [post]
+func (h *Handler) CriarPoliticaCancelamento(c *gin.Context) {
+	var req model.CreatePoliticaCancelamentoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	politica := req.ToDomain()
+	if err := h.politicaUseCase.Criar(c.Request.Context(), politica); err != nil {
+		c.JSON(statusErroPolitica(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewPoliticaCancelamentoResponse(politica))
+}
+
+// @Summary      Lista as políticas de cancelamento
+// @Description  Retorna a política padrão e as políticas por cliente
+// @Tags         politicas-cancelamento
+// @Produce      json
+// @Success      200 {array}  model.PoliticaCancelamentoResponse
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /politicas-cancelamento [get]
+func (h *Handler) ListarPoliticasCancelamento(c *gin.Context) {
+	politicas, err := h.politicaUseCase.Listar(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]*model.PoliticaCancelamentoResponse, len(politicas))
+	for i := range politicas {
+		response[i] = model.NewPoliticaCancelamentoResponse(&politicas[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      Busca uma política de cancelamento pelo ID
+// @Tags         politicas-cancelamento
+// @Produce      json
+// @Param        id path string true "ID da política" format(uuid)
+// @Success      200 {object} model.PoliticaCancelamentoResponse
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Política não encontrada"
+// @Router       /politicas-cancelamento/{id} [get]
+func (h *Handler) BuscarPoliticaCancelamento(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	politica, err := h.politicaUseCase.BuscarPorID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Política não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewPoliticaCancelamentoResponse(politica))
+}
+
+// @Summary      Atualiza uma política de cancelamento
+// @Description  Altera o nome e substitui todas as faixas da política
+// @Tags         politicas-cancelamento
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID da política" format(uuid)
+// @Param        politica body model.UpdatePoliticaCancelamentoRequest true "Dados da política"
+// @Success      200 {object} model.PoliticaCancelamentoResponse
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      404 {object} map[string]string "Política não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /politicas-cancelamento/{id} [put]
+func (h *Handler) AtualizarPoliticaCancelamento(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req model.UpdatePoliticaCancelamentoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	politica, err := h.politicaUseCase.Atualizar(c.Request.Context(), id, req.Nome, req.FaixasDomain())
+	if err != nil {
+		c.JSON(statusErroPolitica(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewPoliticaCancelamentoResponse(politica))
+}
+
+// @Summary      Remove uma política de cancelamento
+// @Tags         politicas-cancelamento
+// @Param        id path string true "ID da política" format(uuid)
+// @Success      204 "No Content"
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Política não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /politicas-cancelamento/{id} [delete]
+func (h *Handler) RemoverPoliticaCancelamento(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	if err := h.politicaUseCase.Remover(c.Request.Context(), id); err != nil {
+		c.JSON(statusErroPolitica(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// statusErroPolitica traduz os erros do caso de uso de políticas em status HTTP
+func statusErroPolitica(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrPoliticaNaoEncontrada):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrPoliticaDuplicada):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"agencia-viagens/internal/delivery/http/model"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary      Cria uma pré-reserva
+// @Description  Bloqueia o veículo e o motorista para o cliente até expira_em. Depois do prazo a pré-reserva expira automaticamente e o responsável é avisado.
+// @Tags         pre-reservas
+// @Accept       json
+// @Produce      json
+// @Param        reserva body model.CreatePreReservaRequest true "Dados da pré-reserva"
+// @Success      201 {object} domain.PreReserva
+// @Failure      400 {object} map[string]string "Dados inválidos"
+// @Failure      409 {object} map[string]string "Veículo ou motorista indisponível"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /pre-reservas [post]
+func (h *Handler) CriarPreReserva(c *gin.Context) {
+	var req model.CreatePreReservaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reserva := req.ToDomain()
+	if err := h.preReservaUseCase.Criar(c.Request.Context(), reserva); err != nil {
+		c.JSON(statusErroPreReserva(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, reserva)
+}
+
+// @Summary      Lista as pré-reservas
+// @Tags         pre-reservas
+// @Produce      json
+// @Param        offset query int false "Deslocamento" default(0)
+// @Param        limit  query int false "Tamanho da página (máx. 100)" default(20)
+// @Success      200 {array}  domain.PreReserva
+// @Failure      400 {object} map[string]string "Parâmetros inválidos"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /pre-reservas [get]
+func (h *Handler) ListarPreReservas(c *gin.Context) {
+	var params model.PreReservaQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reservas, err := h.preReservaUseCase.Listar(c.Request.Context(), params.Offset, params.Limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reservas)
+}
+
+// @Summary      Busca uma pré-reserva pelo ID
+// @Tags         pre-reservas
+// @Produce      json
+// @Param        id path string true "ID da pré-reserva" format(uuid)
+// @Success      200 {object} domain.PreReserva
+// @Failure      400 {object} map[string]string "ID inválido"
+// @Failure      404 {object} map[string]string "Pré-reserva não encontrada"
+// @Router       /pre-reservas/{id} [get]
+func (h *Handler) BuscarPreReserva(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	reserva, err := h.preReservaUseCase.BuscarPorID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Pré-reserva não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, reserva)
+}
+
+// @Summary      Confirma uma pré-reserva
+// @Description  Cria uma viagem agendada com os dados da pré-reserva
+// @Tags         pre-reservas
+// @Produce      json
+// @Param        id path string true "ID da pré-reserva" format(uuid)
+// @Success      201 {object} model.ViagemResponse
+// @Failure      400 {object} map[string]string "Pré-reserva inativa"
+// @Failure      404 {object} map[string]string "Pré-reserva não encontrada"
+// @Failure      409 {object} map[string]string "Veículo ou motorista indisponível"
+// @Failure      422 {object} map[string]interface{} "Violações das regras de jornada do motorista ou pendências de documentação, manutenção ou CNH"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /pre-reservas/{id}/confirmar [post]
+func (h *Handler) ConfirmarPreReserva(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	viagem, err := h.preReservaUseCase.Confirmar(c.Request.Context(), id)
+	if err != nil {
+		if responderErroJornada(c, err) || responderErroConformidade(c, err) {
+			return
+		}
+		c.JSON(statusErroPreReserva(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.NewViagemResponse(viagem))
+}
+
+// @Summary      Libera uma pré-reserva
+// @Description  Desfaz a pré-reserva antes do prazo, liberando o veículo e o motorista
+// @Tags         pre-reservas
+// @Produce      json
+// @Param        id path string true "ID da pré-reserva" format(uuid)
+// @Success      200 {object} domain.PreReserva
+// @Failure      400 {object} map[string]string "Pré-reserva inativa"
+// @Failure      404 {object} map[string]string "Pré-reserva não encontrada"
+// @Failure      500 {object} map[string]string "Erro interno"
+// @Router       /pre-reservas/{id} [delete]
+func (h *Handler) LiberarPreReserva(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	reserva, err := h.preReservaUseCase.Liberar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusErroPreReserva(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reserva)
+}
+
+// statusErroPreReserva traduz os erros do caso de uso de pré-reservas em status HTTP
+func statusErroPreReserva(err error) int {
+	var domainErr *domain.DomainError
+	switch {
+	case errors.Is(err, usecase.ErrPreReservaNaoEncontrada),
+		errors.Is(err, usecase.ErrVeiculoNaoEncontrado),
+		errors.Is(err, usecase.ErrMotoristaNaoEncontrado):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrVeiculoIndisponivel),
+		errors.Is(err, usecase.ErrMotoristaIndisponivel):
+		return http.StatusConflict
+	case errors.As(err, &domainErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
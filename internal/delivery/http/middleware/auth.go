@@ -4,8 +4,9 @@ import (
 	"net/http"
 	"strings"
 
+	"agencia-viagens/internal/auth"
+
 	"github.com/gin-gonic/gin"
-	"github.com/paulopaiva/agencia-viagens/internal/auth"
 )
 
 // Middleware para autenticação JWT
@@ -22,14 +23,43 @@ func AuthRequired() gin.HandlerFunc {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token inválido ou expirado"})
 			return
 		}
-		// Disponibiliza os claims no contexto
-		c.Set("user_id", claims.UserID)
-		c.Set("user_name", claims.Name)
-		c.Set("user_profile", claims.Profile)
+		setClaims(c, claims)
+		c.Next()
+	}
+}
+
+// Middleware que identifica o usuário quando um token é enviado, sem exigi-lo.
+// Um token inválido continua sendo rejeitado.
+func AuthOptional() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+		if !strings.HasPrefix(header, "Bearer ") {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token inválido ou expirado"})
+			return
+		}
+		claims, err := auth.ValidateJWT(strings.TrimPrefix(header, "Bearer "))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token inválido ou expirado"})
+			return
+		}
+		setClaims(c, claims)
 		c.Next()
 	}
 }
 
+// setClaims disponibiliza os claims no contexto do gin e no contexto da
+// requisição, de onde os casos de uso identificam o autor das alterações
+func setClaims(c *gin.Context, claims *auth.Claims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("user_name", claims.Name)
+	c.Set("user_profile", claims.Profile)
+	c.Request = c.Request.WithContext(auth.ContextWithClaims(c.Request.Context(), claims))
+}
+
 // Middleware para autorização por perfil
 func Authorize(profiles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
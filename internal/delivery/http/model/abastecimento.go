@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// RegistrarAbastecimentoRequest representa a requisição de lançamento de um abastecimento
+type RegistrarAbastecimentoRequest struct {
+	Data        *time.Time `json:"data"`
+	Litros      float64    `json:"litros" binding:"required"`
+	PrecoLitro  float64    `json:"preco_litro" binding:"required"`
+	Odometro    int        `json:"odometro" binding:"required"`
+	Posto       string     `json:"posto"`
+	TanqueCheio *bool      `json:"tanque_cheio"`
+	MotoristaID *uuid.UUID `json:"motorista_id"`
+	ViagemID    *uuid.UUID `json:"viagem_id"`
+}
+
+// ToDomain converte a requisição em um abastecimento do veículo. Sem data
+// informada, considera o momento da requisição; sem indicação, o tanque foi
+// completado.
+func (r *RegistrarAbastecimentoRequest) ToDomain(veiculoID uuid.UUID) *domain.Abastecimento {
+	data := time.Now()
+	if r.Data != nil {
+		data = *r.Data
+	}
+
+	tanqueCheio := true
+	if r.TanqueCheio != nil {
+		tanqueCheio = *r.TanqueCheio
+	}
+
+	abastecimento := domain.NewAbastecimento(veiculoID, data, r.Litros, r.PrecoLitro, r.Odometro, r.Posto, tanqueCheio)
+	abastecimento.MotoristaID = r.MotoristaID
+	abastecimento.ViagemID = r.ViagemID
+	return abastecimento
+}
+
+// AbastecimentoResponse representa a resposta de um abastecimento
+type AbastecimentoResponse struct {
+	ID          string                     `json:"id"`
+	VeiculoID   string                     `json:"veiculo_id"`
+	MotoristaID *uuid.UUID                 `json:"motorista_id,omitempty"`
+	ViagemID    *uuid.UUID                 `json:"viagem_id,omitempty"`
+	Data        time.Time                  `json:"data"`
+	Litros      float64                    `json:"litros"`
+	PrecoLitro  float64                    `json:"preco_litro"`
+	ValorTotal  float64                    `json:"valor_total"`
+	Odometro    int                        `json:"odometro"`
+	Posto       string                     `json:"posto,omitempty"`
+	TanqueCheio bool                       `json:"tanque_cheio"`
+	Origem      domain.OrigemAbastecimento `json:"origem"`
+	CreatedAt   time.Time                  `json:"created_at"`
+}
+
+// NewAbastecimentoResponse cria uma nova resposta de abastecimento
+func NewAbastecimentoResponse(a *domain.Abastecimento) *AbastecimentoResponse {
+	return &AbastecimentoResponse{
+		ID:          a.ID.String(),
+		VeiculoID:   a.VeiculoID.String(),
+		MotoristaID: a.MotoristaID,
+		ViagemID:    a.ViagemID,
+		Data:        a.Data,
+		Litros:      a.Litros,
+		PrecoLitro:  a.PrecoLitro,
+		ValorTotal:  a.ValorTotal,
+		Odometro:    a.Odometro,
+		Posto:       a.Posto,
+		TanqueCheio: a.TanqueCheio,
+		Origem:      a.Origem,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+// ConsumoQueryParams representa os parâmetros do relatório de consumo
+type ConsumoQueryParams struct {
+	PeriodoQueryParams
+	VeiculoID string `form:"veiculo_id"`
+}
+
+// Validate implementa a interface Validator
+func (p *ConsumoQueryParams) Validate() error {
+	if err := p.PeriodoQueryParams.Validate(); err != nil {
+		return err
+	}
+
+	if p.VeiculoID != "" {
+		if _, err := uuid.Parse(p.VeiculoID); err != nil {
+			return validator.ErrIDInvalido
+		}
+	}
+
+	return nil
+}
+
+// Veiculo retorna o veículo a que o relatório se restringe, se informado
+func (p *ConsumoQueryParams) Veiculo() *uuid.UUID {
+	return parseUUIDOpcional(p.VeiculoID)
+}
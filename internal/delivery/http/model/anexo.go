@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// EnviarAnexoForm representa os campos do formulário de envio de anexo. O
+// arquivo vem no campo "arquivo".
+type EnviarAnexoForm struct {
+	Entidade   string `form:"entidade" binding:"required"`
+	EntidadeID string `form:"entidade_id" binding:"required,uuid"`
+	Tipo       string `form:"tipo" binding:"required"`
+}
+
+// ToDomain converte o formulário em um anexo, ainda sem conteúdo
+func (f *EnviarAnexoForm) ToDomain(nomeArquivo string) *domain.Anexo {
+	return domain.NewAnexo(domain.EntidadeAnexo(f.Entidade), uuid.MustParse(f.EntidadeID),
+		domain.TipoAnexo(f.Tipo), nomeArquivo)
+}
+
+// AnexoQueryParams identifica o cadastro cujos anexos são listados
+type AnexoQueryParams struct {
+	Entidade   string `form:"entidade" binding:"required"`
+	EntidadeID string `form:"entidade_id" binding:"required,uuid"`
+}
+
+// DownloadAnexoQueryParams traz a expiração e a assinatura do link de download
+type DownloadAnexoQueryParams struct {
+	Expira     int64  `form:"expira" binding:"required"`
+	Assinatura string `form:"assinatura" binding:"required"`
+}
+
+// LinkAnexoResponse representa o link de download assinado
+type LinkAnexoResponse struct {
+	URL      string    `json:"url"`
+	ExpiraEm time.Time `json:"expira_em"`
+}
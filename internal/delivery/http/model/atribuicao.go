@@ -0,0 +1,100 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+)
+
+// SugestaoAtribuicaoRequest representa a requisição de sugestões de veículo e motorista
+type SugestaoAtribuicaoRequest struct {
+	DataInicio             time.Time `json:"data_inicio" binding:"required"`
+	DataFim                time.Time `json:"data_fim" binding:"required"`
+	QuantidadePassageiros  int       `json:"quantidade_passageiros" binding:"required,min=1"`
+	Origem                 string    `json:"origem"`
+	CoordenadasOrigem      string    `json:"coordenadas_origem"`
+	ComodidadesExigidas    []string  `json:"comodidades_exigidas"`
+	DirecaoPrevistaMinutos int       `json:"direcao_prevista_minutos" binding:"min=0"`
+	Limite                 int       `json:"limite" binding:"min=0,max=50"`
+}
+
+// Validate implementa a interface Validator
+func (r *SugestaoAtribuicaoRequest) Validate() error {
+	return validator.ValidarPeriodo(r.DataInicio, r.DataFim)
+}
+
+// ToDomain converte a requisição em uma solicitação de atribuição
+func (r *SugestaoAtribuicaoRequest) ToDomain() domain.SolicitacaoAtribuicao {
+	return domain.SolicitacaoAtribuicao{
+		DataInicio:             r.DataInicio,
+		DataFim:                r.DataFim,
+		QuantidadePassageiros:  r.QuantidadePassageiros,
+		Origem:                 r.Origem,
+		CoordenadasOrigem:      r.CoordenadasOrigem,
+		ComodidadesExigidas:    r.ComodidadesExigidas,
+		DirecaoPrevistaMinutos: r.DirecaoPrevistaMinutos,
+	}
+}
+
+// VeiculoSugeridoResponse resume o veículo de uma sugestão
+type VeiculoSugeridoResponse struct {
+	ID                string             `json:"id"`
+	Placa             string             `json:"placa"`
+	Modelo            string             `json:"modelo"`
+	Tipo              domain.TipoVeiculo `json:"tipo"`
+	Capacidade        int                `json:"capacidade"`
+	ProximaManutencao time.Time          `json:"proxima_manutencao"`
+}
+
+// MotoristaSugeridoResponse resume o motorista de uma sugestão
+type MotoristaSugeridoResponse struct {
+	ID          string         `json:"id"`
+	Nome        string         `json:"nome"`
+	TipoCNH     domain.TipoCNH `json:"tipo_cnh"`
+	ValidadeCNH time.Time      `json:"validade_cnh"`
+}
+
+// SugestaoAtribuicaoResponse representa um par veículo/motorista sugerido
+type SugestaoAtribuicaoResponse struct {
+	Veiculo             VeiculoSugeridoResponse    `json:"veiculo"`
+	Motorista           MotoristaSugeridoResponse  `json:"motorista"`
+	MotoristaSecundario *MotoristaSugeridoResponse `json:"motorista_secundario,omitempty"`
+	Pontuacao           float64                    `json:"pontuacao"`
+	Observacoes         []string                   `json:"observacoes,omitempty"`
+}
+
+// NewSugestaoAtribuicaoResponse cria uma nova resposta de sugestão
+func NewSugestaoAtribuicaoResponse(s *domain.SugestaoAtribuicao) *SugestaoAtribuicaoResponse {
+	response := &SugestaoAtribuicaoResponse{
+		Veiculo:     newVeiculoSugeridoResponse(s.Veiculo),
+		Motorista:   newMotoristaSugeridoResponse(s.Motorista),
+		Pontuacao:   s.Pontuacao,
+		Observacoes: s.Observacoes,
+	}
+	if s.MotoristaSecundario != nil {
+		secundario := newMotoristaSugeridoResponse(s.MotoristaSecundario)
+		response.MotoristaSecundario = &secundario
+	}
+	return response
+}
+
+func newVeiculoSugeridoResponse(v *domain.Veiculo) VeiculoSugeridoResponse {
+	return VeiculoSugeridoResponse{
+		ID:                v.ID.String(),
+		Placa:             v.Placa,
+		Modelo:            v.Modelo,
+		Tipo:              v.Tipo,
+		Capacidade:        v.Capacidade,
+		ProximaManutencao: v.ProximaManutencao,
+	}
+}
+
+func newMotoristaSugeridoResponse(m *domain.Motorista) MotoristaSugeridoResponse {
+	return MotoristaSugeridoResponse{
+		ID:          m.ID.String(),
+		Nome:        m.Nome,
+		TipoCNH:     m.TipoCNH,
+		ValidadeCNH: m.ValidadeCNH,
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+)
+
+// LancamentoHorasRequest representa a requisição de ajuste ou compensação do banco de horas
+type LancamentoHorasRequest struct {
+	Data      time.Time `json:"data" binding:"required"`
+	Minutos   int       `json:"minutos" binding:"required"`
+	Descricao string    `json:"descricao"`
+}
+
+// ExtratoBancoHorasQueryParams representa os parâmetros do extrato do banco de horas
+type ExtratoBancoHorasQueryParams struct {
+	PeriodoQueryParams
+	Agrupamento string `form:"agrupamento,default=SEMANA"`
+}
+
+// Validate implementa a interface Validator
+func (p *ExtratoBancoHorasQueryParams) Validate() error {
+	if err := p.PeriodoQueryParams.Validate(); err != nil {
+		return err
+	}
+	return domain.AgrupamentoHoras(p.Agrupamento).Validar()
+}
+
+// BancoHorasExcedidoQueryParams representa os parâmetros da consulta de motoristas com banco de horas excedido
+type BancoHorasExcedidoQueryParams struct {
+	LimiteHoras int `form:"limite_horas" binding:"required"`
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"agencia-viagens/internal/domain"
+)
+
+// ItemChecklistRequest representa um item do modelo de checklist
+type ItemChecklistRequest struct {
+	Codigo    string `json:"codigo" binding:"required"`
+	Descricao string `json:"descricao" binding:"required"`
+	Critico   bool   `json:"critico"`
+}
+
+// DefinirModeloChecklistRequest representa a requisição de definição do
+// checklist de um tipo de veículo
+type DefinirModeloChecklistRequest struct {
+	Itens           []ItemChecklistRequest `json:"itens" binding:"required,min=1,dive"`
+	AbrirManutencao bool                   `json:"abrir_manutencao"`
+}
+
+// ToDomain converte a requisição em um modelo de checklist do tipo de veículo
+func (r *DefinirModeloChecklistRequest) ToDomain(tipo domain.TipoVeiculo) *domain.ModeloChecklist {
+	itens := make([]domain.ItemChecklist, len(r.Itens))
+	for i, item := range r.Itens {
+		itens[i] = domain.ItemChecklist{Codigo: item.Codigo, Descricao: item.Descricao, Critico: item.Critico}
+	}
+	return domain.NewModeloChecklist(tipo, itens, r.AbrirManutencao)
+}
+
+// RespostaItemChecklistRequest representa a conferência de um item
+type RespostaItemChecklistRequest struct {
+	Codigo     string `json:"codigo" binding:"required"`
+	Conforme   bool   `json:"conforme"`
+	Observacao string `json:"observacao"`
+}
+
+// RegistrarInspecaoRequest representa a inspeção de saída feita pelo motorista
+type RegistrarInspecaoRequest struct {
+	Itens       []RespostaItemChecklistRequest `json:"itens" binding:"required,min=1,dive"`
+	Observacoes string                         `json:"observacoes"`
+}
+
+// Respostas converte os itens da requisição em respostas do checklist
+func (r *RegistrarInspecaoRequest) Respostas() []domain.RespostaItemChecklist {
+	respostas := make([]domain.RespostaItemChecklist, len(r.Itens))
+	for i, item := range r.Itens {
+		respostas[i] = domain.RespostaItemChecklist{Codigo: item.Codigo, Conforme: item.Conforme, Observacao: item.Observacao}
+	}
+	return respostas
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"agencia-viagens/internal/domain"
+)
+
+// CriarComodidadeRequest representa a requisição de inclusão de comodidade no catálogo
+type CriarComodidadeRequest struct {
+	Codigo         string `json:"codigo" binding:"required"`
+	Nome           string `json:"nome" binding:"required"`
+	Acessibilidade bool   `json:"acessibilidade"`
+}
+
+// ToDomain converte a requisição em uma comodidade
+func (r *CriarComodidadeRequest) ToDomain() *domain.Comodidade {
+	return domain.NewComodidade(r.Codigo, r.Nome, r.Acessibilidade)
+}
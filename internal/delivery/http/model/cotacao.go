@@ -0,0 +1,186 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// CotacaoRequest representa a requisição de cotação de viagem
+type CotacaoRequest struct {
+	ClienteID   uuid.UUID          `json:"cliente_id" binding:"required"`
+	TipoVeiculo domain.TipoVeiculo `json:"tipo_veiculo" binding:"required"`
+	Origem      string             `json:"origem" binding:"required"`
+	Destino     string             `json:"destino" binding:"required"`
+	DataInicio  time.Time          `json:"data_inicio" binding:"required"`
+	DataFim     time.Time          `json:"data_fim" binding:"required"`
+	DistanciaKm float64            `json:"distancia_km" binding:"required,gt=0"`
+	HorasEspera float64            `json:"horas_espera" binding:"min=0"`
+	Pedagios    float64            `json:"pedagios" binding:"min=0"`
+}
+
+// Validate implementa a interface Validator
+func (r *CotacaoRequest) Validate() error {
+	if err := validator.ValidarPeriodo(r.DataInicio, r.DataFim); err != nil {
+		return err
+	}
+
+	if err := validator.ValidarTipoVeiculo(r.TipoVeiculo); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToDomain converte a requisição em uma cotação
+func (r *CotacaoRequest) ToDomain() *domain.Cotacao {
+	return domain.NewCotacao(r.ClienteID, r.TipoVeiculo, r.Origem, r.Destino,
+		r.DataInicio, r.DataFim, r.DistanciaKm, r.HorasEspera, r.Pedagios)
+}
+
+// ItemCotacaoResponse representa uma linha do detalhamento da cotação
+type ItemCotacaoResponse struct {
+	Descricao     string  `json:"descricao"`
+	Quantidade    float64 `json:"quantidade"`
+	ValorUnitario float64 `json:"valor_unitario"`
+	Valor         float64 `json:"valor"`
+}
+
+// CotacaoResponse representa a resposta de cotação
+type CotacaoResponse struct {
+	ID            string                `json:"id"`
+	ClienteID     string                `json:"cliente_id"`
+	TipoVeiculo   domain.TipoVeiculo    `json:"tipo_veiculo"`
+	TabelaPrecoID string                `json:"tabela_preco_id,omitempty"`
+	Origem        string                `json:"origem"`
+	Destino       string                `json:"destino"`
+	DataInicio    time.Time             `json:"data_inicio"`
+	DataFim       time.Time             `json:"data_fim"`
+	DistanciaKm   float64               `json:"distancia_km"`
+	HorasEspera   float64               `json:"horas_espera"`
+	Pedagios      float64               `json:"pedagios"`
+	Itens         []ItemCotacaoResponse `json:"itens"`
+	ValorTotal    float64               `json:"valor_total"`
+	Status        domain.StatusCotacao  `json:"status"`
+	ValidaAte     time.Time             `json:"valida_ate"`
+	ViagemID      string                `json:"viagem_id,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// NewCotacaoResponse cria uma nova resposta de cotação
+func NewCotacaoResponse(c *domain.Cotacao) *CotacaoResponse {
+	response := &CotacaoResponse{
+		ID:          c.ID.String(),
+		ClienteID:   c.ClienteID.String(),
+		TipoVeiculo: c.TipoVeiculo,
+		Origem:      c.Origem,
+		Destino:     c.Destino,
+		DataInicio:  c.DataInicio,
+		DataFim:     c.DataFim,
+		DistanciaKm: c.DistanciaKm,
+		HorasEspera: c.HorasEspera,
+		Pedagios:    c.Pedagios,
+		Itens:       make([]ItemCotacaoResponse, len(c.Itens)),
+		ValorTotal:  c.ValorTotal,
+		Status:      c.Status,
+		ValidaAte:   c.ValidaAte,
+		CreatedAt:   c.CreatedAt,
+	}
+
+	if c.TabelaPrecoID != nil {
+		response.TabelaPrecoID = c.TabelaPrecoID.String()
+	}
+
+	if c.ViagemID != nil {
+		response.ViagemID = c.ViagemID.String()
+	}
+
+	for i, item := range c.Itens {
+		response.Itens[i] = ItemCotacaoResponse{
+			Descricao:     item.Descricao,
+			Quantidade:    item.Quantidade,
+			ValorUnitario: item.ValorUnitario,
+			Valor:         item.Valor,
+		}
+	}
+
+	return response
+}
+
+// TabelaPrecoRequest representa os valores de uma tabela de preços
+type TabelaPrecoRequest struct {
+	ValorKm           float64 `json:"valor_km" binding:"min=0"`
+	ValorHora         float64 `json:"valor_hora" binding:"min=0"`
+	ValorHoraEspera   float64 `json:"valor_hora_espera" binding:"min=0"`
+	ValorDiaria       float64 `json:"valor_diaria" binding:"min=0"`
+	ValorMinimo       float64 `json:"valor_minimo" binding:"min=0"`
+	PercentualNoturno float64 `json:"percentual_noturno" binding:"min=0"`
+	PercentualFeriado float64 `json:"percentual_feriado" binding:"min=0"`
+}
+
+// ToDomain converte a requisição em uma tabela de preços
+func (r *TabelaPrecoRequest) ToDomain(clienteID *uuid.UUID, tipo domain.TipoVeiculo) *domain.TabelaPreco {
+	tabela := domain.NewTabelaPreco(clienteID, tipo, r.ValorKm, r.ValorHora, r.ValorHoraEspera, r.ValorDiaria)
+	tabela.ValorMinimo = r.ValorMinimo
+	tabela.PercentualNoturno = r.PercentualNoturno
+	tabela.PercentualFeriado = r.PercentualFeriado
+	return tabela
+}
+
+// CreateTabelaPrecoRequest representa a requisição de criação de tabela de preços
+type CreateTabelaPrecoRequest struct {
+	ClienteID   *uuid.UUID         `json:"cliente_id"`
+	TipoVeiculo domain.TipoVeiculo `json:"tipo_veiculo" binding:"required"`
+	TabelaPrecoRequest
+}
+
+// Validate implementa a interface Validator
+func (r *CreateTabelaPrecoRequest) Validate() error {
+	return validator.ValidarTipoVeiculo(r.TipoVeiculo)
+}
+
+// TabelaPrecoResponse representa a resposta de tabela de preços
+type TabelaPrecoResponse struct {
+	ID          string             `json:"id"`
+	ClienteID   string             `json:"cliente_id,omitempty"`
+	TipoVeiculo domain.TipoVeiculo `json:"tipo_veiculo"`
+	Padrao      bool               `json:"padrao"`
+	TabelaPrecoRequest
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTabelaPrecoResponse cria uma nova resposta de tabela de preços
+func NewTabelaPrecoResponse(t *domain.TabelaPreco) *TabelaPrecoResponse {
+	response := &TabelaPrecoResponse{
+		ID:          t.ID.String(),
+		TipoVeiculo: t.TipoVeiculo,
+		Padrao:      t.ClienteID == nil,
+		TabelaPrecoRequest: TabelaPrecoRequest{
+			ValorKm:           t.ValorKm,
+			ValorHora:         t.ValorHora,
+			ValorHoraEspera:   t.ValorHoraEspera,
+			ValorDiaria:       t.ValorDiaria,
+			ValorMinimo:       t.ValorMinimo,
+			PercentualNoturno: t.PercentualNoturno,
+			PercentualFeriado: t.PercentualFeriado,
+		},
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+
+	if t.ClienteID != nil {
+		response.ClienteID = t.ClienteID.String()
+	}
+
+	return response
+}
+
+// CreateFeriadoRequest representa a requisição de cadastro de feriado
+type CreateFeriadoRequest struct {
+	Data      time.Time `json:"data" binding:"required"`
+	Descricao string    `json:"descricao" binding:"required"`
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// RegistrarCustoFixoRequest representa a requisição de registro de um custo fixo do veículo
+type RegistrarCustoFixoRequest struct {
+	Tipo         domain.TipoCustoFixo `json:"tipo" binding:"required"`
+	Descricao    string               `json:"descricao"`
+	ValorMensal  float64              `json:"valor_mensal" binding:"required"`
+	VigenteDesde time.Time            `json:"vigente_desde" binding:"required"`
+	VigenteAte   *time.Time           `json:"vigente_ate"`
+}
+
+// ToDomain converte a requisição em um custo fixo do veículo
+func (r *RegistrarCustoFixoRequest) ToDomain(veiculoID uuid.UUID) *domain.CustoFixoVeiculo {
+	custo := domain.NewCustoFixoVeiculo(veiculoID, r.Tipo, r.ValorMensal, r.VigenteDesde)
+	custo.Descricao = r.Descricao
+	custo.VigenteAte = r.VigenteAte
+	return custo
+}
+
+// RentabilidadeQueryParams representa os parâmetros do relatório de rentabilidade da frota
+type RentabilidadeQueryParams struct {
+	PeriodoQueryParams
+	Tipo    string `form:"tipo"`
+	Formato string `form:"formato,default=json"`
+}
+
+// Validate implementa a interface Validator
+func (p *RentabilidadeQueryParams) Validate() error {
+	if err := p.PeriodoQueryParams.Validate(); err != nil {
+		return err
+	}
+
+	if p.Tipo != "" {
+		if err := validator.ValidarTipoVeiculo(domain.TipoVeiculo(p.Tipo)); err != nil {
+			return err
+		}
+	}
+
+	if p.Formato != "json" && p.Formato != "csv" {
+		return validator.ErrFormatoInvalido
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// RegistrarDespesaRequest representa a requisição de lançamento de uma despesa
+type RegistrarDespesaRequest struct {
+	MotoristaID   *uuid.UUID              `json:"motorista_id"`
+	Categoria     domain.CategoriaDespesa `json:"categoria" binding:"required"`
+	Valor         float64                 `json:"valor" binding:"required"`
+	PagoPor       domain.PagamentoDespesa `json:"pago_por" binding:"required"`
+	RealizadaEm   *time.Time              `json:"realizada_em"`
+	ComprovanteID *uuid.UUID              `json:"comprovante_id"` // anexo COMPROVANTE da viagem
+	Descricao     string                  `json:"descricao"`
+}
+
+// ToDomain converte a requisição em uma despesa da viagem. Sem data
+// informada, considera o momento da requisição.
+func (r *RegistrarDespesaRequest) ToDomain(viagemID uuid.UUID) *domain.DespesaViagem {
+	realizadaEm := time.Now()
+	if r.RealizadaEm != nil {
+		realizadaEm = *r.RealizadaEm
+	}
+
+	var motoristaID uuid.UUID
+	if r.MotoristaID != nil {
+		motoristaID = *r.MotoristaID
+	}
+
+	despesa := domain.NewDespesaViagem(viagemID, motoristaID, r.Categoria, r.Valor, r.PagoPor, realizadaEm)
+	despesa.ComprovanteID = r.ComprovanteID
+	despesa.Descricao = r.Descricao
+	return despesa
+}
+
+// RejeitarDespesaRequest representa a requisição de rejeição de uma despesa
+type RejeitarDespesaRequest struct {
+	Motivo string `json:"motivo" binding:"required"`
+}
+
+// DespesaViagemResponse representa a resposta de uma despesa de viagem
+type DespesaViagemResponse struct {
+	ID             string                  `json:"id"`
+	ViagemID       string                  `json:"viagem_id"`
+	MotoristaID    string                  `json:"motorista_id"`
+	Categoria      domain.CategoriaDespesa `json:"categoria"`
+	Valor          float64                 `json:"valor"`
+	PagoPor        domain.PagamentoDespesa `json:"pago_por"`
+	RealizadaEm    time.Time               `json:"realizada_em"`
+	ComprovanteID  *uuid.UUID              `json:"comprovante_id,omitempty"`
+	Descricao      string                  `json:"descricao,omitempty"`
+	Status         domain.StatusDespesa    `json:"status"`
+	AvaliadorNome  string                  `json:"avaliador_nome,omitempty"`
+	AvaliadaEm     *time.Time              `json:"avaliada_em,omitempty"`
+	MotivoRejeicao string                  `json:"motivo_rejeicao,omitempty"`
+	ReembolsadaEm  *time.Time              `json:"reembolsada_em,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+// NewDespesaViagemResponse cria uma nova resposta de despesa de viagem
+func NewDespesaViagemResponse(d *domain.DespesaViagem) *DespesaViagemResponse {
+	return &DespesaViagemResponse{
+		ID:             d.ID.String(),
+		ViagemID:       d.ViagemID.String(),
+		MotoristaID:    d.MotoristaID.String(),
+		Categoria:      d.Categoria,
+		Valor:          d.Valor,
+		PagoPor:        d.PagoPor,
+		RealizadaEm:    d.RealizadaEm,
+		ComprovanteID:  d.ComprovanteID,
+		Descricao:      d.Descricao,
+		Status:         d.Status,
+		AvaliadorNome:  d.AvaliadorNome,
+		AvaliadaEm:     d.AvaliadaEm,
+		MotivoRejeicao: d.MotivoRejeicao,
+		ReembolsadaEm:  d.ReembolsadaEm,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// ResumoReembolsoResponse representa o resumo de reembolso de um motorista
+type ResumoReembolsoResponse struct {
+	MotoristaID      string                   `json:"motorista_id"`
+	DataInicio       time.Time                `json:"data_inicio"`
+	DataFim          time.Time                `json:"data_fim"`
+	TotalPendente    float64                  `json:"total_pendente"`
+	TotalAprovado    float64                  `json:"total_aprovado"`
+	TotalReembolsado float64                  `json:"total_reembolsado"`
+	TotalRejeitado   float64                  `json:"total_rejeitado"`
+	Despesas         []*DespesaViagemResponse `json:"despesas"`
+}
+
+// NewResumoReembolsoResponse cria uma nova resposta de resumo de reembolso
+func NewResumoReembolsoResponse(r *domain.ResumoReembolso) *ResumoReembolsoResponse {
+	response := &ResumoReembolsoResponse{
+		MotoristaID:      r.MotoristaID.String(),
+		DataInicio:       r.DataInicio,
+		DataFim:          r.DataFim,
+		TotalPendente:    r.TotalPendente,
+		TotalAprovado:    r.TotalAprovado,
+		TotalReembolsado: r.TotalReembolsado,
+		TotalRejeitado:   r.TotalRejeitado,
+		Despesas:         make([]*DespesaViagemResponse, len(r.Despesas)),
+	}
+
+	for i, d := range r.Despesas {
+		response.Despesas[i] = NewDespesaViagemResponse(d)
+	}
+
+	return response
+}
+
+// PeriodoQueryParams representa um período informado na query
+type PeriodoQueryParams struct {
+	DataInicio time.Time `form:"data_inicio" binding:"required"`
+	DataFim    time.Time `form:"data_fim" binding:"required"`
+}
+
+// Validate implementa a interface Validator
+func (p *PeriodoQueryParams) Validate() error {
+	// Consultas aceitam períodos passados, então basta a ordem das datas
+	if p.DataInicio.After(p.DataFim) {
+		return validator.ErrPeriodoInvalido
+	}
+	return nil
+}
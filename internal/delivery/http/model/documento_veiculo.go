@@ -0,0 +1,83 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// RegistrarDocumentoVeiculoRequest representa a requisição de cadastro de um documento do veículo
+type RegistrarDocumentoVeiculoRequest struct {
+	Tipo        domain.TipoDocumentoVeiculo `json:"tipo" binding:"required"`
+	Numero      string                      `json:"numero" binding:"required"`
+	UF          string                      `json:"uf"`
+	EmitidoEm   time.Time                   `json:"emitido_em" binding:"required"`
+	ValidoAte   time.Time                   `json:"valido_ate" binding:"required"`
+	ArquivoURL  string                      `json:"arquivo_url"`
+	Observacoes string                      `json:"observacoes"`
+}
+
+// ToDomain converte a requisição em um documento do veículo
+func (r *RegistrarDocumentoVeiculoRequest) ToDomain(veiculoID uuid.UUID) *domain.DocumentoVeiculo {
+	documento := domain.NewDocumentoVeiculo(veiculoID, r.Tipo, r.Numero, r.EmitidoEm, r.ValidoAte)
+	documento.UF = strings.ToUpper(r.UF)
+	documento.ArquivoURL = r.ArquivoURL
+	documento.Observacoes = r.Observacoes
+	return documento
+}
+
+// AtualizarDocumentoVeiculoRequest representa a requisição de correção de um documento do veículo
+type AtualizarDocumentoVeiculoRequest struct {
+	Numero      string    `json:"numero" binding:"required"`
+	UF          string    `json:"uf"`
+	EmitidoEm   time.Time `json:"emitido_em" binding:"required"`
+	ValidoAte   time.Time `json:"valido_ate" binding:"required"`
+	ArquivoURL  string    `json:"arquivo_url"`
+	Observacoes string    `json:"observacoes"`
+}
+
+// ToDomain converte a requisição no documento corrigido
+func (r *AtualizarDocumentoVeiculoRequest) ToDomain(id uuid.UUID) *domain.DocumentoVeiculo {
+	return &domain.DocumentoVeiculo{
+		ID:          id,
+		Numero:      r.Numero,
+		UF:          strings.ToUpper(r.UF),
+		EmitidoEm:   r.EmitidoEm,
+		ValidoAte:   r.ValidoAte,
+		ArquivoURL:  r.ArquivoURL,
+		Observacoes: r.Observacoes,
+	}
+}
+
+// DocumentoVeiculoResponse representa a resposta de um documento do veículo
+type DocumentoVeiculoResponse struct {
+	ID          string                      `json:"id"`
+	VeiculoID   string                      `json:"veiculo_id"`
+	Tipo        domain.TipoDocumentoVeiculo `json:"tipo"`
+	Numero      string                      `json:"numero"`
+	UF          string                      `json:"uf,omitempty"`
+	EmitidoEm   time.Time                   `json:"emitido_em"`
+	ValidoAte   time.Time                   `json:"valido_ate"`
+	Vencido     bool                        `json:"vencido"`
+	ArquivoURL  string                      `json:"arquivo_url,omitempty"`
+	Observacoes string                      `json:"observacoes,omitempty"`
+}
+
+// NewDocumentoVeiculoResponse cria uma nova resposta de documento do veículo
+func NewDocumentoVeiculoResponse(d *domain.DocumentoVeiculo) *DocumentoVeiculoResponse {
+	return &DocumentoVeiculoResponse{
+		ID:          d.ID.String(),
+		VeiculoID:   d.VeiculoID.String(),
+		Tipo:        d.Tipo,
+		Numero:      d.Numero,
+		UF:          d.UF,
+		EmitidoEm:   d.EmitidoEm,
+		ValidoAte:   d.ValidoAte,
+		Vencido:     d.Vencido(time.Now()),
+		ArquivoURL:  d.ArquivoURL,
+		Observacoes: d.Observacoes,
+	}
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+)
+
+// EventoViagemResponse representa uma entrada do histórico de uma viagem
+type EventoViagemResponse struct {
+	ID         string                   `json:"id"`
+	ViagemID   string                   `json:"viagem_id"`
+	Tipo       domain.TipoEventoViagem  `json:"tipo"`
+	Ator       domain.Ator              `json:"ator"`
+	Descricao  string                   `json:"descricao,omitempty"`
+	Alteracoes []domain.AlteracaoEvento `json:"alteracoes,omitempty"`
+	OcorridoEm time.Time                `json:"ocorrido_em"`
+}
+
+// NewEventoViagemResponse cria uma nova resposta de evento de viagem
+func NewEventoViagemResponse(e *domain.EventoViagem) *EventoViagemResponse {
+	return &EventoViagemResponse{
+		ID:         e.ID.String(),
+		ViagemID:   e.ViagemID.String(),
+		Tipo:       e.Tipo,
+		Ator:       e.Ator,
+		Descricao:  e.Descricao,
+		Alteracoes: e.Alteracoes,
+		OcorridoEm: e.OcorridoEm,
+	}
+}
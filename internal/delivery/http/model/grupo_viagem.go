@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// CreateGrupoViagemRequest representa a requisição de criação de um grupo de viagem
+type CreateGrupoViagemRequest struct {
+	ClienteID             uuid.UUID `json:"cliente_id" binding:"required"`
+	QuantidadePassageiros int       `json:"quantidade_passageiros" binding:"required,min=1"`
+	Origem                string    `json:"origem" binding:"required"`
+	Destino               string    `json:"destino" binding:"required"`
+	DataInicio            time.Time `json:"data_inicio" binding:"required"`
+	DataFim               time.Time `json:"data_fim" binding:"required"`
+	Valor                 float64   `json:"valor" binding:"required"`
+	Observacoes           string    `json:"observacoes"`
+	ComodidadesExigidas   []string  `json:"comodidades_exigidas"`
+}
+
+// Validate implementa a interface Validator
+func (r *CreateGrupoViagemRequest) Validate() error {
+	if err := validator.ValidarPeriodo(r.DataInicio, r.DataFim); err != nil {
+		return err
+	}
+
+	if err := validator.ValidarValor(r.Valor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToDomain converte a requisição em um grupo de viagem
+func (r *CreateGrupoViagemRequest) ToDomain() *domain.GrupoViagem {
+	grupo := domain.NewGrupoViagem(r.ClienteID, r.QuantidadePassageiros, r.Origem, r.Destino,
+		r.DataInicio, r.DataFim, r.Valor)
+	grupo.Observacoes = r.Observacoes
+	grupo.ComodidadesExigidas = r.ComodidadesExigidas
+	return grupo
+}
+
+// ReagendarGrupoViagemRequest representa a requisição de reagendamento de um grupo
+type ReagendarGrupoViagemRequest struct {
+	DataInicio time.Time `json:"data_inicio" binding:"required"`
+	DataFim    time.Time `json:"data_fim" binding:"required"`
+}
+
+// Validate implementa a interface Validator
+func (r *ReagendarGrupoViagemRequest) Validate() error {
+	return validator.ValidarPeriodo(r.DataInicio, r.DataFim)
+}
+
+// GrupoViagemResponse representa a resposta de grupo de viagem
+type GrupoViagemResponse struct {
+	ID                    string              `json:"id"`
+	ClienteID             string              `json:"cliente_id"`
+	QuantidadePassageiros int                 `json:"quantidade_passageiros"`
+	Origem                string              `json:"origem"`
+	Destino               string              `json:"destino"`
+	DataInicio            time.Time           `json:"data_inicio"`
+	DataFim               time.Time           `json:"data_fim"`
+	Valor                 float64             `json:"valor"`
+	Status                domain.StatusViagem `json:"status"`
+	Observacoes           string              `json:"observacoes"`
+	ComodidadesExigidas   []string            `json:"comodidades_exigidas,omitempty"`
+	Viagens               []*ViagemResponse   `json:"viagens"`
+	CreatedAt             time.Time           `json:"created_at"`
+	UpdatedAt             time.Time           `json:"updated_at"`
+}
+
+// NewGrupoViagemResponse cria uma nova resposta de grupo de viagem
+func NewGrupoViagemResponse(g *domain.GrupoViagem) *GrupoViagemResponse {
+	response := &GrupoViagemResponse{
+		ID:                    g.ID.String(),
+		ClienteID:             g.ClienteID.String(),
+		QuantidadePassageiros: g.QuantidadePassageiros,
+		Origem:                g.Origem,
+		Destino:               g.Destino,
+		DataInicio:            g.DataInicio,
+		DataFim:               g.DataFim,
+		Valor:                 g.Valor,
+		Status:                g.Status,
+		Observacoes:           g.Observacoes,
+		ComodidadesExigidas:   g.ComodidadesExigidas,
+		Viagens:               make([]*ViagemResponse, len(g.Viagens)),
+		CreatedAt:             g.CreatedAt,
+		UpdatedAt:             g.UpdatedAt,
+	}
+
+	for i, v := range g.Viagens {
+		response.Viagens[i] = NewViagemResponse(v)
+	}
+
+	return response
+}
+
+// GrupoViagemQueryParams representa os parâmetros de query para listagem de grupos
+type GrupoViagemQueryParams struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// ProgramarIndisponibilidadeRequest representa a requisição de programação de uma indisponibilidade do veículo
+type ProgramarIndisponibilidadeRequest struct {
+	Motivo     domain.MotivoIndisponibilidade `json:"motivo" binding:"required"`
+	Descricao  string                         `json:"descricao"`
+	DataInicio time.Time                      `json:"data_inicio" binding:"required"`
+	DataFim    time.Time                      `json:"data_fim" binding:"required"`
+}
+
+// Validate implementa a interface Validator
+func (r *ProgramarIndisponibilidadeRequest) Validate() error {
+	return validator.ValidarPeriodo(r.DataInicio, r.DataFim)
+}
+
+// ToDomain converte a requisição em uma indisponibilidade do veículo
+func (r *ProgramarIndisponibilidadeRequest) ToDomain(veiculoID uuid.UUID) *domain.IndisponibilidadeVeiculo {
+	return domain.NewIndisponibilidadeVeiculo(veiculoID, r.Motivo, r.Descricao, r.DataInicio, r.DataFim)
+}
+
+// IndisponibilidadeVeiculoResponse representa a resposta de uma indisponibilidade do veículo
+type IndisponibilidadeVeiculoResponse struct {
+	ID              string                         `json:"id"`
+	VeiculoID       string                         `json:"veiculo_id"`
+	Motivo          domain.MotivoIndisponibilidade `json:"motivo"`
+	Descricao       string                         `json:"descricao,omitempty"`
+	DataInicio      time.Time                      `json:"data_inicio"`
+	DataFim         time.Time                      `json:"data_fim"`
+	ResponsavelNome string                         `json:"responsavel_nome,omitempty"`
+}
+
+// NewIndisponibilidadeVeiculoResponse cria uma nova resposta de indisponibilidade do veículo
+func NewIndisponibilidadeVeiculoResponse(i *domain.IndisponibilidadeVeiculo) *IndisponibilidadeVeiculoResponse {
+	return &IndisponibilidadeVeiculoResponse{
+		ID:              i.ID.String(),
+		VeiculoID:       i.VeiculoID.String(),
+		Motivo:          i.Motivo,
+		Descricao:       i.Descricao,
+		DataInicio:      i.DataInicio,
+		DataFim:         i.DataFim,
+		ResponsavelNome: i.ResponsavelNome,
+	}
+}
+
+// IndisponibilidadesQueryParams representa o período de consulta das
+// indisponibilidades. Sem início, parte de agora; sem fim, cobre um ano.
+type IndisponibilidadesQueryParams struct {
+	DataInicio *time.Time `form:"data_inicio"`
+	DataFim    *time.Time `form:"data_fim"`
+}
+
+// Periodo retorna o período consultado
+func (p *IndisponibilidadesQueryParams) Periodo() (time.Time, time.Time) {
+	inicio := time.Now()
+	if p.DataInicio != nil {
+		inicio = *p.DataInicio
+	}
+	fim := inicio.AddDate(1, 0, 0)
+	if p.DataFim != nil {
+		fim = *p.DataFim
+	}
+	return inicio, fim
+}
+
+// Validate implementa a interface Validator
+func (p *IndisponibilidadesQueryParams) Validate() error {
+	inicio, fim := p.Periodo()
+	return validator.ValidarPeriodo(inicio, fim)
+}
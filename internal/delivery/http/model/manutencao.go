@@ -0,0 +1,151 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// AbrirOrdemManutencaoRequest representa a requisição de abertura de uma ordem de manutenção
+type AbrirOrdemManutencaoRequest struct {
+	Tipo           domain.TipoManutencao `json:"tipo" binding:"required"`
+	Descricao      string                `json:"descricao" binding:"required"`
+	PlanoID        *uuid.UUID            `json:"plano_id"`
+	Fornecedor     string                `json:"fornecedor"`
+	CustoPecas     float64               `json:"custo_pecas" binding:"min=0"`
+	CustoMaoDeObra float64               `json:"custo_mao_de_obra" binding:"min=0"`
+	AgendadaPara   time.Time             `json:"agendada_para"`
+	Observacoes    string                `json:"observacoes"`
+}
+
+// ToDomain converte a requisição em uma ordem de manutenção do veículo
+func (r *AbrirOrdemManutencaoRequest) ToDomain(veiculoID uuid.UUID) *domain.OrdemManutencao {
+	ordem := domain.NewOrdemManutencao(veiculoID, r.Tipo, r.Descricao, r.AgendadaPara)
+	ordem.PlanoID = r.PlanoID
+	ordem.Fornecedor = r.Fornecedor
+	ordem.CustoPecas = r.CustoPecas
+	ordem.CustoMaoDeObra = r.CustoMaoDeObra
+	ordem.Observacoes = r.Observacoes
+	return ordem
+}
+
+// ConcluirOrdemManutencaoRequest representa os custos finais e o odômetro no serviço
+type ConcluirOrdemManutencaoRequest struct {
+	Odometro       int     `json:"odometro" binding:"min=0"`
+	CustoPecas     float64 `json:"custo_pecas" binding:"min=0"`
+	CustoMaoDeObra float64 `json:"custo_mao_de_obra" binding:"min=0"`
+}
+
+// OrdemManutencaoResponse representa a resposta de uma ordem de manutenção
+type OrdemManutencaoResponse struct {
+	ID             string                       `json:"id"`
+	VeiculoID      string                       `json:"veiculo_id"`
+	PlanoID        string                       `json:"plano_id,omitempty"`
+	Tipo           domain.TipoManutencao        `json:"tipo"`
+	Descricao      string                       `json:"descricao"`
+	Fornecedor     string                       `json:"fornecedor,omitempty"`
+	CustoPecas     float64                      `json:"custo_pecas"`
+	CustoMaoDeObra float64                      `json:"custo_mao_de_obra"`
+	CustoTotal     float64                      `json:"custo_total"`
+	Odometro       int                          `json:"odometro,omitempty"`
+	Status         domain.StatusOrdemManutencao `json:"status"`
+	AgendadaPara   time.Time                    `json:"agendada_para"`
+	IniciadaEm     *time.Time                   `json:"iniciada_em,omitempty"`
+	ConcluidaEm    *time.Time                   `json:"concluida_em,omitempty"`
+	Observacoes    string                       `json:"observacoes,omitempty"`
+}
+
+// NewOrdemManutencaoResponse cria uma nova resposta de ordem de manutenção
+func NewOrdemManutencaoResponse(o *domain.OrdemManutencao) *OrdemManutencaoResponse {
+	response := &OrdemManutencaoResponse{
+		ID:             o.ID.String(),
+		VeiculoID:      o.VeiculoID.String(),
+		Tipo:           o.Tipo,
+		Descricao:      o.Descricao,
+		Fornecedor:     o.Fornecedor,
+		CustoPecas:     o.CustoPecas,
+		CustoMaoDeObra: o.CustoMaoDeObra,
+		CustoTotal:     o.CustoTotal(),
+		Odometro:       o.Odometro,
+		Status:         o.Status,
+		AgendadaPara:   o.AgendadaPara,
+		IniciadaEm:     o.IniciadaEm,
+		ConcluidaEm:    o.ConcluidaEm,
+		Observacoes:    o.Observacoes,
+	}
+
+	if o.PlanoID != nil {
+		response.PlanoID = o.PlanoID.String()
+	}
+
+	return response
+}
+
+// HistoricoManutencaoResponse representa as ordens de manutenção de um veículo
+type HistoricoManutencaoResponse struct {
+	VeiculoID  string                     `json:"veiculo_id"`
+	Ordens     []*OrdemManutencaoResponse `json:"ordens"`
+	CustoTotal float64                    `json:"custo_total"` // ordens concluídas
+}
+
+// NewHistoricoManutencaoResponse cria uma nova resposta de histórico de manutenção
+func NewHistoricoManutencaoResponse(veiculoID uuid.UUID, ordens []*domain.OrdemManutencao) *HistoricoManutencaoResponse {
+	response := &HistoricoManutencaoResponse{
+		VeiculoID: veiculoID.String(),
+		Ordens:    make([]*OrdemManutencaoResponse, len(ordens)),
+	}
+
+	for i, o := range ordens {
+		response.Ordens[i] = NewOrdemManutencaoResponse(o)
+		if o.Status == domain.StatusOrdemConcluida {
+			response.CustoTotal += o.CustoTotal()
+		}
+	}
+
+	return response
+}
+
+// CriarPlanoManutencaoRequest representa a requisição de criação de um plano de manutenção
+type CriarPlanoManutencaoRequest struct {
+	Descricao      string `json:"descricao" binding:"required"`
+	IntervaloMeses int    `json:"intervalo_meses" binding:"min=0"`
+	IntervaloKm    int    `json:"intervalo_km" binding:"min=0"`
+}
+
+// PlanoManutencaoResponse representa um plano de manutenção com o próximo vencimento
+type PlanoManutencaoResponse struct {
+	ID              string     `json:"id"`
+	VeiculoID       string     `json:"veiculo_id"`
+	Descricao       string     `json:"descricao"`
+	IntervaloMeses  int        `json:"intervalo_meses,omitempty"`
+	IntervaloKm     int        `json:"intervalo_km,omitempty"`
+	UltimaExecucao  time.Time  `json:"ultima_execucao"`
+	UltimoOdometro  int        `json:"ultimo_odometro"`
+	ProximaData     *time.Time `json:"proxima_data,omitempty"`
+	ProximoOdometro int        `json:"proximo_odometro,omitempty"`
+	Vencido         bool       `json:"vencido"`
+}
+
+// NewPlanoManutencaoResponse cria a resposta do plano considerando a
+// quilometragem atual do veículo
+func NewPlanoManutencaoResponse(p *domain.PlanoManutencao, veiculo *domain.Veiculo) *PlanoManutencaoResponse {
+	response := &PlanoManutencaoResponse{
+		ID:              p.ID.String(),
+		VeiculoID:       p.VeiculoID.String(),
+		Descricao:       p.Descricao,
+		IntervaloMeses:  p.IntervaloMeses,
+		IntervaloKm:     p.IntervaloKm,
+		UltimaExecucao:  p.UltimaExecucao,
+		UltimoOdometro:  p.UltimoOdometro,
+		ProximoOdometro: p.ProximoOdometro(),
+		Vencido:         p.Vencido(time.Now(), veiculo.OdometroAtual),
+	}
+
+	if data := p.ProximaData(); !data.IsZero() {
+		response.ProximaData = &data
+	}
+
+	return response
+}
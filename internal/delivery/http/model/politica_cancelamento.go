@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// FaixaCancelamentoRequest representa uma faixa de reembolso na requisição
+type FaixaCancelamentoRequest struct {
+	HorasAntecedencia   int     `json:"horas_antecedencia" binding:"min=0"`
+	PercentualReembolso float64 `json:"percentual_reembolso" binding:"min=0,max=100"`
+}
+
+// CreatePoliticaCancelamentoRequest representa a requisição de criação de política de cancelamento
+type CreatePoliticaCancelamentoRequest struct {
+	ClienteID *uuid.UUID                 `json:"cliente_id"`
+	Nome      string                     `json:"nome" binding:"required"`
+	Faixas    []FaixaCancelamentoRequest `json:"faixas" binding:"required,min=1,dive"`
+}
+
+// ToDomain converte a requisição em uma política de cancelamento
+func (r *CreatePoliticaCancelamentoRequest) ToDomain() *domain.PoliticaCancelamento {
+	return domain.NewPoliticaCancelamento(r.ClienteID, r.Nome, faixasToDomain(r.Faixas))
+}
+
+// UpdatePoliticaCancelamentoRequest representa a requisição de atualização de política de cancelamento
+type UpdatePoliticaCancelamentoRequest struct {
+	Nome   string                     `json:"nome" binding:"required"`
+	Faixas []FaixaCancelamentoRequest `json:"faixas" binding:"required,min=1,dive"`
+}
+
+// FaixasDomain converte as faixas da requisição
+func (r *UpdatePoliticaCancelamentoRequest) FaixasDomain() []domain.FaixaCancelamento {
+	return faixasToDomain(r.Faixas)
+}
+
+func faixasToDomain(faixas []FaixaCancelamentoRequest) []domain.FaixaCancelamento {
+	result := make([]domain.FaixaCancelamento, len(faixas))
+	for i, f := range faixas {
+		result[i] = domain.FaixaCancelamento{
+			HorasAntecedencia:   f.HorasAntecedencia,
+			PercentualReembolso: f.PercentualReembolso,
+		}
+	}
+	return result
+}
+
+// PoliticaCancelamentoResponse representa a resposta de política de cancelamento
+type PoliticaCancelamentoResponse struct {
+	ID        string                     `json:"id"`
+	ClienteID string                     `json:"cliente_id,omitempty"`
+	Nome      string                     `json:"nome"`
+	Padrao    bool                       `json:"padrao"`
+	Faixas    []FaixaCancelamentoRequest `json:"faixas"`
+	CreatedAt time.Time                  `json:"created_at"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+}
+
+// NewPoliticaCancelamentoResponse cria uma nova resposta de política de cancelamento
+func NewPoliticaCancelamentoResponse(p *domain.PoliticaCancelamento) *PoliticaCancelamentoResponse {
+	response := &PoliticaCancelamentoResponse{
+		ID:        p.ID.String(),
+		Nome:      p.Nome,
+		Padrao:    p.ClienteID == nil,
+		Faixas:    make([]FaixaCancelamentoRequest, len(p.Faixas)),
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+
+	if p.ClienteID != nil {
+		response.ClienteID = p.ClienteID.String()
+	}
+
+	for i, f := range p.Faixas {
+		response.Faixas[i] = FaixaCancelamentoRequest{
+			HorasAntecedencia:   f.HorasAntecedencia,
+			PercentualReembolso: f.PercentualReembolso,
+		}
+	}
+
+	return response
+}
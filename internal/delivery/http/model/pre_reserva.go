@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+
+	"github.com/google/uuid"
+)
+
+// CreatePreReservaRequest representa a requisição de criação de pré-reserva
+type CreatePreReservaRequest struct {
+	VeiculoID        uuid.UUID `json:"veiculo_id" binding:"required"`
+	MotoristaID      uuid.UUID `json:"motorista_id" binding:"required"`
+	ClienteID        uuid.UUID `json:"cliente_id" binding:"required"`
+	Origem           string    `json:"origem" binding:"required"`
+	Destino          string    `json:"destino" binding:"required"`
+	DataInicio       time.Time `json:"data_inicio" binding:"required"`
+	DataFim          time.Time `json:"data_fim" binding:"required"`
+	Valor            float64   `json:"valor" binding:"required"`
+	ExpiraEm         time.Time `json:"expira_em" binding:"required"`
+	ResponsavelNome  string    `json:"responsavel_nome"`
+	ResponsavelEmail string    `json:"responsavel_email" binding:"required,email"`
+	Observacoes      string    `json:"observacoes"`
+}
+
+// Validate implementa a interface Validator
+func (r *CreatePreReservaRequest) Validate() error {
+	if err := validator.ValidarPeriodo(r.DataInicio, r.DataFim); err != nil {
+		return err
+	}
+
+	if err := validator.ValidarValor(r.Valor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToDomain converte a requisição em uma pré-reserva
+func (r *CreatePreReservaRequest) ToDomain() *domain.PreReserva {
+	reserva := domain.NewPreReserva(r.VeiculoID, r.MotoristaID, r.ClienteID, r.Origem, r.Destino,
+		r.DataInicio, r.DataFim, r.Valor, r.ExpiraEm)
+	reserva.ResponsavelNome = r.ResponsavelNome
+	reserva.ResponsavelEmail = r.ResponsavelEmail
+	reserva.Observacoes = r.Observacoes
+	return reserva
+}
+
+// PreReservaQueryParams representa os parâmetros de query para listagem de pré-reservas
+type PreReservaQueryParams struct {
+	Offset int `form:"offset,default=0" binding:"min=0"`
+	Limit  int `form:"limit,default=20" binding:"min=1,max=100"`
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/validator"
+)
+
+// ReagendarViagemRequest representa a requisição de reagendamento de uma viagem
+type ReagendarViagemRequest struct {
+	DataInicio           time.Time `json:"data_inicio" binding:"required"`
+	DataFim              time.Time `json:"data_fim" binding:"required"`
+	JustificativaJornada string    `json:"justificativa_jornada"`
+}
+
+// Validate implementa a interface Validator
+func (r *ReagendarViagemRequest) Validate() error {
+	return validator.ValidarPeriodo(r.DataInicio, r.DataFim)
+}
+
+// PreReservaConflitanteResponse resume uma pré-reserva que ocupa o recurso
+type PreReservaConflitanteResponse struct {
+	ID          string    `json:"id"`
+	VeiculoID   string    `json:"veiculo_id"`
+	MotoristaID string    `json:"motorista_id"`
+	DataInicio  time.Time `json:"data_inicio"`
+	DataFim     time.Time `json:"data_fim"`
+	ExpiraEm    time.Time `json:"expira_em"`
+}
+
+// ConflitoReagendamentoResponse representa a resposta de um reagendamento
+// recusado, com o que ocupa os recursos e as alternativas encontradas
+type ConflitoReagendamentoResponse struct {
+	Error                   string                              `json:"error"`
+	VeiculoOcupado          bool                                `json:"veiculo_ocupado"`
+	MotoristaOcupado        bool                                `json:"motorista_ocupado"`
+	ViagensConflitantes     []*ViagemResponse                   `json:"viagens_conflitantes"`
+	PreReservasConflitantes []*PreReservaConflitanteResponse    `json:"pre_reservas_conflitantes"`
+	Indisponibilidades      []*IndisponibilidadeVeiculoResponse `json:"indisponibilidades_conflitantes"`
+	VeiculosAlternativos    []VeiculoSugeridoResponse           `json:"veiculos_alternativos"`
+	MotoristasAlternativos  []MotoristaSugeridoResponse         `json:"motoristas_alternativos"`
+	HorariosLivres          []domain.Periodo                    `json:"horarios_livres"`
+}
+
+// NewConflitoReagendamentoResponse cria uma nova resposta de conflito de reagendamento
+func NewConflitoReagendamentoResponse(c *domain.ConflitoReagendamento) *ConflitoReagendamentoResponse {
+	response := &ConflitoReagendamentoResponse{
+		Error:                   c.Error(),
+		VeiculoOcupado:          c.VeiculoOcupado,
+		MotoristaOcupado:        c.MotoristaOcupado,
+		ViagensConflitantes:     make([]*ViagemResponse, len(c.Viagens)),
+		PreReservasConflitantes: make([]*PreReservaConflitanteResponse, len(c.PreReservas)),
+		Indisponibilidades:      make([]*IndisponibilidadeVeiculoResponse, len(c.Indisponibilidades)),
+		VeiculosAlternativos:    make([]VeiculoSugeridoResponse, len(c.VeiculosAlternativos)),
+		MotoristasAlternativos:  make([]MotoristaSugeridoResponse, len(c.MotoristasAlternativos)),
+		HorariosLivres:          c.HorariosLivres,
+	}
+
+	for i, v := range c.Viagens {
+		response.ViagensConflitantes[i] = NewViagemResponse(v)
+	}
+	for i, r := range c.PreReservas {
+		response.PreReservasConflitantes[i] = &PreReservaConflitanteResponse{
+			ID:          r.ID.String(),
+			VeiculoID:   r.VeiculoID.String(),
+			MotoristaID: r.MotoristaID.String(),
+			DataInicio:  r.DataInicio,
+			DataFim:     r.DataFim,
+			ExpiraEm:    r.ExpiraEm,
+		}
+	}
+	for i, d := range c.Indisponibilidades {
+		response.Indisponibilidades[i] = NewIndisponibilidadeVeiculoResponse(d)
+	}
+	for i, v := range c.VeiculosAlternativos {
+		response.VeiculosAlternativos[i] = newVeiculoSugeridoResponse(v)
+	}
+	for i, m := range c.MotoristasAlternativos {
+		response.MotoristasAlternativos[i] = newMotoristaSugeridoResponse(m)
+	}
+	if response.HorariosLivres == nil {
+		response.HorariosLivres = []domain.Periodo{}
+	}
+
+	return response
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"agencia-viagens/internal/domain"
+)
+
+// RegistroViagemRequest representa as leituras informadas no check-in ou no check-out
+type RegistroViagemRequest struct {
+	Odometro         int        `json:"odometro" binding:"min=0"`
+	NivelCombustivel int        `json:"nivel_combustivel" binding:"min=0,max=100"`
+	RegistradoEm     *time.Time `json:"registrado_em"`
+	Fotos            []string   `json:"fotos"`
+	Observacoes      string     `json:"observacoes"`
+}
+
+// ToDomain converte a requisição em uma leitura de viagem. Sem horário
+// informado, considera o momento da requisição.
+func (r *RegistroViagemRequest) ToDomain() domain.LeituraViagem {
+	registradoEm := time.Now()
+	if r.RegistradoEm != nil {
+		registradoEm = *r.RegistradoEm
+	}
+
+	return domain.LeituraViagem{
+		Odometro:         r.Odometro,
+		NivelCombustivel: r.NivelCombustivel,
+		RegistradoEm:     registradoEm,
+		Fotos:            r.Fotos,
+		Observacoes:      r.Observacoes,
+	}
+}
+
+// RegistroViagemResponse representa a resposta de um registro de viagem
+type RegistroViagemResponse struct {
+	ID               string                    `json:"id"`
+	ViagemID         string                    `json:"viagem_id"`
+	VeiculoID        string                    `json:"veiculo_id"`
+	MotoristaID      string                    `json:"motorista_id"`
+	Tipo             domain.TipoRegistroViagem `json:"tipo"`
+	Odometro         int                       `json:"odometro"`
+	NivelCombustivel int                       `json:"nivel_combustivel"`
+	RegistradoEm     time.Time                 `json:"registrado_em"`
+	Fotos            []string                  `json:"fotos,omitempty"`
+	Observacoes      string                    `json:"observacoes,omitempty"`
+}
+
+// NewRegistroViagemResponse cria uma nova resposta de registro de viagem
+func NewRegistroViagemResponse(r *domain.RegistroViagem) *RegistroViagemResponse {
+	return &RegistroViagemResponse{
+		ID:               r.ID.String(),
+		ViagemID:         r.ViagemID.String(),
+		VeiculoID:        r.VeiculoID.String(),
+		MotoristaID:      r.MotoristaID.String(),
+		Tipo:             r.Tipo,
+		Odometro:         r.Odometro,
+		NivelCombustivel: r.NivelCombustivel,
+		RegistradoEm:     r.RegistradoEm,
+		Fotos:            r.Fotos,
+		Observacoes:      r.Observacoes,
+	}
+}
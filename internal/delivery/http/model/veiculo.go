@@ -3,6 +3,7 @@ package model
 import (
 	"agencia-viagens/internal/domain"
 	"agencia-viagens/internal/validator"
+	"strings"
 	"time"
 )
 
@@ -18,6 +19,7 @@ type CreateVeiculoRequest struct {
 	Renavam     string             `json:"renavam" binding:"required"`
 	Cor         string             `json:"cor"`
 	Observacoes string             `json:"observacoes"`
+	Comodidades []string           `json:"comodidades"`
 }
 
 // Validate implementa a interface Validator
@@ -38,7 +40,11 @@ func (r *CreateVeiculoRequest) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := domain.ValidarRenavam(r.Renavam); err != nil {
+		return err
+	}
+
+	return domain.ConferirChassi(r.Chassi, r.Marca, r.Ano)
 }
 
 // UpdateVeiculoRequest representa a requisição de atualização de veículo
@@ -51,6 +57,7 @@ type UpdateVeiculoRequest struct {
 	Cor         string               `json:"cor"`
 	Observacoes string               `json:"observacoes"`
 	Status      domain.StatusVeiculo `json:"status"`
+	Comodidades []string             `json:"comodidades"`
 }
 
 // Validate implementa a interface Validator
@@ -97,35 +104,46 @@ type VeiculoResponse struct {
 	Cor                    string               `json:"cor"`
 	Observacoes            string               `json:"observacoes"`
 	DocumentacaoValida     bool                 `json:"documentacao_valida"`
-	VencimentoDocumentacao time.Time            `json:"vencimento_documentacao"`
+	VencimentoDocumentacao *time.Time           `json:"vencimento_documentacao,omitempty"`
 	UltimaManutencao       time.Time            `json:"ultima_manutencao"`
 	ProximaManutencao      time.Time            `json:"proxima_manutencao"`
+	ProximaManutencaoKm    int                  `json:"proxima_manutencao_km,omitempty"`
+	OdometroAtual          int                  `json:"odometro_atual"`
+	Comodidades            []string             `json:"comodidades"`
 	CreatedAt              time.Time            `json:"created_at"`
 	UpdatedAt              time.Time            `json:"updated_at"`
 }
 
 // NewVeiculoResponse cria uma nova resposta de veículo
 func NewVeiculoResponse(v *domain.Veiculo) *VeiculoResponse {
-	return &VeiculoResponse{
-		ID:                     v.ID.String(),
-		Placa:                  v.Placa,
-		Modelo:                 v.Modelo,
-		Marca:                  v.Marca,
-		Ano:                    v.Ano,
-		Tipo:                   v.Tipo,
-		Capacidade:             v.Capacidade,
-		Status:                 v.Status,
-		Chassi:                 v.Chassi,
-		Renavam:                v.Renavam,
-		Cor:                    v.Cor,
-		Observacoes:            v.Observacoes,
-		DocumentacaoValida:     v.DocumentacaoValida,
-		VencimentoDocumentacao: v.VencimentoDocumentacao,
-		UltimaManutencao:       v.UltimaManutencao,
-		ProximaManutencao:      v.ProximaManutencao,
-		CreatedAt:              v.CreatedAt,
-		UpdatedAt:              v.UpdatedAt,
+	response := &VeiculoResponse{
+		ID:                  v.ID.String(),
+		Placa:               v.Placa,
+		Modelo:              v.Modelo,
+		Marca:               v.Marca,
+		Ano:                 v.Ano,
+		Tipo:                v.Tipo,
+		Capacidade:          v.Capacidade,
+		Status:              v.Status,
+		Chassi:              v.Chassi,
+		Renavam:             v.Renavam,
+		Cor:                 v.Cor,
+		Observacoes:         v.Observacoes,
+		DocumentacaoValida:  v.DocumentacaoEmDia(time.Now()),
+		UltimaManutencao:    v.UltimaManutencao,
+		ProximaManutencao:   v.ProximaManutencao,
+		ProximaManutencaoKm: v.ProximaManutencaoKm,
+		OdometroAtual:       v.OdometroAtual,
+		Comodidades:         v.Comodidades,
+		CreatedAt:           v.CreatedAt,
+		UpdatedAt:           v.UpdatedAt,
+	}
+
+	if !v.VencimentoDocumentacao.IsZero() {
+		response.VencimentoDocumentacao = &v.VencimentoDocumentacao
 	}
+
+	return response
 }
 
 // ListVeiculosResponse representa a resposta de listagem de veículos
@@ -150,10 +168,11 @@ func NewListVeiculosResponse(veiculos []*domain.Veiculo, total int64) *ListVeicu
 
 // VeiculoQueryParams representa os parâmetros de query para listagem de veículos
 type VeiculoQueryParams struct {
-	Offset int    `form:"offset" binding:"min=0"`
-	Limit  int    `form:"limit" binding:"min=1,max=100"`
-	Status string `form:"status"`
-	Tipo   string `form:"tipo"`
+	Offset      int    `form:"offset,default=0" binding:"min=0"`
+	Limit       int    `form:"limit,default=100" binding:"min=1,max=100"`
+	Status      string `form:"status"`
+	Tipo        string `form:"tipo"`
+	Comodidades string `form:"comodidades"` // códigos separados por vírgula
 }
 
 // Validate implementa a interface Validator
@@ -173,6 +192,20 @@ func (p *VeiculoQueryParams) Validate() error {
 	return nil
 }
 
+// ToFiltro converte os parâmetros em um filtro de listagem de veículos
+func (p *VeiculoQueryParams) ToFiltro() domain.FiltroVeiculo {
+	filtro := domain.FiltroVeiculo{
+		Status: domain.StatusVeiculo(p.Status),
+		Tipo:   domain.TipoVeiculo(p.Tipo),
+		Offset: p.Offset,
+		Limit:  p.Limit,
+	}
+	if p.Comodidades != "" {
+		filtro.Comodidades = domain.NormalizarComodidades(strings.Split(p.Comodidades, ","))
+	}
+	return filtro
+}
+
 // DisponibilidadeVeiculoQueryParams representa os parâmetros de query para verificação de disponibilidade
 type DisponibilidadeVeiculoQueryParams struct {
 	DataInicio time.Time `form:"data_inicio" binding:"required"`
@@ -40,23 +40,31 @@ func (r *CreateViagemRequest) Validate() error {
 	return nil
 }
 
-// UpdateViagemRequest representa a requisição de atualização de viagem
+// UpdateViagemRequest representa a requisição de atualização de viagem. Traz
+// só os dados editáveis: o status muda pelo cancelamento, check-in e
+// check-out, e o status informado precisa ser o atual da viagem.
 type UpdateViagemRequest struct {
-	Origem      string              `json:"origem"`
-	Destino     string              `json:"destino"`
-	DataInicio  time.Time           `json:"data_inicio"`
-	DataFim     time.Time           `json:"data_fim"`
-	Valor       float64             `json:"valor"`
-	Status      domain.StatusViagem `json:"status"`
-	Observacoes string              `json:"observacoes"`
+	VeiculoID              uuid.UUID           `json:"veiculo_id" binding:"required"`
+	MotoristaID            uuid.UUID           `json:"motorista_id" binding:"required"`
+	MotoristaSecundarioID  *uuid.UUID          `json:"motorista_secundario_id"`
+	Origem                 string              `json:"origem" binding:"required"`
+	Destino                string              `json:"destino" binding:"required"`
+	DataInicio             time.Time           `json:"data_inicio" binding:"required"`
+	DataFim                time.Time           `json:"data_fim" binding:"required"`
+	Valor                  float64             `json:"valor" binding:"required"`
+	QuantidadePassageiros  int                 `json:"quantidade_passageiros" binding:"min=0"`
+	DirecaoPrevistaMinutos int                 `json:"direcao_prevista_minutos" binding:"min=0"`
+	ComodidadesExigidas    []string            `json:"comodidades_exigidas"`
+	JustificativaJornada   string              `json:"justificativa_jornada"`
+	Status                 domain.StatusViagem `json:"status"`
+	Observacoes            string              `json:"observacoes"`
 }
 
 // Validate implementa a interface Validator
 func (r *UpdateViagemRequest) Validate() error {
-	if !r.DataInicio.IsZero() && !r.DataFim.IsZero() {
-		if err := validator.ValidarPeriodo(r.DataInicio, r.DataFim); err != nil {
-			return err
-		}
+	// Viagens já realizadas continuam editáveis, então basta a ordem das datas
+	if r.DataInicio.After(r.DataFim) {
+		return validator.ErrPeriodoInvalido
 	}
 
 	if r.Status != "" {
@@ -65,49 +73,114 @@ func (r *UpdateViagemRequest) Validate() error {
 		}
 	}
 
-	if r.Valor != 0 {
-		if err := validator.ValidarValor(r.Valor); err != nil {
-			return err
-		}
+	if err := validator.ValidarValor(r.Valor); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// ToDomain converte a requisição na viagem com os dados editados
+func (r *UpdateViagemRequest) ToDomain(id uuid.UUID) *domain.Viagem {
+	return &domain.Viagem{
+		ID:                     id,
+		VeiculoID:              r.VeiculoID,
+		MotoristaID:            r.MotoristaID,
+		MotoristaSecundarioID:  r.MotoristaSecundarioID,
+		Origem:                 r.Origem,
+		Destino:                r.Destino,
+		DataInicio:             r.DataInicio,
+		DataFim:                r.DataFim,
+		Valor:                  r.Valor,
+		QuantidadePassageiros:  r.QuantidadePassageiros,
+		DirecaoPrevistaMinutos: r.DirecaoPrevistaMinutos,
+		ComodidadesExigidas:    r.ComodidadesExigidas,
+		JustificativaJornada:   r.JustificativaJornada,
+		Status:                 r.Status,
+		Observacoes:            r.Observacoes,
+	}
+}
+
+// CancelarViagemRequest representa a requisição de cancelamento de viagem
+type CancelarViagemRequest struct {
+	Motivo string `json:"motivo" binding:"required"`
+}
+
 // ViagemResponse representa a resposta de viagem
 type ViagemResponse struct {
-	ID          string              `json:"id"`
-	VeiculoID   string              `json:"veiculo_id"`
-	MotoristaID string              `json:"motorista_id"`
-	ClienteID   string              `json:"cliente_id"`
-	Origem      string              `json:"origem"`
-	Destino     string              `json:"destino"`
-	DataInicio  time.Time           `json:"data_inicio"`
-	DataFim     time.Time           `json:"data_fim"`
-	Valor       float64             `json:"valor"`
-	Status      domain.StatusViagem `json:"status"`
-	Observacoes string              `json:"observacoes"`
-	CreatedAt   time.Time           `json:"created_at"`
-	UpdatedAt   time.Time           `json:"updated_at"`
+	ID                    string              `json:"id"`
+	VeiculoID             string              `json:"veiculo_id"`
+	MotoristaID           string              `json:"motorista_id"`
+	MotoristaSecundarioID string              `json:"motorista_secundario_id,omitempty"`
+	ClienteID             string              `json:"cliente_id"`
+	GrupoID               string              `json:"grupo_id,omitempty"`
+	CotacaoID             string              `json:"cotacao_id,omitempty"`
+	Origem                string              `json:"origem"`
+	Destino               string              `json:"destino"`
+	DataInicio            time.Time           `json:"data_inicio"`
+	DataFim               time.Time           `json:"data_fim"`
+	Valor                 float64             `json:"valor"`
+	Status                domain.StatusViagem `json:"status"`
+	Observacoes           string              `json:"observacoes"`
+	QuantidadePassageiros int                 `json:"quantidade_passageiros"`
+	DirecaoPrevista       int                 `json:"direcao_prevista_minutos"`
+	MotivoCancelamento    string              `json:"motivo_cancelamento,omitempty"`
+	TaxaCancelamento      float64             `json:"taxa_cancelamento,omitempty"`
+	ValorReembolso        float64             `json:"valor_reembolso,omitempty"`
+	CanceladaEm           *time.Time          `json:"cancelada_em,omitempty"`
+	InicioReal            *time.Time          `json:"inicio_real,omitempty"`
+	FimReal               *time.Time          `json:"fim_real,omitempty"`
+	KmPercorridos         int                 `json:"km_percorridos"`
+	ComodidadesExigidas   []string            `json:"comodidades_exigidas,omitempty"`
+	CreatedAt             time.Time           `json:"created_at"`
+	UpdatedAt             time.Time           `json:"updated_at"`
+
+	// Vencimentos próximos apontados ao agendar ou alterar a viagem
+	Avisos []domain.PendenciaConformidade `json:"avisos,omitempty"`
 }
 
 // NewViagemResponse cria uma nova resposta de viagem
 func NewViagemResponse(v *domain.Viagem) *ViagemResponse {
-	return &ViagemResponse{
-		ID:          v.ID.String(),
-		VeiculoID:   v.VeiculoID.String(),
-		MotoristaID: v.MotoristaID.String(),
-		ClienteID:   v.ClienteID.String(),
-		Origem:      v.Origem,
-		Destino:     v.Destino,
-		DataInicio:  v.DataInicio,
-		DataFim:     v.DataFim,
-		Valor:       v.Valor,
-		Status:      v.Status,
-		Observacoes: v.Observacoes,
-		CreatedAt:   v.CreatedAt,
-		UpdatedAt:   v.UpdatedAt,
+	response := &ViagemResponse{
+		ID:                    v.ID.String(),
+		VeiculoID:             v.VeiculoID.String(),
+		MotoristaID:           v.MotoristaID.String(),
+		ClienteID:             v.ClienteID.String(),
+		Origem:                v.Origem,
+		Destino:               v.Destino,
+		DataInicio:            v.DataInicio,
+		DataFim:               v.DataFim,
+		Valor:                 v.Valor,
+		Status:                v.Status,
+		Observacoes:           v.Observacoes,
+		CreatedAt:             v.CreatedAt,
+		UpdatedAt:             v.UpdatedAt,
+		QuantidadePassageiros: v.QuantidadePassageiros,
+		DirecaoPrevista:       int(v.DirecaoPrevista().Minutes()),
+		Avisos:                v.Avisos,
+		ComodidadesExigidas:   v.ComodidadesExigidas,
+		MotivoCancelamento:    v.MotivoCancelamento,
+		TaxaCancelamento:      v.TaxaCancelamento,
+		ValorReembolso:        v.ValorReembolso,
+		CanceladaEm:           v.CanceladaEm,
+		InicioReal:            v.InicioReal,
+		FimReal:               v.FimReal,
+		KmPercorridos:         v.KmPercorridos,
+	}
+
+	if v.MotoristaSecundarioID != nil {
+		response.MotoristaSecundarioID = v.MotoristaSecundarioID.String()
+	}
+
+	if v.GrupoID != nil {
+		response.GrupoID = v.GrupoID.String()
+	}
+
+	if v.CotacaoID != nil {
+		response.CotacaoID = v.CotacaoID.String()
 	}
+
+	return response
 }
 
 // ListViagensResponse representa a resposta de listagem de viagens
@@ -132,14 +205,18 @@ func NewListViagensResponse(viagens []*domain.Viagem, total int64) *ListViagensR
 
 // ViagemQueryParams representa os parâmetros de query para listagem de viagens
 type ViagemQueryParams struct {
-	Offset      int       `form:"offset" binding:"min=0"`
-	Limit       int       `form:"limit" binding:"min=1,max=100"`
+	Offset      int       `form:"offset,default=0" binding:"min=0"`
+	Limit       int       `form:"limit,default=20" binding:"min=1,max=100"`
 	Status      string    `form:"status"`
 	DataInicio  time.Time `form:"data_inicio"`
 	DataFim     time.Time `form:"data_fim"`
 	VeiculoID   string    `form:"veiculo_id"`
 	MotoristaID string    `form:"motorista_id"`
 	ClienteID   string    `form:"cliente_id"`
+	Origem      string    `form:"origem"`
+	Destino     string    `form:"destino"`
+	OrdenarPor  string    `form:"ordenar_por,default=data_inicio"`
+	Ordem       string    `form:"ordem,default=desc"`
 }
 
 // Validate implementa a interface Validator
@@ -150,10 +227,9 @@ func (p *ViagemQueryParams) Validate() error {
 		}
 	}
 
-	if !p.DataInicio.IsZero() && !p.DataFim.IsZero() {
-		if err := validator.ValidarPeriodo(p.DataInicio, p.DataFim); err != nil {
-			return err
-		}
+	// A busca aceita períodos passados, então basta a ordem das datas
+	if !p.DataInicio.IsZero() && !p.DataFim.IsZero() && p.DataInicio.After(p.DataFim) {
+		return validator.ErrPeriodoInvalido
 	}
 
 	if p.VeiculoID != "" {
@@ -174,9 +250,31 @@ func (p *ViagemQueryParams) Validate() error {
 		}
 	}
 
+	if err := validator.ValidarOrdenacao(p.OrdenarPor, p.Ordem, domain.CamposOrdenacaoViagem); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// ToFiltro converte os parâmetros em um filtro de busca de viagens
+func (p *ViagemQueryParams) ToFiltro() domain.FiltroViagem {
+	return domain.FiltroViagem{
+		Status:      domain.StatusViagem(p.Status),
+		DataInicio:  p.DataInicio,
+		DataFim:     p.DataFim,
+		VeiculoID:   parseUUIDOpcional(p.VeiculoID),
+		MotoristaID: parseUUIDOpcional(p.MotoristaID),
+		ClienteID:   parseUUIDOpcional(p.ClienteID),
+		Origem:      p.Origem,
+		Destino:     p.Destino,
+		OrdenarPor:  p.OrdenarPor,
+		Decrescente: p.Ordem == "desc",
+		Offset:      p.Offset,
+		Limit:       p.Limit,
+	}
+}
+
 // DisponibilidadeViagemQueryParams representa os parâmetros de query para verificação de disponibilidade
 type DisponibilidadeViagemQueryParams struct {
 	DataInicio  time.Time `form:"data_inicio" binding:"required"`
@@ -205,3 +303,15 @@ func (p *DisponibilidadeViagemQueryParams) Validate() error {
 
 	return nil
 }
+
+// parseUUIDOpcional converte um ID já validado, retornando nil se vazio
+func parseUUIDOpcional(valor string) *uuid.UUID {
+	if valor == "" {
+		return nil
+	}
+	id, err := uuid.Parse(valor)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
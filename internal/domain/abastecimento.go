@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ToleranciaConsumoAnomaloPadrao é a queda do km/l em relação à mediana do
+// veículo a partir da qual o consumo é considerado anômalo, quando não
+// configurada outra tolerância
+const ToleranciaConsumoAnomaloPadrao = 0.2
+
+// MinimoTrechosReferenciaConsumo é a quantidade de trechos necessária para que
+// o veículo tenha um consumo de referência
+const MinimoTrechosReferenciaConsumo = 3
+
+// OrigemAbastecimento indica como o abastecimento foi lançado
+type OrigemAbastecimento string
+
+const (
+	AbastecimentoManual OrigemAbastecimento = "MANUAL"
+	AbastecimentoCartao OrigemAbastecimento = "CARTAO_COMBUSTIVEL"
+)
+
+// Abastecimento é o registro de um abastecimento do veículo
+type Abastecimento struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID   uuid.UUID  `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	MotoristaID *uuid.UUID `json:"motorista_id,omitempty" gorm:"type:uuid;index"`
+	ViagemID    *uuid.UUID `json:"viagem_id,omitempty" gorm:"type:uuid;index"`
+
+	Data        time.Time           `json:"data" gorm:"not null;index"`
+	Litros      float64             `json:"litros" gorm:"type:decimal(10,3);not null"`
+	PrecoLitro  float64             `json:"preco_litro" gorm:"type:decimal(10,3);not null"`
+	ValorTotal  float64             `json:"valor_total" gorm:"type:decimal(10,2);not null"`
+	Odometro    int                 `json:"odometro" gorm:"not null"`
+	Posto       string              `json:"posto" gorm:"type:varchar(200)"`
+	TanqueCheio bool                `json:"tanque_cheio" gorm:"not null;default:true"`
+	Origem      OrigemAbastecimento `json:"origem" gorm:"type:varchar(20);not null;default:'MANUAL'"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewAbastecimento cria uma nova instância de Abastecimento
+func NewAbastecimento(veiculoID uuid.UUID, data time.Time, litros, precoLitro float64, odometro int,
+	posto string, tanqueCheio bool) *Abastecimento {
+	return &Abastecimento{
+		ID:          uuid.New(),
+		VeiculoID:   veiculoID,
+		Data:        data,
+		Litros:      litros,
+		PrecoLitro:  precoLitro,
+		ValorTotal:  arredondar(litros * precoLitro),
+		Odometro:    odometro,
+		Posto:       posto,
+		TanqueCheio: tanqueCheio,
+		Origem:      AbastecimentoManual,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validar verifica se o abastecimento é válido
+func (a *Abastecimento) Validar() error {
+	if a.Litros <= 0 {
+		return ErrLitrosInvalidos
+	}
+
+	if a.PrecoLitro <= 0 {
+		return ErrValorInvalido
+	}
+
+	if a.Odometro <= 0 {
+		return ErrOdometroAbastecimentoInvalido
+	}
+
+	if a.Data.IsZero() || a.Data.After(time.Now()) {
+		return ErrDataAbastecimentoInvalida
+	}
+
+	switch a.Origem {
+	case AbastecimentoManual, AbastecimentoCartao:
+	default:
+		return ErrOrigemAbastecimentoInvalida
+	}
+
+	return nil
+}
+
+// TrechoConsumo é o percurso entre dois abastecimentos de tanque cheio. O
+// combustível consumido é o que foi colocado depois do primeiro, inclusive
+// nos abastecimentos parciais, até completar o tanque no segundo.
+type TrechoConsumo struct {
+	VeiculoID   uuid.UUID  `json:"veiculo_id"`
+	MotoristaID *uuid.UUID `json:"motorista_id,omitempty"`
+	ViagemID    *uuid.UUID `json:"viagem_id,omitempty"`
+
+	Inicio          time.Time `json:"inicio"`
+	Fim             time.Time `json:"fim"`
+	OdometroInicial int       `json:"odometro_inicial"`
+	OdometroFinal   int       `json:"odometro_final"`
+	Km              int       `json:"km"`
+	Litros          float64   `json:"litros"`
+	Custo           float64   `json:"custo"`
+	KmPorLitro      float64   `json:"km_por_litro"`
+
+	// Comparação com a mediana de km/l do veículo
+	Referencia float64 `json:"km_por_litro_referencia,omitempty"`
+	Desvio     float64 `json:"desvio,omitempty"` // percentual abaixo (-) ou acima da referência
+	Anomalo    bool    `json:"anomalo"`
+}
+
+// CalcularTrechosConsumo monta os trechos de consumo de um veículo pelo
+// método de tanque cheio a tanque cheio. Abastecimentos anteriores ao primeiro
+// tanque cheio não entram no cálculo. O trecho é atribuído ao motorista e à
+// viagem do abastecimento que o encerra.
+func CalcularTrechosConsumo(abastecimentos []*Abastecimento) []*TrechoConsumo {
+	ordenados := make([]*Abastecimento, len(abastecimentos))
+	copy(ordenados, abastecimentos)
+	sort.SliceStable(ordenados, func(i, j int) bool {
+		if ordenados[i].Odometro != ordenados[j].Odometro {
+			return ordenados[i].Odometro < ordenados[j].Odometro
+		}
+		return ordenados[i].Data.Before(ordenados[j].Data)
+	})
+
+	var trechos []*TrechoConsumo
+	var inicio *Abastecimento
+	var litros, custo float64
+	for _, a := range ordenados {
+		if inicio == nil {
+			if a.TanqueCheio {
+				inicio = a
+			}
+			continue
+		}
+
+		litros += a.Litros
+		custo += a.ValorTotal
+		if !a.TanqueCheio {
+			continue
+		}
+
+		if km := a.Odometro - inicio.Odometro; km > 0 {
+			trechos = append(trechos, &TrechoConsumo{
+				VeiculoID:       a.VeiculoID,
+				MotoristaID:     a.MotoristaID,
+				ViagemID:        a.ViagemID,
+				Inicio:          inicio.Data,
+				Fim:             a.Data,
+				OdometroInicial: inicio.Odometro,
+				OdometroFinal:   a.Odometro,
+				Km:              km,
+				Litros:          arredondar(litros),
+				Custo:           arredondar(custo),
+				KmPorLitro:      arredondar(float64(km) / litros),
+			})
+		}
+		inicio = a
+		litros, custo = 0, 0
+	}
+
+	return trechos
+}
+
+// MarcarConsumoAnomalo compara cada trecho com a mediana de km/l dos trechos do
+// mesmo veículo e marca os que ficaram abaixo dela além da tolerância, o que
+// indica desvio de combustível ou problema mecânico. Veículos com menos de
+// MinimoTrechosReferenciaConsumo trechos ficam sem referência.
+func MarcarConsumoAnomalo(trechos []*TrechoConsumo, tolerancia float64) {
+	porVeiculo := make(map[uuid.UUID][]*TrechoConsumo)
+	for _, t := range trechos {
+		porVeiculo[t.VeiculoID] = append(porVeiculo[t.VeiculoID], t)
+	}
+
+	for _, doVeiculo := range porVeiculo {
+		if len(doVeiculo) < MinimoTrechosReferenciaConsumo {
+			continue
+		}
+
+		referencia := medianaKmPorLitro(doVeiculo)
+		for _, t := range doVeiculo {
+			t.Referencia = referencia
+			t.Desvio = arredondar((t.KmPorLitro - referencia) / referencia * 100)
+			t.Anomalo = t.KmPorLitro < referencia*(1-tolerancia)
+		}
+	}
+}
+
+func medianaKmPorLitro(trechos []*TrechoConsumo) float64 {
+	valores := make([]float64, len(trechos))
+	for i, t := range trechos {
+		valores[i] = t.KmPorLitro
+	}
+	sort.Float64s(valores)
+
+	meio := len(valores) / 2
+	if len(valores)%2 == 0 {
+		return arredondar((valores[meio-1] + valores[meio]) / 2)
+	}
+	return valores[meio]
+}
+
+// ConsumoAgrupado totaliza os trechos de um veículo, motorista ou rota
+type ConsumoAgrupado struct {
+	Chave           string  `json:"chave"` // ID do veículo ou do motorista, ou a rota
+	Km              int     `json:"km"`
+	Litros          float64 `json:"litros"`
+	Custo           float64 `json:"custo"`
+	KmPorLitro      float64 `json:"km_por_litro"`
+	CustoPorKm      float64 `json:"custo_por_km"`
+	Trechos         int     `json:"trechos"`
+	TrechosAnomalos int     `json:"trechos_anomalos"`
+}
+
+// RelatorioConsumo reúne o consumo do período por veículo, motorista e rota e
+// os trechos com consumo anômalo
+type RelatorioConsumo struct {
+	DataInicio   time.Time          `json:"data_inicio"`
+	DataFim      time.Time          `json:"data_fim"`
+	PorVeiculo   []*ConsumoAgrupado `json:"por_veiculo"`
+	PorMotorista []*ConsumoAgrupado `json:"por_motorista"`
+	PorRota      []*ConsumoAgrupado `json:"por_rota"`
+	Anomalias    []*TrechoConsumo   `json:"anomalias"`
+}
+
+// ResumirConsumo agrupa os trechos. rotas traz a descrição da rota de cada
+// viagem; trechos sem motorista ou sem viagem ficam fora dos respectivos grupos.
+func ResumirConsumo(dataInicio, dataFim time.Time, trechos []*TrechoConsumo, rotas map[uuid.UUID]string) *RelatorioConsumo {
+	relatorio := &RelatorioConsumo{
+		DataInicio: dataInicio,
+		DataFim:    dataFim,
+		Anomalias:  []*TrechoConsumo{},
+	}
+
+	porVeiculo := newAgrupamentoConsumo()
+	porMotorista := newAgrupamentoConsumo()
+	porRota := newAgrupamentoConsumo()
+	for _, t := range trechos {
+		porVeiculo.somar(t.VeiculoID.String(), t)
+		if t.MotoristaID != nil {
+			porMotorista.somar(t.MotoristaID.String(), t)
+		}
+		if t.ViagemID != nil {
+			if rota, ok := rotas[*t.ViagemID]; ok {
+				porRota.somar(rota, t)
+			}
+		}
+		if t.Anomalo {
+			relatorio.Anomalias = append(relatorio.Anomalias, t)
+		}
+	}
+
+	relatorio.PorVeiculo = porVeiculo.fechar()
+	relatorio.PorMotorista = porMotorista.fechar()
+	relatorio.PorRota = porRota.fechar()
+
+	return relatorio
+}
+
+type agrupamentoConsumo struct {
+	grupos map[string]*ConsumoAgrupado
+	ordem  []string
+}
+
+func newAgrupamentoConsumo() *agrupamentoConsumo {
+	return &agrupamentoConsumo{grupos: make(map[string]*ConsumoAgrupado)}
+}
+
+func (a *agrupamentoConsumo) somar(chave string, t *TrechoConsumo) {
+	g, ok := a.grupos[chave]
+	if !ok {
+		g = &ConsumoAgrupado{Chave: chave}
+		a.grupos[chave] = g
+		a.ordem = append(a.ordem, chave)
+	}
+
+	g.Km += t.Km
+	g.Litros += t.Litros
+	g.Custo += t.Custo
+	g.Trechos++
+	if t.Anomalo {
+		g.TrechosAnomalos++
+	}
+}
+
+// fechar calcula as médias e ordena os grupos do menor para o maior km/l
+func (a *agrupamentoConsumo) fechar() []*ConsumoAgrupado {
+	grupos := make([]*ConsumoAgrupado, 0, len(a.ordem))
+	for _, chave := range a.ordem {
+		g := a.grupos[chave]
+		g.Litros = arredondar(g.Litros)
+		g.Custo = arredondar(g.Custo)
+		if g.Litros > 0 {
+			g.KmPorLitro = arredondar(float64(g.Km) / g.Litros)
+		}
+		if g.Km > 0 {
+			g.CustoPorKm = arredondar(g.Custo / float64(g.Km))
+		}
+		grupos = append(grupos, g)
+	}
+
+	sort.SliceStable(grupos, func(i, j int) bool {
+		return grupos[i].KmPorLitro < grupos[j].KmPorLitro
+	})
+	return grupos
+}
+
+// ResultadoImportacao resume a importação de um extrato de cartão combustível
+type ResultadoImportacao struct {
+	Importados int                   `json:"importados"`
+	Ignorados  int                   `json:"ignorados"` // já lançados anteriormente
+	Erros      []ErroLinhaImportacao `json:"erros"`
+}
+
+// ErroLinhaImportacao descreve uma linha do extrato que não pôde ser importada
+type ErroLinhaImportacao struct {
+	Linha int    `json:"linha"`
+	Erro  string `json:"erro"`
+}
+
+// Erros de domínio
+var (
+	ErrLitrosInvalidos               = NewDomainError("quantidade de litros deve ser maior que zero")
+	ErrOdometroAbastecimentoInvalido = NewDomainError("odômetro do abastecimento deve ser maior que zero")
+	ErrDataAbastecimentoInvalida     = NewDomainError("data do abastecimento inválida")
+	ErrOrigemAbastecimentoInvalida   = NewDomainError("origem do abastecimento inválida")
+	ErrViagemAbastecimentoInvalida   = NewDomainError("viagem informada não utiliza o veículo abastecido")
+)
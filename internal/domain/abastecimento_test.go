@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCalcularTrechosConsumo(t *testing.T) {
+	veiculoID := uuid.New()
+	motoristaID := uuid.New()
+	inicio := time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC)
+	abastecimento := func(dia int, litros float64, odometro int, cheio bool) *Abastecimento {
+		return NewAbastecimento(veiculoID, inicio.AddDate(0, 0, dia), litros, 6, odometro, "Posto", cheio)
+	}
+
+	type trecho struct {
+		km         int
+		litros     float64
+		custo      float64
+		kmPorLitro float64
+	}
+	casos := []struct {
+		nome           string
+		abastecimentos []*Abastecimento
+		trechos        []trecho
+	}{
+		{
+			nome: "sem abastecimentos",
+		},
+		{
+			nome:           "só o primeiro tanque cheio",
+			abastecimentos: []*Abastecimento{abastecimento(0, 50, 1000, true)},
+		},
+		{
+			nome: "tanque cheio a tanque cheio",
+			abastecimentos: []*Abastecimento{
+				abastecimento(0, 50, 1000, true),
+				abastecimento(1, 40, 1400, true),
+				abastecimento(2, 30, 1700, true),
+			},
+			trechos: []trecho{{400, 40, 240, 10}, {300, 30, 180, 10}},
+		},
+		{
+			nome: "parciais somam no trecho seguinte",
+			abastecimentos: []*Abastecimento{
+				abastecimento(0, 50, 1000, true),
+				abastecimento(1, 20, 1200, false),
+				abastecimento(2, 30, 1450, true),
+			},
+			trechos: []trecho{{450, 50, 300, 9}},
+		},
+		{
+			nome: "parciais antes do primeiro tanque cheio são ignorados",
+			abastecimentos: []*Abastecimento{
+				abastecimento(0, 25, 900, false),
+				abastecimento(1, 50, 1000, true),
+				abastecimento(2, 48, 1600, true),
+			},
+			trechos: []trecho{{600, 48, 288, 12.5}},
+		},
+		{
+			nome: "fora de ordem são ordenados pelo odômetro",
+			abastecimentos: []*Abastecimento{
+				abastecimento(2, 30, 1700, true),
+				abastecimento(0, 50, 1000, true),
+				abastecimento(1, 40, 1400, true),
+			},
+			trechos: []trecho{{400, 40, 240, 10}, {300, 30, 180, 10}},
+		},
+		{
+			nome: "mesmo odômetro não gera trecho",
+			abastecimentos: []*Abastecimento{
+				abastecimento(0, 50, 1000, true),
+				abastecimento(0, 5, 1000, true),
+				abastecimento(1, 35, 1350, true),
+			},
+			trechos: []trecho{{350, 35, 210, 10}},
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			trechos := CalcularTrechosConsumo(c.abastecimentos)
+			if len(trechos) != len(c.trechos) {
+				t.Fatalf("%d trechos, esperado %d", len(trechos), len(c.trechos))
+			}
+			for i, esperado := range c.trechos {
+				obtido := trecho{trechos[i].Km, trechos[i].Litros, trechos[i].Custo, trechos[i].KmPorLitro}
+				if obtido != esperado {
+					t.Errorf("trecho %d = %+v, esperado %+v", i, obtido, esperado)
+				}
+			}
+		})
+	}
+
+	t.Run("atribuído ao abastecimento que encerra o trecho", func(t *testing.T) {
+		fim := abastecimento(1, 40, 1400, true)
+		fim.MotoristaID = &motoristaID
+		trechos := CalcularTrechosConsumo([]*Abastecimento{abastecimento(0, 50, 1000, true), fim})
+		if len(trechos) != 1 || trechos[0].MotoristaID == nil || *trechos[0].MotoristaID != motoristaID {
+			t.Errorf("trechos = %+v", trechos)
+		}
+		if !trechos[0].Fim.Equal(fim.Data) || trechos[0].OdometroInicial != 1000 {
+			t.Errorf("período do trecho = %v a %v, odômetro inicial %d", trechos[0].Inicio, trechos[0].Fim, trechos[0].OdometroInicial)
+		}
+	})
+}
+
+func TestMarcarConsumoAnomalo(t *testing.T) {
+	veiculoID := uuid.New()
+	trechos := func(kmPorLitro ...float64) []*TrechoConsumo {
+		var result []*TrechoConsumo
+		for _, k := range kmPorLitro {
+			result = append(result, &TrechoConsumo{VeiculoID: veiculoID, KmPorLitro: k})
+		}
+		return result
+	}
+
+	t.Run("abaixo da tolerância", func(t *testing.T) {
+		ts := trechos(10, 10, 10, 7)
+		MarcarConsumoAnomalo(ts, 0.2)
+		for i, esperado := range []bool{false, false, false, true} {
+			if ts[i].Anomalo != esperado || ts[i].Referencia != 10 {
+				t.Errorf("trecho %d: anômalo %v, referência %v", i, ts[i].Anomalo, ts[i].Referencia)
+			}
+		}
+		if ts[3].Desvio != -30 {
+			t.Errorf("desvio = %v, esperado -30", ts[3].Desvio)
+		}
+	})
+
+	t.Run("no limite da tolerância", func(t *testing.T) {
+		ts := trechos(10, 10, 8)
+		MarcarConsumoAnomalo(ts, 0.2)
+		if ts[2].Anomalo {
+			t.Error("trecho exatamente na tolerância não é anômalo")
+		}
+	})
+
+	t.Run("mediana com quantidade par", func(t *testing.T) {
+		ts := trechos(8, 9, 11, 12)
+		MarcarConsumoAnomalo(ts, 0.2)
+		if ts[0].Referencia != 10 {
+			t.Errorf("referência = %v, esperado 10", ts[0].Referencia)
+		}
+	})
+
+	t.Run("poucos trechos ficam sem referência", func(t *testing.T) {
+		ts := trechos(10, 5)
+		MarcarConsumoAnomalo(ts, 0.2)
+		if ts[1].Anomalo || ts[1].Referencia != 0 {
+			t.Errorf("trecho sem referência marcado: %+v", ts[1])
+		}
+	})
+}
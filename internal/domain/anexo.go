@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// EntidadeAnexo representa os cadastros que aceitam arquivos anexados
+type EntidadeAnexo string
+
+const (
+	AnexoDeVeiculo   EntidadeAnexo = "VEICULO"
+	AnexoDeMotorista EntidadeAnexo = "MOTORISTA"
+	AnexoDeViagem    EntidadeAnexo = "VIAGEM"
+	AnexoDeCliente   EntidadeAnexo = "CLIENTE"
+)
+
+// TipoAnexo representa o conteúdo do arquivo anexado
+type TipoAnexo string
+
+const (
+	AnexoCNH         TipoAnexo = "CNH"
+	AnexoCRLV        TipoAnexo = "CRLV"
+	AnexoContrato    TipoAnexo = "CONTRATO"
+	AnexoComprovante TipoAnexo = "COMPROVANTE"
+	AnexoFoto        TipoAnexo = "FOTO"
+	AnexoOutro       TipoAnexo = "OUTRO"
+)
+
+// TamanhoMaximoAnexoPadrao é o limite de tamanho de cada arquivo quando não
+// configurado
+const TamanhoMaximoAnexoPadrao int64 = 10 << 20
+
+// TiposConteudoAnexo são os formatos aceitos: documentos digitalizados em PDF
+// e fotos
+var TiposConteudoAnexo = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+}
+
+// Anexo guarda os dados de um arquivo enviado. O conteúdo fica no
+// armazenamento de arquivos, sob a chave informada.
+type Anexo struct {
+	ID          uuid.UUID     `json:"id" gorm:"type:uuid;primary_key"`
+	Entidade    EntidadeAnexo `json:"entidade" gorm:"type:varchar(20);not null;index:idx_anexo_entidade"`
+	EntidadeID  uuid.UUID     `json:"entidade_id" gorm:"type:uuid;not null;index:idx_anexo_entidade"`
+	Tipo        TipoAnexo     `json:"tipo" gorm:"type:varchar(20);not null"`
+	NomeArquivo string        `json:"nome_arquivo" gorm:"type:varchar(255);not null"`
+	ContentType string        `json:"content_type" gorm:"type:varchar(100);not null"`
+	Tamanho     int64         `json:"tamanho" gorm:"not null"`
+	Checksum    string        `json:"checksum" gorm:"type:char(64);not null"` // SHA-256 em hexadecimal
+	Chave       string        `json:"-" gorm:"type:varchar(255);not null;uniqueIndex"`
+
+	EnviadoPorID   string `json:"enviado_por_id" gorm:"type:varchar(100)"`
+	EnviadoPorNome string `json:"enviado_por_nome" gorm:"type:varchar(100)"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+// NewAnexo cria uma nova instância de Anexo
+func NewAnexo(entidade EntidadeAnexo, entidadeID uuid.UUID, tipo TipoAnexo, nomeArquivo string) *Anexo {
+	anexo := &Anexo{
+		ID:          uuid.New(),
+		Entidade:    entidade,
+		EntidadeID:  entidadeID,
+		Tipo:        tipo,
+		NomeArquivo: nomeArquivoSeguro(nomeArquivo),
+		CreatedAt:   time.Now(),
+	}
+	anexo.Chave = strings.ToLower(string(entidade)) + "/" + entidadeID.String() + "/" + anexo.ID.String()
+	return anexo
+}
+
+// Validar verifica se o anexo é válido
+func (a *Anexo) Validar() error {
+	switch a.Entidade {
+	case AnexoDeVeiculo, AnexoDeMotorista, AnexoDeViagem, AnexoDeCliente:
+	default:
+		return ErrEntidadeAnexoInvalida
+	}
+
+	switch a.Tipo {
+	case AnexoCNH, AnexoCRLV, AnexoContrato, AnexoComprovante, AnexoFoto, AnexoOutro:
+	default:
+		return ErrTipoAnexoInvalido
+	}
+
+	if a.NomeArquivo == "" {
+		return ErrNomeArquivoAnexoObrigatorio
+	}
+
+	if !TipoConteudoAnexoPermitido(a.ContentType) {
+		return ErrTipoConteudoAnexoNaoPermitido
+	}
+
+	return nil
+}
+
+// TipoConteudoAnexoPermitido informa se o formato do arquivo é aceito
+func TipoConteudoAnexoPermitido(contentType string) bool {
+	for _, permitido := range TiposConteudoAnexo {
+		if contentType == permitido {
+			return true
+		}
+	}
+	return false
+}
+
+// nomeArquivoSeguro descarta o caminho enviado pelo navegador e caracteres de
+// controle, que quebrariam o cabeçalho do download
+func nomeArquivoSeguro(nome string) string {
+	nome = path.Base(strings.ReplaceAll(nome, "\\", "/"))
+	nome = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, nome)
+	if nome == "." || nome == "/" {
+		return ""
+	}
+	for len(nome) > 255 {
+		_, tamanho := utf8.DecodeLastRuneInString(nome)
+		nome = nome[:len(nome)-tamanho]
+	}
+	return nome
+}
+
+// Erros de domínio
+var (
+	ErrEntidadeAnexoInvalida         = NewDomainError("entidade do anexo inválida")
+	ErrTipoAnexoInvalido             = NewDomainError("tipo de anexo inválido")
+	ErrNomeArquivoAnexoObrigatorio   = NewDomainError("nome do arquivo é obrigatório")
+	ErrTipoConteudoAnexoNaoPermitido = NewDomainError("formato de arquivo não permitido; envie PDF, JPEG ou PNG")
+)
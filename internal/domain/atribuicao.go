@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parâmetros usados na sugestão de veículo e motorista
+const (
+	// DescansoMinimoEntreJornadas é o intervalo mínimo entre duas viagens do mesmo motorista
+	DescansoMinimoEntreJornadas = 11 * time.Hour
+	// JornadaSemanalReferencia é a carga semanal, em horas, considerada cheia
+	JornadaSemanalReferencia = 44.0
+	// DistanciaReposicionamentoMaxima é a distância, em km, a partir da qual o
+	// deslocamento até a origem não pontua mais
+	DistanciaReposicionamentoMaxima = 300.0
+)
+
+// Pesos dos critérios de pontuação; a soma é 100
+const (
+	pesoCapacidade           = 30.0
+	pesoCargaHoraria         = 20.0
+	pesoManutencao           = 15.0
+	pesoDistanciaVeiculo     = 15.0
+	pesoDistanciaMotorista   = 10.0
+	pesoCategoriaCNHAdequada = 10.0
+)
+
+// SolicitacaoAtribuicao descreve a viagem para a qual se buscam veículo e motorista
+type SolicitacaoAtribuicao struct {
+	DataInicio            time.Time
+	DataFim               time.Time
+	QuantidadePassageiros int
+	Origem                string
+	CoordenadasOrigem     string
+	ComodidadesExigidas   []string
+
+	DirecaoPrevistaMinutos int
+	// Revezamento indica que a viagem exige um motorista secundário
+	Revezamento bool
+}
+
+// AgendaRecurso resume as viagens de um veículo ou motorista em torno do
+// período solicitado
+type AgendaRecurso struct {
+	Anterior    *Viagem   // última viagem encerrada antes do início
+	HorasSemana float64   // horas em viagem nos 7 dias anteriores ao início
+	Viagens     []*Viagem // viagens consideradas nas regras de jornada
+}
+
+// SugestaoAtribuicao é um par veículo/motorista apto para a viagem, com a
+// pontuação obtida e as observações que a justificam
+type SugestaoAtribuicao struct {
+	Veiculo             *Veiculo
+	Motorista           *Motorista
+	MotoristaSecundario *Motorista
+	Pontuacao           float64
+	Observacoes         []string
+}
+
+// MontarAgenda resume as viagens não canceladas do recurso em relação ao
+// período de dataInicio a dataFim
+func MontarAgenda(viagens []*Viagem, dataInicio, dataFim time.Time) AgendaRecurso {
+	var agenda AgendaRecurso
+	semana := dataInicio.AddDate(0, 0, -7)
+
+	for _, v := range viagens {
+		if v.Status == StatusCancelada {
+			continue
+		}
+		if !v.DataFim.After(dataInicio) && (agenda.Anterior == nil || v.DataFim.After(agenda.Anterior.DataFim)) {
+			agenda.Anterior = v
+		}
+		agenda.Viagens = append(agenda.Viagens, v)
+		agenda.HorasSemana += sobreposicao(v.DataInicio, v.DataFim, semana, dataInicio).Hours()
+	}
+
+	return agenda
+}
+
+// PontuarAtribuicao avalia o par veículo/motorista para a solicitação. O
+// segundo retorno é falso quando o par não pode atender a viagem: capacidade
+// insuficiente, CNH incompatível ou vencida, ou violação das regras de jornada
+// do motorista.
+func PontuarAtribuicao(s SolicitacaoAtribuicao, veiculo *Veiculo, agendaVeiculo AgendaRecurso,
+	motorista *Motorista, agendaMotorista AgendaRecurso) (*SugestaoAtribuicao, bool) {
+	if veiculo.Capacidade < s.QuantidadePassageiros {
+		return nil, false
+	}
+	if motorista.PodeConduzir(veiculo) != nil || motorista.CNHValidaAte(s.DataFim) != nil {
+		return nil, false
+	}
+	if len(VerificarJornada(motorista.ID, s.viagem(motorista.ID), agendaMotorista.Viagens)) > 0 {
+		return nil, false
+	}
+
+	sugestao := &SugestaoAtribuicao{Veiculo: veiculo, Motorista: motorista}
+
+	// Capacidade: quanto menos lugares sobrando, melhor
+	passageiros := s.QuantidadePassageiros
+	if passageiros < 1 {
+		passageiros = 1
+	}
+	sugestao.Pontuacao += pesoCapacidade * float64(passageiros) / float64(veiculo.Capacidade)
+
+	// Categoria: evita ocupar motoristas de categoria mais alta que a necessária
+	if motorista.TipoCNH == CategoriaMinimaCNH(veiculo.Tipo, veiculo.Capacidade) {
+		sugestao.Pontuacao += pesoCategoriaCNHAdequada
+	}
+
+	// Carga horária do motorista na última semana
+	carga := math.Min(agendaMotorista.HorasSemana/JornadaSemanalReferencia, 1)
+	sugestao.Pontuacao += pesoCargaHoraria * (1 - carga)
+	if carga >= 1 {
+		sugestao.Observacoes = append(sugestao.Observacoes,
+			fmt.Sprintf("motorista com %.0fh em viagem nos últimos 7 dias", agendaMotorista.HorasSemana))
+	}
+
+	// Manutenção prevista
+	switch {
+	case veiculo.ProximaManutencao.IsZero() || veiculo.ProximaManutencao.After(s.DataFim.AddDate(0, 0, 7)):
+		sugestao.Pontuacao += pesoManutencao
+	case veiculo.ProximaManutencao.After(s.DataFim):
+		sugestao.Pontuacao += pesoManutencao / 2
+		sugestao.Observacoes = append(sugestao.Observacoes, "manutenção prevista para a semana seguinte à viagem")
+	default:
+		sugestao.Observacoes = append(sugestao.Observacoes, "manutenção prevista antes do fim da viagem")
+	}
+
+	// Deslocamento até a origem a partir do destino da viagem anterior
+	sugestao.Pontuacao += pesoDistanciaVeiculo * proximidadeOrigem(s, agendaVeiculo.Anterior)
+	sugestao.Pontuacao += pesoDistanciaMotorista * proximidadeOrigem(s, agendaMotorista.Anterior)
+	if agendaVeiculo.Anterior != nil {
+		if km, ok := DistanciaKm(agendaVeiculo.Anterior.CoordenadasDestino, s.CoordenadasOrigem); ok {
+			sugestao.Observacoes = append(sugestao.Observacoes,
+				fmt.Sprintf("veículo a %.0f km da origem", km))
+		}
+	}
+
+	sugestao.Pontuacao = math.Round(sugestao.Pontuacao*100) / 100
+	return sugestao, true
+}
+
+// viagem monta a viagem solicitada conduzida pelo motorista, para aplicar as
+// regras de jornada. Com revezamento, o motorista secundário ainda não é
+// conhecido; basta que a viagem o tenha.
+func (s SolicitacaoAtribuicao) viagem(motoristaID uuid.UUID) *Viagem {
+	viagem := &Viagem{
+		ID:                     uuid.New(),
+		MotoristaID:            motoristaID,
+		DataInicio:             s.DataInicio,
+		DataFim:                s.DataFim,
+		DirecaoPrevistaMinutos: s.DirecaoPrevistaMinutos,
+	}
+	if s.Revezamento {
+		secundario := uuid.Nil
+		viagem.MotoristaSecundarioID = &secundario
+	}
+	return viagem
+}
+
+// ComporRevezamento completa as sugestões de um mesmo veículo com o motorista
+// secundário: cada motorista é pareado com o de maior pontuação entre os
+// demais, e a sugestão passa a valer a média das duas pontuações. Com menos de
+// dois motoristas não há revezamento possível.
+func ComporRevezamento(sugestoes []*SugestaoAtribuicao) []*SugestaoAtribuicao {
+	if len(sugestoes) < 2 {
+		return nil
+	}
+
+	ordenadas := make([]*SugestaoAtribuicao, len(sugestoes))
+	copy(ordenadas, sugestoes)
+	sort.SliceStable(ordenadas, func(i, j int) bool {
+		return ordenadas[i].Pontuacao > ordenadas[j].Pontuacao
+	})
+
+	compostas := make([]*SugestaoAtribuicao, len(ordenadas))
+	for i, principal := range ordenadas {
+		secundaria := ordenadas[0]
+		if i == 0 {
+			secundaria = ordenadas[1]
+		}
+
+		composta := *principal
+		composta.MotoristaSecundario = secundaria.Motorista
+		composta.Pontuacao = math.Round((principal.Pontuacao+secundaria.Pontuacao)/2*100) / 100
+		composta.Observacoes = append(append([]string(nil), principal.Observacoes...),
+			fmt.Sprintf("revezamento com %s", secundaria.Motorista.Nome))
+		compostas[i] = &composta
+	}
+	return compostas
+}
+
+// proximidadeOrigem retorna de 0 a 1 o quão perto da origem o recurso estará
+// ao fim da viagem anterior. Sem viagem anterior ou sem como comparar os
+// locais, o resultado é neutro (0,5).
+func proximidadeOrigem(s SolicitacaoAtribuicao, anterior *Viagem) float64 {
+	if anterior == nil {
+		return 0.5
+	}
+	if km, ok := DistanciaKm(anterior.CoordenadasDestino, s.CoordenadasOrigem); ok {
+		return math.Max(0, 1-km/DistanciaReposicionamentoMaxima)
+	}
+	if anterior.Destino == "" || s.Origem == "" {
+		return 0.5
+	}
+	if strings.EqualFold(strings.TrimSpace(anterior.Destino), strings.TrimSpace(s.Origem)) {
+		return 1
+	}
+	return 0
+}
+
+// DistanciaKm calcula a distância em linha reta entre duas coordenadas no
+// formato "latitude,longitude". O segundo retorno é falso se alguma delas não
+// puder ser interpretada.
+func DistanciaKm(coordenadasA, coordenadasB string) (float64, bool) {
+	latA, lngA, ok := parseCoordenadas(coordenadasA)
+	if !ok {
+		return 0, false
+	}
+	latB, lngB, ok := parseCoordenadas(coordenadasB)
+	if !ok {
+		return 0, false
+	}
+
+	const raioTerraKm = 6371.0
+	rad := math.Pi / 180
+	dLat := (latB - latA) * rad
+	dLng := (lngB - lngA) * rad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(latA*rad)*math.Cos(latB*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * raioTerraKm * math.Asin(math.Sqrt(h)), true
+}
+
+func parseCoordenadas(coordenadas string) (lat, lng float64, ok bool) {
+	partes := strings.Split(coordenadas, ",")
+	if len(partes) != 2 {
+		return 0, 0, false
+	}
+	lat, errLat := strconv.ParseFloat(strings.TrimSpace(partes[0]), 64)
+	lng, errLng := strconv.ParseFloat(strings.TrimSpace(partes[1]), 64)
+	if errLat != nil || errLng != nil || math.Abs(lat) > 90 || math.Abs(lng) > 180 {
+		return 0, 0, false
+	}
+	return lat, lng, true
+}
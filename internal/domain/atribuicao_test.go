@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMontarAgenda(t *testing.T) {
+	inicio := time.Date(2026, 8, 10, 8, 0, 0, 0, time.UTC)
+	fim := inicio.Add(10 * time.Hour)
+	h := time.Hour
+
+	ontem := viagemJornada(inicio.AddDate(0, 0, -1), 10*h)
+	antiga := viagemJornada(inicio.AddDate(0, 0, -10), 4*h)
+	inicioDaSemana := viagemJornada(inicio.AddDate(0, 0, -7).Add(-2*h), 4*h)
+	naSemana := viagemJornada(inicio.AddDate(0, 0, -3), 4*h)
+	futura := viagemJornada(inicio.AddDate(0, 0, 1), 2*h)
+	cancelada := viagemJornada(inicio.Add(-5*h), 4*h)
+	cancelada.Status = StatusCancelada
+
+	agenda := MontarAgenda([]*Viagem{antiga, ontem, cancelada, inicioDaSemana, naSemana, futura}, inicio, fim)
+
+	if agenda.Anterior != ontem {
+		t.Errorf("Anterior = %v, esperado a viagem de ontem", agenda.Anterior)
+	}
+	// 10h de ontem, 4h três dias antes e 2h da viagem que cruza o início da semana
+	if agenda.HorasSemana != 16 {
+		t.Errorf("HorasSemana = %v, esperado 16", agenda.HorasSemana)
+	}
+	if esperado := []*Viagem{antiga, ontem, inicioDaSemana, naSemana, futura}; !slices.Equal(agenda.Viagens, esperado) {
+		t.Errorf("Viagens com %d viagens, esperado %d sem a cancelada", len(agenda.Viagens), len(esperado))
+	}
+
+	if vazia := MontarAgenda(nil, inicio, fim); vazia.Anterior != nil || vazia.HorasSemana != 0 || vazia.Viagens != nil {
+		t.Errorf("agenda sem viagens = %+v", vazia)
+	}
+}
+
+func TestPontuarAtribuicao(t *testing.T) {
+	inicio := time.Date(2026, 8, 10, 8, 0, 0, 0, time.UTC)
+	h := time.Hour
+	coordenadasOrigem := "-25.4284,-49.2733"
+
+	solicitacao := func(duracao time.Duration) SolicitacaoAtribuicao {
+		return SolicitacaoAtribuicao{
+			DataInicio:            inicio,
+			DataFim:               inicio.Add(duracao),
+			QuantidadePassageiros: 10,
+			Origem:                "Curitiba",
+			CoordenadasOrigem:     coordenadasOrigem,
+		}
+	}
+	comRevezamento := func(s SolicitacaoAtribuicao) SolicitacaoAtribuicao {
+		s.Revezamento = true
+		return s
+	}
+	veiculo := func(capacidade int, proximaManutencao time.Time) *Veiculo {
+		return &Veiculo{ID: uuid.New(), Tipo: TipoVan, Capacidade: capacidade, ProximaManutencao: proximaManutencao}
+	}
+	motorista := func(categoria TipoCNH, validade time.Time) *Motorista {
+		return &Motorista{ID: uuid.New(), Nome: "Motorista", TipoCNH: categoria, ValidadeCNH: validade}
+	}
+	anterior := func(destino, coordenadas string) AgendaRecurso {
+		v := viagemJornada(inicio.Add(-24*h), 4*h)
+		v.Destino = destino
+		v.CoordenadasDestino = coordenadas
+		return AgendaRecurso{Anterior: v, Viagens: []*Viagem{v}}
+	}
+
+	van := veiculo(15, time.Time{})
+	validade := inicio.AddDate(1, 0, 0)
+	habilitado := motorista(CNHD, validade)
+
+	// Capacidade 20 + categoria 10 + carga 20 + manutenção 15 + deslocamentos neutros 7,5 e 5
+	const base = 77.5
+
+	casos := []struct {
+		nome            string
+		solicitacao     SolicitacaoAtribuicao
+		veiculo         *Veiculo
+		motorista       *Motorista
+		agendaVeiculo   AgendaRecurso
+		agendaMotorista AgendaRecurso
+		apto            bool
+		pontuacao       float64
+		observacoes     []string
+	}{
+		{
+			nome:        "par apto sem agenda",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: habilitado,
+			apto: true, pontuacao: base,
+		},
+		{
+			nome:        "capacidade insuficiente",
+			solicitacao: solicitacao(4 * h), veiculo: veiculo(8, time.Time{}), motorista: habilitado,
+		},
+		{
+			nome:        "CNH incompatível com o veículo",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: motorista(CNHB, validade),
+		},
+		{
+			nome:        "CNH vence durante a viagem",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: motorista(CNHD, inicio.Add(2*h)),
+		},
+		{
+			nome:        "categoria acima da necessária",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: motorista(CNHE, validade),
+			apto: true, pontuacao: base - pesoCategoriaCNHAdequada,
+		},
+		{
+			nome:        "motorista com a semana cheia",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: habilitado,
+			agendaMotorista: AgendaRecurso{HorasSemana: JornadaSemanalReferencia},
+			apto:            true, pontuacao: base - pesoCargaHoraria,
+			observacoes: []string{"motorista com 44h em viagem nos últimos 7 dias"},
+		},
+		{
+			nome:        "manutenção na semana seguinte",
+			solicitacao: solicitacao(4 * h), veiculo: veiculo(15, inicio.AddDate(0, 0, 3)), motorista: habilitado,
+			apto: true, pontuacao: base - pesoManutencao/2,
+			observacoes: []string{"manutenção prevista para a semana seguinte à viagem"},
+		},
+		{
+			nome:        "manutenção antes do fim da viagem",
+			solicitacao: solicitacao(4 * h), veiculo: veiculo(15, inicio.Add(2*h)), motorista: habilitado,
+			apto: true, pontuacao: base - pesoManutencao,
+			observacoes: []string{"manutenção prevista antes do fim da viagem"},
+		},
+		{
+			nome:        "veículo na origem pelas coordenadas",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: habilitado,
+			agendaVeiculo: anterior("", coordenadasOrigem),
+			apto:          true, pontuacao: base + pesoDistanciaVeiculo/2,
+			observacoes: []string{"veículo a 0 km da origem"},
+		},
+		{
+			nome:        "motorista em outra cidade",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: habilitado,
+			agendaMotorista: anterior("Londrina", ""),
+			apto:            true, pontuacao: base - pesoDistanciaMotorista/2,
+		},
+		{
+			nome:        "motorista sem pausa após a viagem anterior",
+			solicitacao: solicitacao(4 * h), veiculo: van, motorista: habilitado,
+			agendaMotorista: AgendaRecurso{Viagens: []*Viagem{viagemJornada(inicio.Add(-3*h-15*time.Minute), 3*h)}},
+		},
+		{
+			nome:        "direção acima do limite sem revezamento",
+			solicitacao: solicitacao(8 * h), veiculo: van, motorista: habilitado,
+		},
+		{
+			nome:        "direção acima do limite com revezamento",
+			solicitacao: comRevezamento(solicitacao(8 * h)), veiculo: van, motorista: habilitado,
+			apto: true, pontuacao: base,
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			sugestao, apto := PontuarAtribuicao(c.solicitacao, c.veiculo, c.agendaVeiculo, c.motorista, c.agendaMotorista)
+			if apto != c.apto {
+				t.Fatalf("apto = %v, esperado %v", apto, c.apto)
+			}
+			if !apto {
+				return
+			}
+			if sugestao.Veiculo != c.veiculo || sugestao.Motorista != c.motorista {
+				t.Errorf("sugestão com outro veículo ou motorista")
+			}
+			if sugestao.Pontuacao != c.pontuacao {
+				t.Errorf("Pontuacao = %v, esperado %v", sugestao.Pontuacao, c.pontuacao)
+			}
+			if !slices.Equal(sugestao.Observacoes, c.observacoes) {
+				t.Errorf("Observacoes = %q, esperado %q", sugestao.Observacoes, c.observacoes)
+			}
+		})
+	}
+}
+
+func TestComporRevezamento(t *testing.T) {
+	sugestao := func(nome string, pontuacao float64, observacoes ...string) *SugestaoAtribuicao {
+		return &SugestaoAtribuicao{
+			Veiculo:     &Veiculo{},
+			Motorista:   &Motorista{ID: uuid.New(), Nome: nome},
+			Pontuacao:   pontuacao,
+			Observacoes: observacoes,
+		}
+	}
+
+	if compostas := ComporRevezamento([]*SugestaoAtribuicao{sugestao("Ana", 80)}); compostas != nil {
+		t.Errorf("revezamento com um único motorista = %v, esperado nil", compostas)
+	}
+
+	ana := sugestao("Ana", 80, "manutenção prevista para a semana seguinte à viagem")
+	bruno := sugestao("Bruno", 90)
+	carla := sugestao("Carla", 70)
+
+	esperado := []struct {
+		principal   *Motorista
+		secundario  *Motorista
+		pontuacao   float64
+		observacoes []string
+	}{
+		{bruno.Motorista, ana.Motorista, 85, []string{"revezamento com Ana"}},
+		{ana.Motorista, bruno.Motorista, 85, []string{"manutenção prevista para a semana seguinte à viagem", "revezamento com Bruno"}},
+		{carla.Motorista, bruno.Motorista, 80, []string{"revezamento com Bruno"}},
+	}
+
+	compostas := ComporRevezamento([]*SugestaoAtribuicao{ana, bruno, carla})
+	if len(compostas) != len(esperado) {
+		t.Fatalf("%d sugestões compostas, esperado %d", len(compostas), len(esperado))
+	}
+	for i, e := range esperado {
+		c := compostas[i]
+		if c.Motorista != e.principal || c.MotoristaSecundario != e.secundario {
+			t.Errorf("sugestão %d = %s com %s, esperado %s com %s", i,
+				c.Motorista.Nome, c.MotoristaSecundario.Nome, e.principal.Nome, e.secundario.Nome)
+		}
+		if c.Pontuacao != e.pontuacao {
+			t.Errorf("sugestão %d com pontuação %v, esperado %v", i, c.Pontuacao, e.pontuacao)
+		}
+		if !slices.Equal(c.Observacoes, e.observacoes) {
+			t.Errorf("sugestão %d com observações %q, esperado %q", i, c.Observacoes, e.observacoes)
+		}
+	}
+
+	// As sugestões originais continuam sem revezamento
+	if ana.MotoristaSecundario != nil || ana.Pontuacao != 80 || len(ana.Observacoes) != 1 {
+		t.Errorf("sugestão original alterada: %+v", ana)
+	}
+}
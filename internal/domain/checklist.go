@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var regexCodigoItemChecklist = regexp.MustCompile(`^[A-Z0-9_]{2,40}$`)
+
+// ItemChecklist é um item a conferir na inspeção de saída. Item crítico
+// reprovado impede o início da viagem.
+type ItemChecklist struct {
+	Codigo    string `json:"codigo"`
+	Descricao string `json:"descricao"`
+	Critico   bool   `json:"critico"`
+}
+
+// ItensChecklistPadrao são os itens conferidos nos tipos de veículo sem
+// modelo de checklist configurado
+func ItensChecklistPadrao() []ItemChecklist {
+	return []ItemChecklist{
+		{Codigo: "PNEUS", Descricao: "Pneus calibrados e sem desgaste excessivo, incluindo o estepe", Critico: true},
+		{Codigo: "LUZES", Descricao: "Faróis, lanternas, setas e luz de freio funcionando", Critico: true},
+		{Codigo: "EXTINTOR", Descricao: "Extintor carregado e dentro da validade", Critico: true},
+		{Codigo: "CINTOS", Descricao: "Cintos de segurança de todos os assentos funcionando", Critico: true},
+		{Codigo: "CRONOTACOGRAFO", Descricao: "Cronotacógrafo funcionando e com disco ou fita", Critico: true},
+		{Codigo: "LIMPEZA", Descricao: "Interior limpo", Critico: false},
+	}
+}
+
+// ModeloChecklist define os itens da inspeção de saída de um tipo de veículo.
+// Com AbrirManutencao, a inspeção com itens reprovados abre uma ordem de
+// manutenção corretiva para o veículo.
+type ModeloChecklist struct {
+	ID              uuid.UUID       `json:"id" gorm:"type:uuid;primary_key"`
+	TipoVeiculo     TipoVeiculo     `json:"tipo_veiculo" gorm:"type:varchar(20);uniqueIndex;not null"`
+	Itens           []ItemChecklist `json:"itens" gorm:"type:jsonb;serializer:json;not null"`
+	AbrirManutencao bool            `json:"abrir_manutencao" gorm:"not null;default:false"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewModeloChecklist cria uma nova instância de ModeloChecklist
+func NewModeloChecklist(tipo TipoVeiculo, itens []ItemChecklist, abrirManutencao bool) *ModeloChecklist {
+	for i := range itens {
+		itens[i].Codigo = strings.ToUpper(strings.TrimSpace(itens[i].Codigo))
+	}
+	return &ModeloChecklist{
+		ID:              uuid.New(),
+		TipoVeiculo:     tipo,
+		Itens:           itens,
+		AbrirManutencao: abrirManutencao,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+// ModeloChecklistPadrao é o modelo usado para o tipo de veículo sem modelo
+// configurado. Não é gravado e por isso não tem ID.
+func ModeloChecklistPadrao(tipo TipoVeiculo) *ModeloChecklist {
+	return &ModeloChecklist{TipoVeiculo: tipo, Itens: ItensChecklistPadrao()}
+}
+
+// Validar verifica se o modelo de checklist é válido
+func (m *ModeloChecklist) Validar() error {
+	switch m.TipoVeiculo {
+	case TipoVan, TipoOnibus, TipoMicroOnibus:
+	default:
+		return ErrTipoVeiculoChecklistInvalido
+	}
+
+	if len(m.Itens) == 0 {
+		return ErrChecklistSemItens
+	}
+
+	codigos := make(map[string]bool, len(m.Itens))
+	for _, item := range m.Itens {
+		if !regexCodigoItemChecklist.MatchString(item.Codigo) {
+			return ErrCodigoItemChecklistInvalido
+		}
+		if strings.TrimSpace(item.Descricao) == "" {
+			return ErrDescricaoItemChecklistObrigatoria
+		}
+		if codigos[item.Codigo] {
+			return ErrItemChecklistDuplicado
+		}
+		codigos[item.Codigo] = true
+	}
+
+	return nil
+}
+
+// RespostaItemChecklist é a conferência de um item informada pelo motorista
+type RespostaItemChecklist struct {
+	Codigo     string `json:"codigo"`
+	Conforme   bool   `json:"conforme"`
+	Observacao string `json:"observacao,omitempty"`
+}
+
+// ResultadoItemChecklist guarda o item do modelo junto com a conferência, para
+// que a inspeção não mude quando o modelo for alterado
+type ResultadoItemChecklist struct {
+	ItemChecklist
+	Conforme   bool   `json:"conforme"`
+	Observacao string `json:"observacao,omitempty"`
+}
+
+// InspecaoVeiculo é a inspeção de saída do veículo de uma viagem. A viagem só
+// começa se a última inspeção do veículo atribuído não reprovar item crítico.
+type InspecaoVeiculo struct {
+	ID        uuid.UUID                `json:"id" gorm:"type:uuid;primary_key"`
+	ViagemID  uuid.UUID                `json:"viagem_id" gorm:"type:uuid;not null;index"`
+	VeiculoID uuid.UUID                `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	Itens     []ResultadoItemChecklist `json:"itens" gorm:"type:jsonb;serializer:json;not null"`
+	Aprovada  bool                     `json:"aprovada" gorm:"not null"` // sem item crítico reprovado
+
+	OrdemManutencaoID *uuid.UUID `json:"ordem_manutencao_id,omitempty" gorm:"type:uuid"`
+	Observacoes       string     `json:"observacoes" gorm:"type:text"`
+	RealizadaPor      Ator       `json:"realizada_por" gorm:"embedded"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null;index"`
+}
+
+// NewInspecaoVeiculo confere as respostas contra os itens do modelo. Todos os
+// itens do modelo devem ser respondidos, e só eles.
+func NewInspecaoVeiculo(viagem *Viagem, modelo *ModeloChecklist, respostas []RespostaItemChecklist) (*InspecaoVeiculo, error) {
+	porCodigo := make(map[string]RespostaItemChecklist, len(respostas))
+	for _, r := range respostas {
+		codigo := strings.ToUpper(strings.TrimSpace(r.Codigo))
+		if _, repetida := porCodigo[codigo]; repetida {
+			return nil, ErrItemChecklistDuplicado
+		}
+		porCodigo[codigo] = r
+	}
+
+	inspecao := &InspecaoVeiculo{
+		ID:        uuid.New(),
+		ViagemID:  viagem.ID,
+		VeiculoID: viagem.VeiculoID,
+		Itens:     make([]ResultadoItemChecklist, 0, len(modelo.Itens)),
+		Aprovada:  true,
+		CreatedAt: time.Now(),
+	}
+
+	for _, item := range modelo.Itens {
+		resposta, ok := porCodigo[item.Codigo]
+		if !ok {
+			return nil, NewDomainError("item do checklist sem resposta: " + item.Codigo)
+		}
+		delete(porCodigo, item.Codigo)
+
+		inspecao.Itens = append(inspecao.Itens, ResultadoItemChecklist{
+			ItemChecklist: item,
+			Conforme:      resposta.Conforme,
+			Observacao:    resposta.Observacao,
+		})
+		if item.Critico && !resposta.Conforme {
+			inspecao.Aprovada = false
+		}
+	}
+
+	for codigo := range porCodigo {
+		return nil, NewDomainError("item não pertence ao checklist do veículo: " + codigo)
+	}
+
+	return inspecao, nil
+}
+
+// Reprovados retorna os itens não conformes, críticos ou não
+func (i *InspecaoVeiculo) Reprovados() []ResultadoItemChecklist {
+	var reprovados []ResultadoItemChecklist
+	for _, item := range i.Itens {
+		if !item.Conforme {
+			reprovados = append(reprovados, item)
+		}
+	}
+	return reprovados
+}
+
+// DescricaoManutencao descreve os itens reprovados para a ordem de manutenção
+// aberta pela inspeção
+func (i *InspecaoVeiculo) DescricaoManutencao() string {
+	var linhas []string
+	for _, item := range i.Reprovados() {
+		linha := item.Descricao
+		if item.Observacao != "" {
+			linha += ": " + item.Observacao
+		}
+		linhas = append(linhas, "- "+linha)
+	}
+	return "Itens reprovados na inspeção de saída:\n" + strings.Join(linhas, "\n")
+}
+
+// VerificarInspecaoSaida confere se o veículo pode partir: a inspeção mais
+// recente do veículo atribuído à viagem deve existir e não reprovar item crítico
+func VerificarInspecaoSaida(viagem *Viagem, inspecoes []*InspecaoVeiculo) error {
+	var ultima *InspecaoVeiculo
+	for _, i := range inspecoes {
+		if i.VeiculoID != viagem.VeiculoID {
+			continue
+		}
+		if ultima == nil || i.CreatedAt.After(ultima.CreatedAt) {
+			ultima = i
+		}
+	}
+
+	if ultima == nil {
+		return ErrInspecaoSaidaPendente
+	}
+	if !ultima.Aprovada {
+		return ErrInspecaoSaidaReprovada
+	}
+	return nil
+}
+
+// Erros de domínio
+var (
+	ErrTipoVeiculoChecklistInvalido      = NewDomainError("tipo de veículo do checklist inválido")
+	ErrChecklistSemItens                 = NewDomainError("checklist deve ter ao menos um item")
+	ErrCodigoItemChecklistInvalido       = NewDomainError("código do item deve ter de 2 a 40 letras maiúsculas, números ou _")
+	ErrDescricaoItemChecklistObrigatoria = NewDomainError("descrição do item do checklist é obrigatória")
+	ErrItemChecklistDuplicado            = NewDomainError("item repetido no checklist")
+	ErrInspecaoSaidaPendente             = NewDomainError("veículo sem inspeção de saída; registre o checklist antes de iniciar a viagem")
+	ErrInspecaoSaidaReprovada            = NewDomainError("inspeção de saída reprovou itens críticos; corrija e registre nova inspeção antes de iniciar a viagem")
+	ErrViagemNaoInspecionavel            = NewDomainError("somente viagens agendadas passam por inspeção de saída")
+)
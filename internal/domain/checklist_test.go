@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewInspecaoVeiculo(t *testing.T) {
+	viagem := &Viagem{ID: uuid.New(), VeiculoID: uuid.New()}
+	modelo := NewModeloChecklist(TipoVan, []ItemChecklist{
+		{Codigo: "PNEUS", Descricao: "Pneus calibrados", Critico: true},
+		{Codigo: "LUZES", Descricao: "Luzes funcionando", Critico: true},
+		{Codigo: "LIMPEZA", Descricao: "Interior limpo"},
+	}, false)
+
+	resposta := func(codigo string, conforme bool) RespostaItemChecklist {
+		return RespostaItemChecklist{Codigo: codigo, Conforme: conforme}
+	}
+
+	casos := []struct {
+		nome       string
+		respostas  []RespostaItemChecklist
+		erro       bool
+		aprovada   bool
+		reprovados []string
+	}{
+		{
+			nome:      "todos conformes",
+			respostas: []RespostaItemChecklist{resposta("PNEUS", true), resposta("LUZES", true), resposta("LIMPEZA", true)},
+			aprovada:  true,
+		},
+		{
+			nome:       "item não crítico reprovado",
+			respostas:  []RespostaItemChecklist{resposta("LIMPEZA", false), resposta("LUZES", true), resposta("PNEUS", true)},
+			aprovada:   true,
+			reprovados: []string{"LIMPEZA"},
+		},
+		{
+			nome:       "item crítico reprovado",
+			respostas:  []RespostaItemChecklist{resposta("PNEUS", true), resposta("LUZES", false), resposta("LIMPEZA", false)},
+			reprovados: []string{"LUZES", "LIMPEZA"},
+		},
+		{
+			nome:      "código em minúsculas",
+			respostas: []RespostaItemChecklist{resposta(" pneus ", true), resposta("luzes", true), resposta("Limpeza", true)},
+			aprovada:  true,
+		},
+		{
+			nome:      "item sem resposta",
+			respostas: []RespostaItemChecklist{resposta("PNEUS", true), resposta("LUZES", true)},
+			erro:      true,
+		},
+		{
+			nome: "item fora do modelo",
+			respostas: []RespostaItemChecklist{resposta("PNEUS", true), resposta("LUZES", true), resposta("LIMPEZA", true),
+				resposta("EXTINTOR", true)},
+			erro: true,
+		},
+		{
+			nome:      "resposta repetida",
+			respostas: []RespostaItemChecklist{resposta("PNEUS", true), resposta("pneus", false), resposta("LUZES", true)},
+			erro:      true,
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			inspecao, err := NewInspecaoVeiculo(viagem, modelo, c.respostas)
+			if c.erro {
+				var domainErr *DomainError
+				if !errors.As(err, &domainErr) {
+					t.Errorf("erro = %v, esperado erro de domínio", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if inspecao.ViagemID != viagem.ID || inspecao.VeiculoID != viagem.VeiculoID {
+				t.Errorf("inspeção da viagem %s e veículo %s", inspecao.ViagemID, inspecao.VeiculoID)
+			}
+			if len(inspecao.Itens) != len(modelo.Itens) {
+				t.Errorf("%d itens, esperado %d", len(inspecao.Itens), len(modelo.Itens))
+			}
+			if inspecao.Aprovada != c.aprovada {
+				t.Errorf("Aprovada = %v, esperado %v", inspecao.Aprovada, c.aprovada)
+			}
+
+			var reprovados []string
+			for _, item := range inspecao.Reprovados() {
+				reprovados = append(reprovados, item.Codigo)
+			}
+			if !slices.Equal(reprovados, c.reprovados) {
+				t.Errorf("Reprovados() = %v, esperado %v", reprovados, c.reprovados)
+			}
+		})
+	}
+}
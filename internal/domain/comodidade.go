@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var regexCodigoComodidade = regexp.MustCompile(`^[A-Z0-9_]{2,40}$`)
+
+// Comodidade é um item do catálogo de comodidades e recursos de acessibilidade
+// que os veículos podem oferecer e as viagens podem exigir
+type Comodidade struct {
+	ID             uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	Codigo         string    `json:"codigo" gorm:"type:varchar(40);uniqueIndex;not null"`
+	Nome           string    `json:"nome" gorm:"type:varchar(100);not null"`
+	Acessibilidade bool      `json:"acessibilidade" gorm:"not null;default:false"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewComodidade cria uma nova instância de Comodidade
+func NewComodidade(codigo, nome string, acessibilidade bool) *Comodidade {
+	return &Comodidade{
+		ID:             uuid.New(),
+		Codigo:         strings.ToUpper(strings.TrimSpace(codigo)),
+		Nome:           nome,
+		Acessibilidade: acessibilidade,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// Validar verifica se a comodidade é válida
+func (c *Comodidade) Validar() error {
+	if !regexCodigoComodidade.MatchString(c.Codigo) {
+		return ErrCodigoComodidadeInvalido
+	}
+
+	if strings.TrimSpace(c.Nome) == "" {
+		return ErrNomeComodidadeObrigatorio
+	}
+
+	return nil
+}
+
+// ComodidadesPadrao é o catálogo inicial, criado na migração do banco
+func ComodidadesPadrao() []*Comodidade {
+	return []*Comodidade{
+		NewComodidade("WIFI", "Wi-Fi", false),
+		NewComodidade("AR_CONDICIONADO", "Ar-condicionado", false),
+		NewComodidade("BANHEIRO", "Banheiro", false),
+		NewComodidade("TOMADA_USB", "Tomadas USB", false),
+		NewComodidade("POLTRONA_RECLINAVEL", "Poltronas reclináveis", false),
+		NewComodidade("ELEVADOR_CADEIRANTE", "Elevador para cadeira de rodas", true),
+	}
+}
+
+// NormalizarComodidades coloca os códigos em maiúsculas, sem repetições e em
+// ordem alfabética
+func NormalizarComodidades(codigos []string) []string {
+	vistos := make(map[string]bool, len(codigos))
+	normalizados := make([]string, 0, len(codigos))
+	for _, codigo := range codigos {
+		codigo = strings.ToUpper(strings.TrimSpace(codigo))
+		if codigo == "" || vistos[codigo] {
+			continue
+		}
+		vistos[codigo] = true
+		normalizados = append(normalizados, codigo)
+	}
+	sort.Strings(normalizados)
+	return normalizados
+}
+
+// ComodidadesFaltantes retorna os códigos exigidos que não estão entre os oferecidos
+func ComodidadesFaltantes(oferecidas, exigidas []string) []string {
+	possui := make(map[string]bool, len(oferecidas))
+	for _, codigo := range oferecidas {
+		possui[codigo] = true
+	}
+
+	var faltantes []string
+	for _, codigo := range exigidas {
+		if !possui[codigo] {
+			faltantes = append(faltantes, codigo)
+		}
+	}
+	return faltantes
+}
+
+// Erros de domínio
+var (
+	ErrCodigoComodidadeInvalido  = NewDomainError("código da comodidade deve ter de 2 a 40 letras maiúsculas, números ou _")
+	ErrNomeComodidadeObrigatorio = NewDomainError("nome da comodidade é obrigatório")
+)
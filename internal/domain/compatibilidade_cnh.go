@@ -0,0 +1,102 @@
+package domain
+
+import "time"
+
+// RegraCompatibilidadeCNH associa um tipo de veículo, a partir de uma
+// capacidade de passageiros, à categoria mínima de CNH exigida para conduzi-lo
+type RegraCompatibilidadeCNH struct {
+	Tipo             TipoVeiculo
+	CapacidadeMinima int
+	Categoria        TipoCNH
+}
+
+// RegrasCompatibilidadeCNH contém as regras aplicadas na atribuição de
+// motoristas. Pelo CTB (art. 143), veículos com mais de 8 passageiros, além do
+// condutor, exigem categoria D; ônibus e micro-ônibus sempre exigem D.
+var RegrasCompatibilidadeCNH = []RegraCompatibilidadeCNH{
+	{Tipo: TipoOnibus, CapacidadeMinima: 0, Categoria: CNHD},
+	{Tipo: TipoMicroOnibus, CapacidadeMinima: 0, Categoria: CNHD},
+	{Tipo: TipoVan, CapacidadeMinima: 0, Categoria: CNHB},
+	{Tipo: TipoVan, CapacidadeMinima: 9, Categoria: CNHD},
+}
+
+// nivelCNH define a hierarquia das categorias de veículos de quatro rodas ou
+// mais: cada categoria habilita a conduzir os veículos das anteriores
+var nivelCNH = map[TipoCNH]int{
+	CNHB: 1,
+	CNHC: 2,
+	CNHD: 3,
+	CNHE: 4,
+}
+
+// CategoriaMinimaCNH retorna a categoria mínima de CNH exigida para conduzir
+// um veículo do tipo e capacidade informados
+func CategoriaMinimaCNH(tipo TipoVeiculo, capacidade int) TipoCNH {
+	categoria := CNHB
+	if capacidade > 8 {
+		categoria = CNHD
+	}
+
+	for _, regra := range RegrasCompatibilidadeCNH {
+		if regra.Tipo != tipo || capacidade < regra.CapacidadeMinima {
+			continue
+		}
+		if nivelCNH[regra.Categoria] > nivelCNH[categoria] {
+			categoria = regra.Categoria
+		}
+	}
+
+	return categoria
+}
+
+// Abrange indica se a categoria habilita a conduzir veículos da categoria mínima informada
+func (c TipoCNH) Abrange(minima TipoCNH) bool {
+	if c == minima {
+		return true
+	}
+
+	nivel, ok := nivelCNH[c]
+	if !ok {
+		return false
+	}
+	nivelMinimo, ok := nivelCNH[minima]
+	if !ok {
+		return false
+	}
+
+	return nivel >= nivelMinimo
+}
+
+// CategoriasQueAbrangem retorna todas as categorias que habilitam a conduzir
+// veículos da categoria mínima informada
+func CategoriasQueAbrangem(minima TipoCNH) []TipoCNH {
+	categorias := []TipoCNH{}
+	for _, c := range []TipoCNH{CNHA, CNHB, CNHC, CNHD, CNHE} {
+		if c.Abrange(minima) {
+			categorias = append(categorias, c)
+		}
+	}
+	return categorias
+}
+
+// PodeConduzir verifica se a categoria da CNH do motorista é compatível com o veículo
+func (m *Motorista) PodeConduzir(v *Veiculo) error {
+	if !m.TipoCNH.Abrange(CategoriaMinimaCNH(v.Tipo, v.Capacidade)) {
+		return ErrCategoriaCNHIncompativel
+	}
+	return nil
+}
+
+// CNHValidaAte verifica se a CNH do motorista permanece válida até a data informada
+func (m *Motorista) CNHValidaAte(data time.Time) error {
+	if m.ValidadeCNH.Before(data) {
+		return ErrCNHVenceDuranteViagem
+	}
+	return nil
+}
+
+// Erros de domínio
+var (
+	ErrCategoriaCNHIncompativel = NewDomainError("categoria da CNH do motorista não permite conduzir o veículo")
+	ErrCNHVenceDuranteViagem    = NewDomainError("CNH do motorista vence antes do fim da viagem")
+)
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCategoriaMinimaCNH(t *testing.T) {
+	casos := []struct {
+		tipo       TipoVeiculo
+		capacidade int
+		categoria  TipoCNH
+	}{
+		{TipoVan, 8, CNHB},
+		{TipoVan, 9, CNHD},
+		{TipoVan, 15, CNHD},
+		{TipoMicroOnibus, 8, CNHD},
+		{TipoOnibus, 44, CNHD},
+		{TipoVeiculo("CARRO"), 4, CNHB},
+		{TipoVeiculo("CARRO"), 9, CNHD},
+	}
+
+	for _, c := range casos {
+		if categoria := CategoriaMinimaCNH(c.tipo, c.capacidade); categoria != c.categoria {
+			t.Errorf("CategoriaMinimaCNH(%s, %d) = %s, esperado %s", c.tipo, c.capacidade, categoria, c.categoria)
+		}
+	}
+}
+
+func TestTipoCNHAbrange(t *testing.T) {
+	casos := []struct {
+		categoria TipoCNH
+		minima    TipoCNH
+		abrange   bool
+	}{
+		{CNHB, CNHB, true},
+		{CNHD, CNHB, true},
+		{CNHE, CNHD, true},
+		{CNHB, CNHD, false},
+		{CNHC, CNHD, false},
+		{CNHA, CNHA, true},
+		{CNHA, CNHB, false},
+		{CNHE, CNHA, false},
+		{TipoCNH(""), CNHB, false},
+	}
+
+	for _, c := range casos {
+		if abrange := c.categoria.Abrange(c.minima); abrange != c.abrange {
+			t.Errorf("%q.Abrange(%q) = %v, esperado %v", c.categoria, c.minima, abrange, c.abrange)
+		}
+	}
+}
+
+func TestCategoriasQueAbrangem(t *testing.T) {
+	casos := map[TipoCNH][]TipoCNH{
+		CNHA: {CNHA},
+		CNHB: {CNHB, CNHC, CNHD, CNHE},
+		CNHD: {CNHD, CNHE},
+	}
+
+	for minima, esperado := range casos {
+		if categorias := CategoriasQueAbrangem(minima); !slices.Equal(categorias, esperado) {
+			t.Errorf("CategoriasQueAbrangem(%s) = %v, esperado %v", minima, categorias, esperado)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AntecedenciaAvisoConformidadePadrao é o prazo após o fim da viagem dentro do
+// qual um vencimento gera aviso, quando não configurado outro prazo
+const AntecedenciaAvisoConformidadePadrao = 30 * 24 * time.Hour
+
+// RecursoConformidade identifica o recurso da viagem a que a pendência se refere
+type RecursoConformidade string
+
+const (
+	RecursoVeiculo   RecursoConformidade = "VEICULO"
+	RecursoMotorista RecursoConformidade = "MOTORISTA"
+)
+
+// CodigoConformidade identifica a pendência encontrada
+type CodigoConformidade string
+
+const (
+	ConformidadeDocumentoAusente      CodigoConformidade = "DOCUMENTO_AUSENTE"
+	ConformidadeDocumentoVencido      CodigoConformidade = "DOCUMENTO_VENCIDO"
+	ConformidadeDocumentoAVencer      CodigoConformidade = "DOCUMENTO_A_VENCER"
+	ConformidadeManutencaoVencida     CodigoConformidade = "MANUTENCAO_VENCIDA"
+	ConformidadeManutencaoProxima     CodigoConformidade = "MANUTENCAO_PROXIMA"
+	ConformidadeCNHVencida            CodigoConformidade = "CNH_VENCIDA"
+	ConformidadeCNHAVencer            CodigoConformidade = "CNH_A_VENCER"
+	ConformidadeMotoristaIndisponivel CodigoConformidade = "MOTORISTA_INDISPONIVEL"
+)
+
+// PendenciaConformidade descreve um impedimento ou aviso sobre o veículo ou
+// um motorista da viagem
+type PendenciaConformidade struct {
+	Recurso    RecursoConformidade `json:"recurso"`
+	RecursoID  uuid.UUID           `json:"recurso_id"`
+	Codigo     CodigoConformidade  `json:"codigo"`
+	Descricao  string              `json:"descricao"`
+	Vencimento *time.Time          `json:"vencimento,omitempty"`
+}
+
+// Conformidade reúne os impedimentos que bloqueiam a viagem e os avisos de
+// vencimentos próximos
+type Conformidade struct {
+	Bloqueios []PendenciaConformidade `json:"bloqueios"`
+	Avisos    []PendenciaConformidade `json:"avisos"`
+}
+
+// ErroConformidade indica que o veículo ou algum motorista não pode realizar a viagem
+type ErroConformidade struct {
+	Conformidade
+}
+
+func (e *ErroConformidade) Error() string {
+	descricoes := make([]string, len(e.Bloqueios))
+	for i, b := range e.Bloqueios {
+		descricoes[i] = b.Descricao
+	}
+	return "veículo ou motorista em situação irregular: " + strings.Join(descricoes, "; ")
+}
+
+// VerificarConformidade avalia a documentação e a manutenção do veículo e a CNH
+// e a situação dos motoristas para o período da viagem. O que vence antes do
+// fim da viagem bloqueia; o que vence até antecedenciaAviso depois dele gera aviso.
+func VerificarConformidade(viagem *Viagem, veiculo *Veiculo, documentos []*DocumentoVeiculo,
+	motoristas []*Motorista, antecedenciaAviso time.Duration) Conformidade {
+	var c Conformidade
+	limiteAviso := viagem.DataFim.Add(antecedenciaAviso)
+
+	vigentes := DocumentosVigentes(documentos)
+	for _, tipo := range DocumentosObrigatorios {
+		d, ok := vigentes[tipo]
+		switch {
+		case !ok:
+			c.bloquear(RecursoVeiculo, veiculo.ID, ConformidadeDocumentoAusente, time.Time{},
+				"veículo %s sem %s cadastrado", veiculo.Placa, tipo)
+		case d.ValidoAte.Before(viagem.DataFim):
+			c.bloquear(RecursoVeiculo, veiculo.ID, ConformidadeDocumentoVencido, d.ValidoAte,
+				"%s do veículo %s vence antes do fim da viagem", tipo, veiculo.Placa)
+		case d.ValidoAte.Before(limiteAviso):
+			c.avisar(RecursoVeiculo, veiculo.ID, ConformidadeDocumentoAVencer, d.ValidoAte,
+				"%s do veículo %s vence logo após a viagem", tipo, veiculo.Placa)
+		}
+	}
+
+	switch {
+	case !veiculo.ProximaManutencao.IsZero() && veiculo.ProximaManutencao.Before(viagem.DataInicio):
+		c.bloquear(RecursoVeiculo, veiculo.ID, ConformidadeManutencaoVencida, veiculo.ProximaManutencao,
+			"manutenção do veículo %s vencida", veiculo.Placa)
+	case veiculo.ProximaManutencaoKm > 0 && veiculo.OdometroAtual >= veiculo.ProximaManutencaoKm:
+		c.bloquear(RecursoVeiculo, veiculo.ID, ConformidadeManutencaoVencida, time.Time{},
+			"veículo %s atingiu a quilometragem de manutenção", veiculo.Placa)
+	case !veiculo.ProximaManutencao.IsZero() && veiculo.ProximaManutencao.Before(limiteAviso):
+		c.avisar(RecursoVeiculo, veiculo.ID, ConformidadeManutencaoProxima, veiculo.ProximaManutencao,
+			"manutenção do veículo %s vence durante ou logo após a viagem", veiculo.Placa)
+	case veiculo.ProximaManutencaoKm > 0 && veiculo.OdometroAtual >= veiculo.ProximaManutencaoKm-MargemAvisoManutencaoKm:
+		c.avisar(RecursoVeiculo, veiculo.ID, ConformidadeManutencaoProxima, time.Time{},
+			"veículo %s próximo da quilometragem de manutenção", veiculo.Placa)
+	}
+
+	for _, m := range motoristas {
+		if m.Status == StatusFolga || m.Status == StatusInativo {
+			c.bloquear(RecursoMotorista, m.ID, ConformidadeMotoristaIndisponivel, time.Time{},
+				"motorista %s está com status %s", m.Nome, m.Status)
+		}
+
+		switch {
+		case m.ValidadeCNH.Before(viagem.DataFim):
+			c.bloquear(RecursoMotorista, m.ID, ConformidadeCNHVencida, m.ValidadeCNH,
+				"CNH do motorista %s vence antes do fim da viagem", m.Nome)
+		case m.ValidadeCNH.Before(limiteAviso):
+			c.avisar(RecursoMotorista, m.ID, ConformidadeCNHAVencer, m.ValidadeCNH,
+				"CNH do motorista %s vence logo após a viagem", m.Nome)
+		}
+	}
+
+	return c
+}
+
+// Bloqueada indica se alguma pendência impede a viagem
+func (c *Conformidade) Bloqueada() bool {
+	return len(c.Bloqueios) > 0
+}
+
+func (c *Conformidade) bloquear(recurso RecursoConformidade, id uuid.UUID, codigo CodigoConformidade,
+	vencimento time.Time, formato string, args ...interface{}) {
+	c.Bloqueios = append(c.Bloqueios, novaPendencia(recurso, id, codigo, vencimento, formato, args...))
+}
+
+func (c *Conformidade) avisar(recurso RecursoConformidade, id uuid.UUID, codigo CodigoConformidade,
+	vencimento time.Time, formato string, args ...interface{}) {
+	c.Avisos = append(c.Avisos, novaPendencia(recurso, id, codigo, vencimento, formato, args...))
+}
+
+func novaPendencia(recurso RecursoConformidade, id uuid.UUID, codigo CodigoConformidade,
+	vencimento time.Time, formato string, args ...interface{}) PendenciaConformidade {
+	p := PendenciaConformidade{
+		Recurso:   recurso,
+		RecursoID: id,
+		Codigo:    codigo,
+		Descricao: fmt.Sprintf(formato, args...),
+	}
+	if !vencimento.IsZero() {
+		p.Vencimento = &vencimento
+	}
+	return p
+}
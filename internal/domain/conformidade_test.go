@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestVerificarConformidade(t *testing.T) {
+	inicio := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	viagem := &Viagem{ID: uuid.New(), DataInicio: inicio, DataFim: inicio.Add(24 * time.Hour)}
+	aviso := AntecedenciaAvisoConformidadePadrao
+	emDia := viagem.DataFim.Add(aviso).AddDate(0, 1, 0)
+	logoApos := viagem.DataFim.AddDate(0, 0, 10)
+
+	veiculo := func(ajuste func(v *Veiculo)) *Veiculo {
+		v := &Veiculo{ID: uuid.New(), Placa: "ABC1C34"}
+		if ajuste != nil {
+			ajuste(v)
+		}
+		return v
+	}
+	// documentos obrigatórios em dia, com a validade de alguns tipos alterada
+	documentos := func(validade map[TipoDocumentoVeiculo]time.Time) []*DocumentoVeiculo {
+		var docs []*DocumentoVeiculo
+		for _, tipo := range DocumentosObrigatorios {
+			validoAte, ok := validade[tipo]
+			if !ok {
+				validoAte = emDia
+			}
+			if validoAte.IsZero() {
+				continue
+			}
+			docs = append(docs, NewDocumentoVeiculo(uuid.Nil, tipo, "123", validoAte.AddDate(-1, 0, 0), validoAte))
+		}
+		return docs
+	}
+	motorista := func(status StatusMotorista, validadeCNH time.Time) *Motorista {
+		return &Motorista{ID: uuid.New(), Nome: "Motorista", Status: status, ValidadeCNH: validadeCNH}
+	}
+	habilitado := motorista(StatusDisponivel, emDia)
+
+	casos := []struct {
+		nome       string
+		veiculo    *Veiculo
+		documentos []*DocumentoVeiculo
+		motoristas []*Motorista
+		bloqueios  []CodigoConformidade
+		avisos     []CodigoConformidade
+	}{
+		{
+			nome:       "tudo em dia",
+			veiculo:    veiculo(nil),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado},
+		},
+		{
+			nome:       "documento ausente",
+			veiculo:    veiculo(nil),
+			documentos: documentos(map[TipoDocumentoVeiculo]time.Time{DocumentoCRLV: {}}),
+			motoristas: []*Motorista{habilitado},
+			bloqueios:  []CodigoConformidade{ConformidadeDocumentoAusente},
+		},
+		{
+			nome:       "documento vence durante a viagem",
+			veiculo:    veiculo(nil),
+			documentos: documentos(map[TipoDocumentoVeiculo]time.Time{DocumentoSeguro: inicio.Add(12 * time.Hour)}),
+			motoristas: []*Motorista{habilitado},
+			bloqueios:  []CodigoConformidade{ConformidadeDocumentoVencido},
+		},
+		{
+			nome:       "documento vence logo após a viagem",
+			veiculo:    veiculo(nil),
+			documentos: documentos(map[TipoDocumentoVeiculo]time.Time{DocumentoANTT: logoApos}),
+			motoristas: []*Motorista{habilitado},
+			avisos:     []CodigoConformidade{ConformidadeDocumentoAVencer},
+		},
+		{
+			nome:       "manutenção vencida por data",
+			veiculo:    veiculo(func(v *Veiculo) { v.ProximaManutencao = inicio.AddDate(0, 0, -1) }),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado},
+			bloqueios:  []CodigoConformidade{ConformidadeManutencaoVencida},
+		},
+		{
+			nome: "manutenção vencida por quilometragem",
+			veiculo: veiculo(func(v *Veiculo) {
+				v.ProximaManutencaoKm = 100000
+				v.OdometroAtual = 100000
+			}),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado},
+			bloqueios:  []CodigoConformidade{ConformidadeManutencaoVencida},
+		},
+		{
+			nome:       "manutenção durante a viagem",
+			veiculo:    veiculo(func(v *Veiculo) { v.ProximaManutencao = inicio.Add(12 * time.Hour) }),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado},
+			avisos:     []CodigoConformidade{ConformidadeManutencaoProxima},
+		},
+		{
+			nome: "próximo da quilometragem de manutenção",
+			veiculo: veiculo(func(v *Veiculo) {
+				v.ProximaManutencaoKm = 100000
+				v.OdometroAtual = 100000 - MargemAvisoManutencaoKm
+			}),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado},
+			avisos:     []CodigoConformidade{ConformidadeManutencaoProxima},
+		},
+		{
+			nome:       "motorista de folga",
+			veiculo:    veiculo(nil),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{habilitado, motorista(StatusFolga, emDia)},
+			bloqueios:  []CodigoConformidade{ConformidadeMotoristaIndisponivel},
+		},
+		{
+			nome:       "CNH vence durante a viagem",
+			veiculo:    veiculo(nil),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{motorista(StatusDisponivel, inicio.Add(12*time.Hour))},
+			bloqueios:  []CodigoConformidade{ConformidadeCNHVencida},
+		},
+		{
+			nome:       "CNH vence logo após a viagem",
+			veiculo:    veiculo(nil),
+			documentos: documentos(nil),
+			motoristas: []*Motorista{motorista(StatusDisponivel, logoApos)},
+			avisos:     []CodigoConformidade{ConformidadeCNHAVencer},
+		},
+		{
+			nome:       "pendências acumuladas",
+			veiculo:    veiculo(func(v *Veiculo) { v.ProximaManutencao = logoApos }),
+			documentos: documentos(map[TipoDocumentoVeiculo]time.Time{DocumentoCRLV: {}, DocumentoSeguro: logoApos}),
+			motoristas: []*Motorista{motorista(StatusFolga, logoApos)},
+			bloqueios:  []CodigoConformidade{ConformidadeDocumentoAusente, ConformidadeMotoristaIndisponivel},
+			avisos: []CodigoConformidade{ConformidadeDocumentoAVencer, ConformidadeManutencaoProxima,
+				ConformidadeCNHAVencer},
+		},
+	}
+
+	codigos := func(pendencias []PendenciaConformidade) []CodigoConformidade {
+		var resultado []CodigoConformidade
+		for _, p := range pendencias {
+			resultado = append(resultado, p.Codigo)
+		}
+		return resultado
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			conformidade := VerificarConformidade(viagem, c.veiculo, c.documentos, c.motoristas, aviso)
+			if bloqueios := codigos(conformidade.Bloqueios); !slices.Equal(bloqueios, c.bloqueios) {
+				t.Errorf("bloqueios = %v, esperado %v", bloqueios, c.bloqueios)
+			}
+			if avisos := codigos(conformidade.Avisos); !slices.Equal(avisos, c.avisos) {
+				t.Errorf("avisos = %v, esperado %v", avisos, c.avisos)
+			}
+			if conformidade.Bloqueada() != (len(c.bloqueios) > 0) {
+				t.Errorf("Bloqueada() = %v com bloqueios %v", conformidade.Bloqueada(), c.bloqueios)
+			}
+		})
+	}
+}
+
+func TestPendenciaConformidadeVencimento(t *testing.T) {
+	inicio := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	viagem := &Viagem{DataInicio: inicio, DataFim: inicio.Add(24 * time.Hour)}
+	validadeCNH := inicio.Add(12 * time.Hour)
+	motorista := &Motorista{ID: uuid.New(), Nome: "Motorista", Status: StatusFolga, ValidadeCNH: validadeCNH}
+
+	conformidade := VerificarConformidade(viagem, &Veiculo{ID: uuid.New()}, nil, []*Motorista{motorista},
+		AntecedenciaAvisoConformidadePadrao)
+
+	for _, p := range conformidade.Bloqueios {
+		switch p.Codigo {
+		case ConformidadeCNHVencida:
+			if p.Vencimento == nil || !p.Vencimento.Equal(validadeCNH) {
+				t.Errorf("CNH vencida com vencimento %v, esperado %v", p.Vencimento, validadeCNH)
+			}
+			if p.Recurso != RecursoMotorista || p.RecursoID != motorista.ID {
+				t.Errorf("CNH vencida atribuída a %s %s", p.Recurso, p.RecursoID)
+			}
+		case ConformidadeDocumentoAusente, ConformidadeMotoristaIndisponivel:
+			if p.Vencimento != nil {
+				t.Errorf("%s com vencimento %v, esperado nenhum", p.Codigo, p.Vencimento)
+			}
+		}
+	}
+}
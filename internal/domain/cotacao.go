@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusCotacao representa os possíveis status de uma cotação
+type StatusCotacao string
+
+const (
+	StatusCotacaoAberta     StatusCotacao = "ABERTA"
+	StatusCotacaoConvertida StatusCotacao = "CONVERTIDA"
+)
+
+// Horário considerado noturno para o adicional (22h às 5h)
+const (
+	inicioHorarioNoturno = 22
+	fimHorarioNoturno    = 5
+)
+
+// Cotacao representa o preço calculado para uma viagem, válido até ValidaAte.
+// Enquanto válida, pode ser convertida em uma viagem pelo valor cotado.
+type Cotacao struct {
+	ID            uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	ClienteID     uuid.UUID   `json:"cliente_id" gorm:"type:uuid;not null;index"`
+	TipoVeiculo   TipoVeiculo `json:"tipo_veiculo" gorm:"type:varchar(20);not null"`
+	TabelaPrecoID *uuid.UUID  `json:"tabela_preco_id,omitempty" gorm:"type:uuid"`
+
+	Origem     string    `json:"origem" gorm:"not null"`
+	Destino    string    `json:"destino" gorm:"not null"`
+	DataInicio time.Time `json:"data_inicio" gorm:"not null"`
+	DataFim    time.Time `json:"data_fim" gorm:"not null"`
+
+	// Parâmetros de cálculo
+	DistanciaKm float64 `json:"distancia_km" gorm:"type:decimal(10,2);not null"`
+	HorasEspera float64 `json:"horas_espera" gorm:"type:decimal(10,2);default:0"`
+	Pedagios    float64 `json:"pedagios" gorm:"type:decimal(10,2);default:0"`
+
+	Itens      []ItemCotacao `json:"itens" gorm:"foreignKey:CotacaoID;constraint:OnDelete:CASCADE"`
+	ValorTotal float64       `json:"valor_total" gorm:"type:decimal(10,2);not null"`
+
+	Status    StatusCotacao `json:"status" gorm:"type:varchar(20);not null;default:'ABERTA'"`
+	ValidaAte time.Time     `json:"valida_ate" gorm:"not null"`
+	ViagemID  *uuid.UUID    `json:"viagem_id,omitempty" gorm:"type:uuid"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// ItemCotacao representa uma linha do detalhamento de uma cotação
+type ItemCotacao struct {
+	ID            uuid.UUID `json:"-" gorm:"type:uuid;primary_key"`
+	CotacaoID     uuid.UUID `json:"-" gorm:"type:uuid;not null;index"`
+	Ordem         int       `json:"-" gorm:"not null"`
+	Descricao     string    `json:"descricao" gorm:"type:varchar(200);not null"`
+	Quantidade    float64   `json:"quantidade" gorm:"type:decimal(10,2);not null"`
+	ValorUnitario float64   `json:"valor_unitario" gorm:"type:decimal(10,2);not null"`
+	Valor         float64   `json:"valor" gorm:"type:decimal(10,2);not null"`
+}
+
+// NewCotacao cria uma nova instância de Cotacao
+func NewCotacao(clienteID uuid.UUID, tipo TipoVeiculo, origem, destino string,
+	dataInicio, dataFim time.Time, distanciaKm, horasEspera, pedagios float64) *Cotacao {
+	return &Cotacao{
+		ID:          uuid.New(),
+		ClienteID:   clienteID,
+		TipoVeiculo: tipo,
+		Origem:      origem,
+		Destino:     destino,
+		DataInicio:  dataInicio,
+		DataFim:     dataFim,
+		DistanciaKm: distanciaKm,
+		HorasEspera: horasEspera,
+		Pedagios:    pedagios,
+		Status:      StatusCotacaoAberta,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validar verifica se a cotação é válida
+func (c *Cotacao) Validar() error {
+	if c.DataInicio.After(c.DataFim) {
+		return ErrDataInicioMaiorQueFim
+	}
+
+	if c.Origem == "" || c.Destino == "" {
+		return ErrOrigemDestinoObrigatorios
+	}
+
+	if c.TipoVeiculo == "" {
+		return ErrTipoVeiculoObrigatorio
+	}
+
+	if c.DistanciaKm <= 0 {
+		return ErrDistanciaInvalida
+	}
+
+	if c.HorasEspera < 0 || c.Pedagios < 0 {
+		return ErrValorCotacaoInvalido
+	}
+
+	return nil
+}
+
+// Calcular monta o detalhamento e o valor total da cotação a partir da tabela
+// de preços e dos feriados do período
+func (c *Cotacao) Calcular(tabela *TabelaPreco, feriados []Feriado) {
+	c.Itens = nil
+	c.TabelaPrecoID = &tabela.ID
+
+	horas := c.DataFim.Sub(c.DataInicio).Hours()
+	c.adicionarItem("Quilometragem", c.DistanciaKm, tabela.ValorKm)
+	c.adicionarItem("Horas de viagem", horas, tabela.ValorHora)
+
+	if c.HorasEspera > 0 {
+		c.adicionarItem("Horas de espera", c.HorasEspera, tabela.ValorHoraEspera)
+	}
+
+	if diarias := c.Diarias(); diarias > 0 {
+		c.adicionarItem("Diárias do motorista", float64(diarias), tabela.ValorDiaria)
+	}
+
+	if noturnas := c.HorasNoturnas(); noturnas > 0 && tabela.PercentualNoturno > 0 {
+		c.adicionarItem(fmt.Sprintf("Adicional noturno (%.0f%%)", tabela.PercentualNoturno),
+			noturnas, tabela.ValorHora*tabela.PercentualNoturno/100)
+	}
+
+	if emFeriado := c.HorasEmFeriados(feriados); emFeriado > 0 && tabela.PercentualFeriado > 0 {
+		c.adicionarItem(fmt.Sprintf("Adicional de feriado (%.0f%%)", tabela.PercentualFeriado),
+			emFeriado, tabela.ValorHora*tabela.PercentualFeriado/100)
+	}
+
+	if c.Pedagios > 0 {
+		c.adicionarItem("Pedágios", 1, c.Pedagios)
+	}
+
+	subtotal := c.somarItens()
+	if subtotal < tabela.ValorMinimo {
+		c.adicionarItem("Complemento para valor mínimo", 1, tabela.ValorMinimo-subtotal)
+	}
+
+	c.ValorTotal = c.somarItens()
+	c.UpdatedAt = time.Now()
+}
+
+// Diarias retorna quantas noites o motorista passa fora, contadas pelas viradas
+// de dia entre o início e o fim da viagem
+func (c *Cotacao) Diarias() int {
+	inicio := inicioDoDia(c.DataInicio)
+	fim := inicioDoDia(c.DataFim.In(c.DataInicio.Location()))
+	return int(math.Round(fim.Sub(inicio).Hours() / 24))
+}
+
+// HorasNoturnas retorna as horas da viagem entre 22h e 5h
+func (c *Cotacao) HorasNoturnas() float64 {
+	total := 0.0
+	for dia := inicioDoDia(c.DataInicio); dia.Before(c.DataFim); dia = dia.AddDate(0, 0, 1) {
+		madrugada := dia.Add(fimHorarioNoturno * time.Hour)
+		noite := dia.Add(inicioHorarioNoturno * time.Hour)
+		total += sobreposicao(c.DataInicio, c.DataFim, dia, madrugada).Hours()
+		total += sobreposicao(c.DataInicio, c.DataFim, noite, dia.AddDate(0, 0, 1)).Hours()
+	}
+	return total
+}
+
+// HorasEmFeriados retorna as horas da viagem que caem em feriados
+func (c *Cotacao) HorasEmFeriados(feriados []Feriado) float64 {
+	total := 0.0
+	for _, f := range feriados {
+		dia := time.Date(f.Data.Year(), f.Data.Month(), f.Data.Day(), 0, 0, 0, 0, c.DataInicio.Location())
+		total += sobreposicao(c.DataInicio, c.DataFim, dia, dia.AddDate(0, 0, 1)).Hours()
+	}
+	return total
+}
+
+// Expirada indica se a cotação passou da data de validade
+func (c *Cotacao) Expirada(agora time.Time) bool {
+	return agora.After(c.ValidaAte)
+}
+
+// Converter marca a cotação como convertida na viagem informada
+func (c *Cotacao) Converter(viagemID uuid.UUID, agora time.Time) error {
+	if c.Status != StatusCotacaoAberta {
+		return ErrCotacaoJaConvertida
+	}
+	if c.Expirada(agora) {
+		return ErrCotacaoExpirada
+	}
+
+	c.Status = StatusCotacaoConvertida
+	c.ViagemID = &viagemID
+	c.UpdatedAt = agora
+	return nil
+}
+
+func (c *Cotacao) adicionarItem(descricao string, quantidade, valorUnitario float64) {
+	c.Itens = append(c.Itens, ItemCotacao{
+		ID:            uuid.New(),
+		CotacaoID:     c.ID,
+		Ordem:         len(c.Itens),
+		Descricao:     descricao,
+		Quantidade:    math.Round(quantidade*100) / 100,
+		ValorUnitario: math.Round(valorUnitario*100) / 100,
+		Valor:         math.Round(quantidade*valorUnitario*100) / 100,
+	})
+}
+
+func (c *Cotacao) somarItens() float64 {
+	total := 0.0
+	for _, item := range c.Itens {
+		total += item.Valor
+	}
+	return math.Round(total*100) / 100
+}
+
+// inicioDoDia retorna a meia-noite do dia da data informada, no mesmo fuso
+func inicioDoDia(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// sobreposicao retorna a duração da interseção entre dois intervalos
+func sobreposicao(inicioA, fimA, inicioB, fimB time.Time) time.Duration {
+	inicio := inicioA
+	if inicioB.After(inicio) {
+		inicio = inicioB
+	}
+	fim := fimA
+	if fimB.Before(fim) {
+		fim = fimB
+	}
+	if !fim.After(inicio) {
+		return 0
+	}
+	return fim.Sub(inicio)
+}
+
+// Erros de domínio
+var (
+	ErrDistanciaInvalida    = NewDomainError("distância deve ser maior que zero")
+	ErrValorCotacaoInvalido = NewDomainError("horas de espera e pedágios não podem ser negativos")
+	ErrCotacaoExpirada      = NewDomainError("cotação expirada")
+	ErrCotacaoJaConvertida  = NewDomainError("cotação já convertida em viagem")
+	ErrCotacaoDivergente    = NewDomainError("viagem não corresponde à cotação")
+)
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func cotacaoTeste(inicio, fim time.Time) *Cotacao {
+	return NewCotacao(uuid.New(), TipoOnibus, "São Paulo", "Campinas", inicio, fim, 100, 0, 0)
+}
+
+func TestCotacaoHorasNoturnas(t *testing.T) {
+	d := func(dia, hora, minuto int) time.Time { return time.Date(2026, 5, dia, hora, minuto, 0, 0, time.UTC) }
+
+	casos := []struct {
+		nome     string
+		inicio   time.Time
+		fim      time.Time
+		noturnas float64
+	}{
+		{"diurna", d(4, 8, 0), d(4, 18, 0), 0},
+		{"atravessa a noite", d(4, 20, 0), d(5, 8, 0), 7},
+		{"começa de madrugada", d(4, 3, 0), d(4, 9, 0), 2},
+		{"termina às 22h30", d(4, 18, 0), d(4, 22, 30), 0.5},
+		{"duas noites", d(4, 12, 0), d(6, 12, 0), 14},
+		{"exatamente às 5h", d(4, 5, 0), d(4, 22, 0), 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if noturnas := cotacaoTeste(c.inicio, c.fim).HorasNoturnas(); noturnas != c.noturnas {
+				t.Errorf("HorasNoturnas() = %v, esperado %v", noturnas, c.noturnas)
+			}
+		})
+	}
+}
+
+func TestCotacaoDiarias(t *testing.T) {
+	d := func(dia, hora int) time.Time { return time.Date(2026, 5, dia, hora, 0, 0, 0, time.UTC) }
+
+	casos := []struct {
+		nome    string
+		inicio  time.Time
+		fim     time.Time
+		diarias int
+	}{
+		{"mesmo dia", d(4, 6), d(4, 23), 0},
+		{"vira a meia-noite", d(4, 20), d(5, 2), 1},
+		{"três dias", d(4, 6), d(6, 20), 2},
+	}
+
+	for _, c := range casos {
+		if diarias := cotacaoTeste(c.inicio, c.fim).Diarias(); diarias != c.diarias {
+			t.Errorf("%s: Diarias() = %d, esperado %d", c.nome, diarias, c.diarias)
+		}
+	}
+}
+
+func TestCotacaoHorasEmFeriados(t *testing.T) {
+	feriados := []Feriado{*NewFeriado(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), "Dia do Trabalho")}
+	d := func(dia, hora int) time.Time { return time.Date(2026, 5, dia, hora, 0, 0, 0, time.UTC) }
+
+	casos := []struct {
+		nome   string
+		inicio time.Time
+		fim    time.Time
+		horas  float64
+	}{
+		{"fora do feriado", d(2, 8), d(2, 18), 0},
+		{"dentro do feriado", d(1, 8), d(1, 18), 10},
+		{"termina no feriado", time.Date(2026, 4, 30, 20, 0, 0, 0, time.UTC), d(1, 6), 6},
+		{"começa no feriado", d(1, 20), d(2, 8), 4},
+	}
+
+	for _, c := range casos {
+		if horas := cotacaoTeste(c.inicio, c.fim).HorasEmFeriados(feriados); horas != c.horas {
+			t.Errorf("%s: HorasEmFeriados() = %v, esperado %v", c.nome, horas, c.horas)
+		}
+	}
+}
+
+func TestCotacaoCalcular(t *testing.T) {
+	tabela := NewTabelaPreco(nil, TipoOnibus, 2, 50, 30, 100)
+	tabela.PercentualNoturno = 20
+	tabela.PercentualFeriado = 50
+	feriados := []Feriado{*NewFeriado(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), "Dia do Trabalho")}
+
+	// Do feriado às 20h até as 8h do dia seguinte: 12 horas, 7 noturnas e 4 no feriado
+	cotacao := NewCotacao(uuid.New(), TipoOnibus, "São Paulo", "Rio de Janeiro",
+		time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC), time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC), 300, 2, 45.5)
+	cotacao.Calcular(tabela, feriados)
+
+	esperados := map[string]float64{
+		"Quilometragem":              600,
+		"Horas de viagem":            600,
+		"Horas de espera":            60,
+		"Diárias do motorista":       100,
+		"Adicional noturno (20%)":    70,
+		"Adicional de feriado (50%)": 100,
+		"Pedágios":                   45.5,
+	}
+	if len(cotacao.Itens) != len(esperados) {
+		t.Fatalf("itens = %+v", cotacao.Itens)
+	}
+	for _, item := range cotacao.Itens {
+		if valor, ok := esperados[item.Descricao]; !ok || item.Valor != valor {
+			t.Errorf("item %q = %.2f, esperado %.2f", item.Descricao, item.Valor, valor)
+		}
+	}
+	if cotacao.ValorTotal != 1575.5 {
+		t.Errorf("ValorTotal = %.2f, esperado 1575.50", cotacao.ValorTotal)
+	}
+	if cotacao.TabelaPrecoID == nil || *cotacao.TabelaPrecoID != tabela.ID {
+		t.Error("cotação deve referenciar a tabela usada")
+	}
+
+	// Recalcular não acumula itens
+	cotacao.Calcular(tabela, feriados)
+	if len(cotacao.Itens) != len(esperados) || cotacao.ValorTotal != 1575.5 {
+		t.Errorf("recálculo mudou a cotação: %d itens, total %.2f", len(cotacao.Itens), cotacao.ValorTotal)
+	}
+}
+
+func TestCotacaoCalcularValorMinimo(t *testing.T) {
+	tabela := NewTabelaPreco(nil, TipoVan, 2, 50, 30, 100)
+	tabela.ValorMinimo = 500
+
+	inicio := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	cotacao := NewCotacao(uuid.New(), TipoVan, "Centro", "Aeroporto", inicio, inicio.Add(time.Hour), 10, 0, 0)
+	cotacao.Calcular(tabela, nil)
+
+	ultimo := cotacao.Itens[len(cotacao.Itens)-1]
+	if ultimo.Descricao != "Complemento para valor mínimo" || ultimo.Valor != 430 {
+		t.Errorf("complemento = %+v, esperado 430", ultimo)
+	}
+	if cotacao.ValorTotal != 500 {
+		t.Errorf("ValorTotal = %.2f, esperado 500", cotacao.ValorTotal)
+	}
+}
+
+func TestCotacaoConverter(t *testing.T) {
+	agora := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	viagemID := uuid.New()
+
+	cotacao := cotacaoTeste(agora.AddDate(0, 0, 10), agora.AddDate(0, 0, 11))
+	cotacao.ValidaAte = agora.Add(time.Hour)
+
+	if err := cotacao.Converter(viagemID, agora.Add(2*time.Hour)); !errors.Is(err, ErrCotacaoExpirada) {
+		t.Errorf("cotação expirada: %v", err)
+	}
+	if err := cotacao.Converter(viagemID, agora); err != nil {
+		t.Fatalf("Converter: %v", err)
+	}
+	if cotacao.Status != StatusCotacaoConvertida || *cotacao.ViagemID != viagemID {
+		t.Errorf("cotação convertida = %s %v", cotacao.Status, cotacao.ViagemID)
+	}
+	if err := cotacao.Converter(uuid.New(), agora); !errors.Is(err, ErrCotacaoJaConvertida) {
+		t.Errorf("segunda conversão: %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TipoCustoFixo representa as categorias de custo fixo de um veículo
+type TipoCustoFixo string
+
+const (
+	CustoSeguro        TipoCustoFixo = "SEGURO"
+	CustoDepreciacao   TipoCustoFixo = "DEPRECIACAO"
+	CustoFinanciamento TipoCustoFixo = "FINANCIAMENTO"
+	CustoOutros        TipoCustoFixo = "OUTROS"
+)
+
+// diasPorMes converte o valor mensal em diário para o rateio do período
+const diasPorMes = 365.0 / 12
+
+// CustoFixoVeiculo é um custo mensal do veículo que independe do uso, como
+// seguro, depreciação e parcela do financiamento. Sem fim de vigência, vale
+// por tempo indeterminado.
+type CustoFixoVeiculo struct {
+	ID          uuid.UUID     `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID   uuid.UUID     `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	Tipo        TipoCustoFixo `json:"tipo" gorm:"type:varchar(20);not null"`
+	Descricao   string        `json:"descricao" gorm:"type:text"`
+	ValorMensal float64       `json:"valor_mensal" gorm:"type:decimal(10,2);not null"`
+
+	VigenteDesde time.Time  `json:"vigente_desde" gorm:"not null;index"`
+	VigenteAte   *time.Time `json:"vigente_ate,omitempty" gorm:"index"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewCustoFixoVeiculo cria uma nova instância de CustoFixoVeiculo
+func NewCustoFixoVeiculo(veiculoID uuid.UUID, tipo TipoCustoFixo, valorMensal float64, vigenteDesde time.Time) *CustoFixoVeiculo {
+	return &CustoFixoVeiculo{
+		ID:           uuid.New(),
+		VeiculoID:    veiculoID,
+		Tipo:         tipo,
+		ValorMensal:  valorMensal,
+		VigenteDesde: vigenteDesde,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Validar verifica se o custo fixo é válido
+func (c *CustoFixoVeiculo) Validar() error {
+	switch c.Tipo {
+	case CustoSeguro, CustoDepreciacao, CustoFinanciamento, CustoOutros:
+	default:
+		return ErrTipoCustoFixoInvalido
+	}
+
+	if c.ValorMensal <= 0 {
+		return ErrValorCustoFixoInvalido
+	}
+
+	if c.VigenteDesde.IsZero() || (c.VigenteAte != nil && !c.VigenteAte.After(c.VigenteDesde)) {
+		return ErrVigenciaCustoFixoInvalida
+	}
+
+	return nil
+}
+
+// ValorNoPeriodo rateia o valor mensal pelos dias de vigência dentro do período
+func (c *CustoFixoVeiculo) ValorNoPeriodo(periodo Periodo) float64 {
+	fimVigencia := periodo.DataFim
+	if c.VigenteAte != nil {
+		fimVigencia = *c.VigenteAte
+	}
+
+	dias := sobreposicao(c.VigenteDesde, fimVigencia, periodo.DataInicio, periodo.DataFim).Hours() / 24
+	return c.ValorMensal / diasPorMes * dias
+}
+
+// MovimentoFrota reúne os lançamentos do período usados no relatório de
+// rentabilidade da frota
+type MovimentoFrota struct {
+	Viagens        []*Viagem
+	Despesas       []*DespesaViagem
+	Abastecimentos []*Abastecimento
+	Manutencoes    []*OrdemManutencao
+	CustosFixos    []*CustoFixoVeiculo
+}
+
+// RentabilidadeVeiculo resume receita, custos e uso de um veículo no período
+type RentabilidadeVeiculo struct {
+	VeiculoID uuid.UUID   `json:"veiculo_id"`
+	Placa     string      `json:"placa"`
+	Modelo    string      `json:"modelo"`
+	Tipo      TipoVeiculo `json:"tipo"`
+	Ano       int         `json:"ano"`
+
+	Viagens   int `json:"viagens"`
+	KmRodados int `json:"km_rodados"`
+
+	Receita     float64 `json:"receita"`
+	Despesas    float64 `json:"despesas"`
+	Combustivel float64 `json:"combustivel"`
+	Manutencao  float64 `json:"manutencao"`
+	CustosFixos float64 `json:"custos_fixos"`
+	CustoTotal  float64 `json:"custo_total"`
+	Resultado   float64 `json:"resultado"`
+	Margem      float64 `json:"margem"` // percentual da receita
+
+	CustoPorKm   float64 `json:"custo_por_km"`
+	ReceitaPorKm float64 `json:"receita_por_km"`
+	Utilizacao   float64 `json:"utilizacao"` // percentual do período em viagem
+}
+
+// RelatorioRentabilidadeFrota traz a rentabilidade de cada veículo, do pior
+// para o melhor resultado, e o total da frota
+type RelatorioRentabilidadeFrota struct {
+	DataInicio time.Time               `json:"data_inicio"`
+	DataFim    time.Time               `json:"data_fim"`
+	Veiculos   []*RentabilidadeVeiculo `json:"veiculos"`
+	Total      *RentabilidadeVeiculo   `json:"total"`
+}
+
+// CalcularRentabilidadeFrota apura, por veículo, a receita das viagens
+// concluídas e as taxas de cancelamento, as despesas de viagem não
+// rejeitadas, o combustível, as manutenções concluídas e os custos fixos
+// rateados. O combustível vem dos abastecimentos; despesas de combustível só
+// entram para viagens sem abastecimento vinculado, para não contar duas vezes.
+func CalcularRentabilidadeFrota(dataInicio, dataFim time.Time, veiculos []*Veiculo, movimento MovimentoFrota) *RelatorioRentabilidadeFrota {
+	periodo := Periodo{DataInicio: dataInicio, DataFim: dataFim}
+	relatorio := &RelatorioRentabilidadeFrota{
+		DataInicio: dataInicio,
+		DataFim:    dataFim,
+		Veiculos:   make([]*RentabilidadeVeiculo, 0, len(veiculos)),
+		Total:      &RentabilidadeVeiculo{},
+	}
+
+	porVeiculo := make(map[uuid.UUID]*RentabilidadeVeiculo, len(veiculos))
+	emViagem := make(map[uuid.UUID]time.Duration, len(veiculos))
+	for _, v := range veiculos {
+		linha := &RentabilidadeVeiculo{VeiculoID: v.ID, Placa: v.Placa, Modelo: v.Modelo, Tipo: v.Tipo, Ano: v.Ano}
+		porVeiculo[v.ID] = linha
+		relatorio.Veiculos = append(relatorio.Veiculos, linha)
+	}
+
+	veiculoDaViagem := make(map[uuid.UUID]uuid.UUID, len(movimento.Viagens))
+	for _, viagem := range movimento.Viagens {
+		linha, ok := porVeiculo[viagem.VeiculoID]
+		if !ok {
+			continue
+		}
+		veiculoDaViagem[viagem.ID] = viagem.VeiculoID
+
+		switch viagem.Status {
+		case StatusConcluida:
+			linha.Viagens++
+			linha.KmRodados += viagem.KmPercorridos
+			linha.Receita += viagem.Valor
+			realizado := periodoRealizado(viagem)
+			emViagem[viagem.VeiculoID] += sobreposicao(realizado.DataInicio, realizado.DataFim, dataInicio, dataFim)
+		case StatusCancelada:
+			linha.Receita += viagem.TaxaCancelamento
+		}
+	}
+
+	abastecidas := make(map[uuid.UUID]bool)
+	for _, a := range movimento.Abastecimentos {
+		if linha, ok := porVeiculo[a.VeiculoID]; ok {
+			linha.Combustivel += a.ValorTotal
+		}
+		if a.ViagemID != nil {
+			abastecidas[*a.ViagemID] = true
+		}
+	}
+
+	for _, d := range movimento.Despesas {
+		veiculoID, ok := veiculoDaViagem[d.ViagemID]
+		if !ok || d.Status == StatusDespesaRejeitada {
+			continue
+		}
+		if d.Categoria == DespesaCombustivel && abastecidas[d.ViagemID] {
+			continue
+		}
+		porVeiculo[veiculoID].Despesas += d.Valor
+	}
+
+	for _, o := range movimento.Manutencoes {
+		if linha, ok := porVeiculo[o.VeiculoID]; ok && o.Status == StatusOrdemConcluida {
+			linha.Manutencao += o.CustoTotal()
+		}
+	}
+
+	for _, c := range movimento.CustosFixos {
+		if linha, ok := porVeiculo[c.VeiculoID]; ok {
+			linha.CustosFixos += c.ValorNoPeriodo(periodo)
+		}
+	}
+
+	duracao := dataFim.Sub(dataInicio)
+	var totalEmViagem time.Duration
+	for _, linha := range relatorio.Veiculos {
+		totalEmViagem += emViagem[linha.VeiculoID]
+		linha.fechar(emViagem[linha.VeiculoID], duracao)
+		relatorio.Total.somar(linha)
+	}
+	relatorio.Total.fechar(totalEmViagem, duracao*time.Duration(len(relatorio.Veiculos)))
+
+	sort.SliceStable(relatorio.Veiculos, func(i, j int) bool {
+		return relatorio.Veiculos[i].Resultado < relatorio.Veiculos[j].Resultado
+	})
+
+	return relatorio
+}
+
+// periodoRealizado usa os horários reais da viagem quando registrados
+func periodoRealizado(viagem *Viagem) Periodo {
+	periodo := Periodo{DataInicio: viagem.DataInicio, DataFim: viagem.DataFim}
+	if viagem.InicioReal != nil {
+		periodo.DataInicio = *viagem.InicioReal
+	}
+	if viagem.FimReal != nil {
+		periodo.DataFim = *viagem.FimReal
+	}
+	return periodo
+}
+
+// somar acumula os valores de um veículo no total da frota
+func (r *RentabilidadeVeiculo) somar(linha *RentabilidadeVeiculo) {
+	r.Viagens += linha.Viagens
+	r.KmRodados += linha.KmRodados
+	r.Receita += linha.Receita
+	r.Despesas += linha.Despesas
+	r.Combustivel += linha.Combustivel
+	r.Manutencao += linha.Manutencao
+	r.CustosFixos += linha.CustosFixos
+}
+
+// fechar calcula os totais, os indicadores por km e a utilização, que é o
+// tempo em viagem sobre o tempo disponível no período
+func (r *RentabilidadeVeiculo) fechar(emViagem, disponivel time.Duration) {
+	r.Receita = arredondar(r.Receita)
+	r.Despesas = arredondar(r.Despesas)
+	r.Combustivel = arredondar(r.Combustivel)
+	r.Manutencao = arredondar(r.Manutencao)
+	r.CustosFixos = arredondar(r.CustosFixos)
+	r.CustoTotal = arredondar(r.Despesas + r.Combustivel + r.Manutencao + r.CustosFixos)
+	r.Resultado = arredondar(r.Receita - r.CustoTotal)
+
+	if r.Receita > 0 {
+		r.Margem = arredondar(r.Resultado / r.Receita * 100)
+	}
+	if r.KmRodados > 0 {
+		r.CustoPorKm = arredondar(r.CustoTotal / float64(r.KmRodados))
+		r.ReceitaPorKm = arredondar(r.Receita / float64(r.KmRodados))
+	}
+	if disponivel > 0 {
+		r.Utilizacao = arredondar(float64(emViagem) / float64(disponivel) * 100)
+	}
+}
+
+// Erros de domínio
+var (
+	ErrTipoCustoFixoInvalido     = NewDomainError("tipo de custo fixo inválido")
+	ErrValorCustoFixoInvalido    = NewDomainError("valor mensal do custo fixo deve ser maior que zero")
+	ErrVigenciaCustoFixoInvalida = NewDomainError("fim da vigência do custo fixo deve ser posterior ao início")
+)
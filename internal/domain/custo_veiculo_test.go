@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCalcularRentabilidadeFrota(t *testing.T) {
+	inicio := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	fim := inicio.AddDate(0, 0, 30)
+	dia := func(d int) time.Time { return inicio.AddDate(0, 0, d-1) }
+
+	van := &Veiculo{ID: uuid.New(), Placa: "ABC1C34", Tipo: TipoVan}
+	onibus := &Veiculo{ID: uuid.New(), Placa: "XYZ9A87", Tipo: TipoOnibus}
+
+	longa := &Viagem{ID: uuid.New(), VeiculoID: van.ID, Status: StatusConcluida, Valor: 3000, KmPercorridos: 1000,
+		DataInicio: dia(10), DataFim: dia(13)}
+	cancelada := &Viagem{ID: uuid.New(), VeiculoID: van.ID, Status: StatusCancelada, Valor: 2000, TaxaCancelamento: 500,
+		DataInicio: dia(20), DataFim: dia(21)}
+	fimReal := dia(5).Add(18 * time.Hour)
+	curta := &Viagem{ID: uuid.New(), VeiculoID: onibus.ID, Status: StatusConcluida, Valor: 1000, KmPercorridos: 500,
+		DataInicio: dia(5), DataFim: dia(5).Add(12 * time.Hour), FimReal: &fimReal}
+	agendada := &Viagem{ID: uuid.New(), VeiculoID: onibus.ID, Status: StatusAgendada, Valor: 5000,
+		DataInicio: dia(25), DataFim: dia(26)}
+	foraDaFrota := &Viagem{ID: uuid.New(), VeiculoID: uuid.New(), Status: StatusConcluida, Valor: 9000, KmPercorridos: 100}
+
+	despesa := func(viagem *Viagem, categoria CategoriaDespesa, valor float64, status StatusDespesa) *DespesaViagem {
+		return &DespesaViagem{ID: uuid.New(), ViagemID: viagem.ID, Categoria: categoria, Valor: valor, Status: status}
+	}
+	abastecimento := func(veiculo *Veiculo, viagem *Viagem, valor float64) *Abastecimento {
+		a := &Abastecimento{ID: uuid.New(), VeiculoID: veiculo.ID, ValorTotal: valor}
+		if viagem != nil {
+			a.ViagemID = &viagem.ID
+		}
+		return a
+	}
+
+	movimento := MovimentoFrota{
+		Viagens: []*Viagem{longa, cancelada, curta, agendada, foraDaFrota},
+		Despesas: []*DespesaViagem{
+			despesa(longa, DespesaPedagio, 200, StatusDespesaAprovada),
+			despesa(longa, DespesaCombustivel, 300, StatusDespesaAprovada), // já no abastecimento
+			despesa(longa, DespesaAlimentacao, 50, StatusDespesaRejeitada),
+			despesa(curta, DespesaCombustivel, 150, StatusDespesaAprovada),
+			despesa(foraDaFrota, DespesaPedagio, 80, StatusDespesaAprovada),
+		},
+		Abastecimentos: []*Abastecimento{
+			abastecimento(van, longa, 400),
+			abastecimento(onibus, nil, 100),
+		},
+		Manutencoes: []*OrdemManutencao{
+			{VeiculoID: van.ID, Status: StatusOrdemConcluida, CustoPecas: 300, CustoMaoDeObra: 200},
+			{VeiculoID: onibus.ID, Status: StatusOrdemAgendada, CustoPecas: 1000},
+		},
+		CustosFixos: []*CustoFixoVeiculo{
+			NewCustoFixoVeiculo(onibus.ID, CustoSeguro, 1000, inicio.AddDate(0, -1, 0)),
+		},
+	}
+
+	relatorio := CalcularRentabilidadeFrota(inicio, fim, []*Veiculo{van, onibus}, movimento)
+
+	esperado := []RentabilidadeVeiculo{
+		{
+			VeiculoID: onibus.ID, Viagens: 1, KmRodados: 500,
+			Receita: 1000, Despesas: 150, Combustivel: 100, CustosFixos: 986.3, CustoTotal: 1236.3,
+			Resultado: -236.3, Margem: -23.63, CustoPorKm: 2.47, ReceitaPorKm: 2, Utilizacao: 2.5,
+		},
+		{
+			VeiculoID: van.ID, Viagens: 1, KmRodados: 1000,
+			Receita: 3500, Despesas: 200, Combustivel: 400, Manutencao: 500, CustoTotal: 1100,
+			Resultado: 2400, Margem: 68.57, CustoPorKm: 1.1, ReceitaPorKm: 3.5, Utilizacao: 10,
+		},
+	}
+	total := RentabilidadeVeiculo{
+		Viagens: 2, KmRodados: 1500,
+		Receita: 4500, Despesas: 350, Combustivel: 500, Manutencao: 500, CustosFixos: 986.3, CustoTotal: 2336.3,
+		Resultado: 2163.7, Margem: 48.08, CustoPorKm: 1.56, ReceitaPorKm: 3, Utilizacao: 6.25,
+	}
+
+	if len(relatorio.Veiculos) != len(esperado) {
+		t.Fatalf("%d veículos no relatório, esperado %d", len(relatorio.Veiculos), len(esperado))
+	}
+	for i, e := range esperado {
+		linha := relatorio.Veiculos[i]
+		if linha.VeiculoID != e.VeiculoID {
+			t.Errorf("veículo %d = %s, esperado %s (ordem do pior para o melhor resultado)", i, linha.Placa, e.VeiculoID)
+			continue
+		}
+		compararRentabilidade(t, linha.Placa, linha, &e)
+	}
+	compararRentabilidade(t, "total", relatorio.Total, &total)
+}
+
+func TestCalcularRentabilidadeFrotaSemVeiculos(t *testing.T) {
+	inicio := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	relatorio := CalcularRentabilidadeFrota(inicio, inicio.AddDate(0, 1, 0), nil, MovimentoFrota{})
+	if len(relatorio.Veiculos) != 0 || *relatorio.Total != (RentabilidadeVeiculo{}) {
+		t.Errorf("relatório sem veículos = %+v, total %+v", relatorio.Veiculos, relatorio.Total)
+	}
+}
+
+func compararRentabilidade(t *testing.T, nome string, obtido, esperado *RentabilidadeVeiculo) {
+	t.Helper()
+	if obtido.Viagens != esperado.Viagens || obtido.KmRodados != esperado.KmRodados {
+		t.Errorf("%s: %d viagens e %d km, esperado %d e %d", nome,
+			obtido.Viagens, obtido.KmRodados, esperado.Viagens, esperado.KmRodados)
+	}
+
+	valores := []struct {
+		campo            string
+		obtido, esperado float64
+	}{
+		{"Receita", obtido.Receita, esperado.Receita},
+		{"Despesas", obtido.Despesas, esperado.Despesas},
+		{"Combustivel", obtido.Combustivel, esperado.Combustivel},
+		{"Manutencao", obtido.Manutencao, esperado.Manutencao},
+		{"CustosFixos", obtido.CustosFixos, esperado.CustosFixos},
+		{"CustoTotal", obtido.CustoTotal, esperado.CustoTotal},
+		{"Resultado", obtido.Resultado, esperado.Resultado},
+		{"Margem", obtido.Margem, esperado.Margem},
+		{"CustoPorKm", obtido.CustoPorKm, esperado.CustoPorKm},
+		{"ReceitaPorKm", obtido.ReceitaPorKm, esperado.ReceitaPorKm},
+		{"Utilizacao", obtido.Utilizacao, esperado.Utilizacao},
+	}
+	for _, v := range valores {
+		if math.Abs(v.obtido-v.esperado) > 0.001 {
+			t.Errorf("%s: %s = %.2f, esperado %.2f", nome, v.campo, v.obtido, v.esperado)
+		}
+	}
+}
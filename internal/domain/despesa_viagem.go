@@ -0,0 +1,290 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CategoriaDespesa representa as categorias de despesa de uma viagem
+type CategoriaDespesa string
+
+const (
+	DespesaPedagio        CategoriaDespesa = "PEDAGIO"
+	DespesaCombustivel    CategoriaDespesa = "COMBUSTIVEL"
+	DespesaAlimentacao    CategoriaDespesa = "ALIMENTACAO"
+	DespesaEstacionamento CategoriaDespesa = "ESTACIONAMENTO"
+	DespesaHospedagem     CategoriaDespesa = "HOSPEDAGEM"
+	DespesaOutros         CategoriaDespesa = "OUTROS"
+)
+
+// PagamentoDespesa indica quem pagou a despesa
+type PagamentoDespesa string
+
+const (
+	// PagamentoMotorista é pago pelo motorista e reembolsado pela empresa
+	PagamentoMotorista     PagamentoDespesa = "MOTORISTA"
+	PagamentoCartaoEmpresa PagamentoDespesa = "CARTAO_EMPRESA"
+)
+
+// StatusDespesa representa as etapas da aprovação de uma despesa
+type StatusDespesa string
+
+const (
+	StatusDespesaPendente    StatusDespesa = "PENDENTE"
+	StatusDespesaAprovada    StatusDespesa = "APROVADA"
+	StatusDespesaRejeitada   StatusDespesa = "REJEITADA"
+	StatusDespesaReembolsada StatusDespesa = "REEMBOLSADA"
+)
+
+// DespesaViagem é um gasto feito durante uma viagem. Despesas pagas pelo
+// motorista são reembolsadas depois de aprovadas.
+type DespesaViagem struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	ViagemID    uuid.UUID `json:"viagem_id" gorm:"type:uuid;not null;index"`
+	MotoristaID uuid.UUID `json:"motorista_id" gorm:"type:uuid;not null;index"`
+
+	Categoria   CategoriaDespesa `json:"categoria" gorm:"type:varchar(20);not null"`
+	Valor       float64          `json:"valor" gorm:"type:decimal(10,2);not null"`
+	PagoPor     PagamentoDespesa `json:"pago_por" gorm:"type:varchar(20);not null"`
+	RealizadaEm time.Time        `json:"realizada_em" gorm:"not null;index"`
+	Descricao   string           `json:"descricao" gorm:"type:text"`
+
+	// ComprovanteID é o anexo do tipo COMPROVANTE enviado para a viagem
+	ComprovanteID *uuid.UUID `json:"comprovante_id,omitempty" gorm:"type:uuid"`
+
+	// Aprovação
+	Status         StatusDespesa `json:"status" gorm:"type:varchar(20);not null;default:'PENDENTE';index"`
+	AvaliadorID    string        `json:"avaliador_id,omitempty" gorm:"type:varchar(100)"`
+	AvaliadorNome  string        `json:"avaliador_nome,omitempty" gorm:"type:varchar(100)"`
+	AvaliadaEm     *time.Time    `json:"avaliada_em,omitempty"`
+	MotivoRejeicao string        `json:"motivo_rejeicao,omitempty" gorm:"type:text"`
+	ReembolsadaEm  *time.Time    `json:"reembolsada_em,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewDespesaViagem cria uma nova instância de DespesaViagem
+func NewDespesaViagem(viagemID, motoristaID uuid.UUID, categoria CategoriaDespesa, valor float64,
+	pagoPor PagamentoDespesa, realizadaEm time.Time) *DespesaViagem {
+	return &DespesaViagem{
+		ID:          uuid.New(),
+		ViagemID:    viagemID,
+		MotoristaID: motoristaID,
+		Categoria:   categoria,
+		Valor:       valor,
+		PagoPor:     pagoPor,
+		RealizadaEm: realizadaEm,
+		Status:      StatusDespesaPendente,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validar verifica se a despesa é válida
+func (d *DespesaViagem) Validar() error {
+	switch d.Categoria {
+	case DespesaPedagio, DespesaCombustivel, DespesaAlimentacao, DespesaEstacionamento, DespesaHospedagem, DespesaOutros:
+	default:
+		return ErrCategoriaDespesaInvalida
+	}
+
+	if d.Valor <= 0 {
+		return ErrValorInvalido
+	}
+
+	switch d.PagoPor {
+	case PagamentoMotorista, PagamentoCartaoEmpresa:
+	default:
+		return ErrPagamentoDespesaInvalido
+	}
+
+	if d.RealizadaEm.IsZero() || d.RealizadaEm.After(time.Now()) {
+		return ErrDataDespesaInvalida
+	}
+
+	// O reembolso ao motorista depende do comprovante
+	if d.PagoPor == PagamentoMotorista && d.ComprovanteID == nil {
+		return ErrComprovanteObrigatorio
+	}
+
+	return nil
+}
+
+// Reembolsavel indica se a despesa deve ser devolvida ao motorista
+func (d *DespesaViagem) Reembolsavel() bool {
+	return d.PagoPor == PagamentoMotorista
+}
+
+// Aprovar aceita a despesa pendente
+func (d *DespesaViagem) Aprovar(avaliador Ator, agora time.Time) error {
+	if d.Status != StatusDespesaPendente {
+		return ErrDespesaJaAvaliada
+	}
+
+	d.Status = StatusDespesaAprovada
+	d.AvaliadorID = avaliador.ID
+	d.AvaliadorNome = avaliador.Nome
+	d.AvaliadaEm = &agora
+	d.UpdatedAt = agora
+	return nil
+}
+
+// Rejeitar recusa a despesa pendente registrando o motivo
+func (d *DespesaViagem) Rejeitar(avaliador Ator, motivo string, agora time.Time) error {
+	if motivo == "" {
+		return ErrMotivoRejeicaoObrigatorio
+	}
+
+	if d.Status != StatusDespesaPendente {
+		return ErrDespesaJaAvaliada
+	}
+
+	d.Status = StatusDespesaRejeitada
+	d.AvaliadorID = avaliador.ID
+	d.AvaliadorNome = avaliador.Nome
+	d.AvaliadaEm = &agora
+	d.MotivoRejeicao = motivo
+	d.UpdatedAt = agora
+	return nil
+}
+
+// Reembolsar registra a devolução ao motorista de uma despesa aprovada
+func (d *DespesaViagem) Reembolsar(agora time.Time) error {
+	if !d.Reembolsavel() {
+		return ErrDespesaNaoReembolsavel
+	}
+
+	if d.Status != StatusDespesaAprovada {
+		return ErrDespesaNaoAprovada
+	}
+
+	d.Status = StatusDespesaReembolsada
+	d.ReembolsadaEm = &agora
+	d.UpdatedAt = agora
+	return nil
+}
+
+// ResumoReembolso totaliza as despesas pagas por um motorista no período,
+// separadas pela etapa da aprovação
+type ResumoReembolso struct {
+	MotoristaID uuid.UUID `json:"motorista_id"`
+	DataInicio  time.Time `json:"data_inicio"`
+	DataFim     time.Time `json:"data_fim"`
+
+	TotalPendente    float64 `json:"total_pendente"`
+	TotalAprovado    float64 `json:"total_aprovado"` // aprovado e ainda não reembolsado
+	TotalReembolsado float64 `json:"total_reembolsado"`
+	TotalRejeitado   float64 `json:"total_rejeitado"`
+
+	Despesas []*DespesaViagem `json:"despesas"`
+}
+
+// ResumirReembolsos monta o resumo de reembolso do motorista com as despesas
+// pagas por ele. Despesas pagas com o cartão da empresa são ignoradas.
+func ResumirReembolsos(motoristaID uuid.UUID, dataInicio, dataFim time.Time, despesas []*DespesaViagem) *ResumoReembolso {
+	resumo := &ResumoReembolso{
+		MotoristaID: motoristaID,
+		DataInicio:  dataInicio,
+		DataFim:     dataFim,
+		Despesas:    []*DespesaViagem{},
+	}
+
+	for _, d := range despesas {
+		if d.MotoristaID != motoristaID || !d.Reembolsavel() {
+			continue
+		}
+
+		switch d.Status {
+		case StatusDespesaPendente:
+			resumo.TotalPendente += d.Valor
+		case StatusDespesaAprovada:
+			resumo.TotalAprovado += d.Valor
+		case StatusDespesaReembolsada:
+			resumo.TotalReembolsado += d.Valor
+		case StatusDespesaRejeitada:
+			resumo.TotalRejeitado += d.Valor
+		}
+		resumo.Despesas = append(resumo.Despesas, d)
+	}
+
+	resumo.TotalPendente = arredondar(resumo.TotalPendente)
+	resumo.TotalAprovado = arredondar(resumo.TotalAprovado)
+	resumo.TotalReembolsado = arredondar(resumo.TotalReembolsado)
+	resumo.TotalRejeitado = arredondar(resumo.TotalRejeitado)
+
+	return resumo
+}
+
+// DespesaPorCategoria é o total gasto em uma categoria
+type DespesaPorCategoria struct {
+	Categoria CategoriaDespesa `json:"categoria"`
+	Total     float64          `json:"total"`
+}
+
+// Rentabilidade compara o valor cobrado pela viagem com as despesas dela
+type Rentabilidade struct {
+	ViagemID      uuid.UUID             `json:"viagem_id"`
+	Valor         float64               `json:"valor"`
+	TotalDespesas float64               `json:"total_despesas"`
+	Resultado     float64               `json:"resultado"`
+	Margem        float64               `json:"margem"` // percentual do valor
+	PorCategoria  []DespesaPorCategoria `json:"por_categoria"`
+}
+
+// CalcularRentabilidade subtrai do valor da viagem as despesas não rejeitadas,
+// incluindo as pendentes de aprovação
+func CalcularRentabilidade(viagem *Viagem, despesas []*DespesaViagem) *Rentabilidade {
+	totais := make(map[CategoriaDespesa]float64)
+	var total float64
+	for _, d := range despesas {
+		if d.ViagemID != viagem.ID || d.Status == StatusDespesaRejeitada {
+			continue
+		}
+		totais[d.Categoria] += d.Valor
+		total += d.Valor
+	}
+
+	rentabilidade := &Rentabilidade{
+		ViagemID:      viagem.ID,
+		Valor:         viagem.Valor,
+		TotalDespesas: arredondar(total),
+		Resultado:     arredondar(viagem.Valor - total),
+		PorCategoria:  make([]DespesaPorCategoria, 0, len(totais)),
+	}
+	if viagem.Valor > 0 {
+		rentabilidade.Margem = arredondar(rentabilidade.Resultado / viagem.Valor * 100)
+	}
+
+	for categoria, valor := range totais {
+		rentabilidade.PorCategoria = append(rentabilidade.PorCategoria,
+			DespesaPorCategoria{Categoria: categoria, Total: arredondar(valor)})
+	}
+	sort.Slice(rentabilidade.PorCategoria, func(i, j int) bool {
+		return rentabilidade.PorCategoria[i].Total > rentabilidade.PorCategoria[j].Total
+	})
+
+	return rentabilidade
+}
+
+// arredondar arredonda o valor monetário para centavos
+func arredondar(valor float64) float64 {
+	return math.Round(valor*100) / 100
+}
+
+// Erros de domínio
+var (
+	ErrCategoriaDespesaInvalida  = NewDomainError("categoria de despesa inválida")
+	ErrPagamentoDespesaInvalido  = NewDomainError("forma de pagamento da despesa inválida")
+	ErrDataDespesaInvalida       = NewDomainError("data da despesa inválida")
+	ErrComprovanteObrigatorio    = NewDomainError("comprovante é obrigatório para despesas pagas pelo motorista")
+	ErrComprovanteInvalido       = NewDomainError("comprovante deve ser um anexo do tipo COMPROVANTE enviado para a viagem")
+	ErrMotivoRejeicaoObrigatorio = NewDomainError("motivo da rejeição é obrigatório")
+	ErrDespesaJaAvaliada         = NewDomainError("despesa já aprovada ou rejeitada")
+	ErrDespesaNaoAprovada        = NewDomainError("somente despesas aprovadas podem ser reembolsadas")
+	ErrDespesaNaoReembolsavel    = NewDomainError("despesa paga com cartão da empresa não é reembolsável")
+	ErrMotoristaDespesaInvalido  = NewDomainError("motorista da despesa não está escalado na viagem")
+)
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TipoDocumentoVeiculo representa os documentos de porte obrigatório ou
+// eventual de um veículo
+type TipoDocumentoVeiculo string
+
+const (
+	DocumentoCRLV                TipoDocumentoVeiculo = "CRLV"
+	DocumentoSeguro              TipoDocumentoVeiculo = "SEGURO"
+	DocumentoANTT                TipoDocumentoVeiculo = "ANTT"
+	DocumentoCronotacografo      TipoDocumentoVeiculo = "CRONOTACOGRAFO"
+	DocumentoAutorizacaoEstadual TipoDocumentoVeiculo = "AUTORIZACAO_ESTADUAL"
+)
+
+// DocumentosObrigatorios são os documentos sem os quais o veículo não pode
+// circular. Autorizações estaduais só são exigidas nas viagens que as pedem.
+var DocumentosObrigatorios = []TipoDocumentoVeiculo{
+	DocumentoCRLV,
+	DocumentoSeguro,
+	DocumentoANTT,
+	DocumentoCronotacografo,
+}
+
+// DocumentoVeiculo é um documento do veículo com seu período de validade
+type DocumentoVeiculo struct {
+	ID        uuid.UUID            `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID uuid.UUID            `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	Tipo      TipoDocumentoVeiculo `json:"tipo" gorm:"type:varchar(30);not null;index"`
+	Numero    string               `json:"numero" gorm:"type:varchar(50);not null"`
+	UF        string               `json:"uf" gorm:"type:char(2)"` // autorizações estaduais
+	EmitidoEm time.Time            `json:"emitido_em" gorm:"not null"`
+	ValidoAte time.Time            `json:"valido_ate" gorm:"not null;index"`
+
+	ArquivoURL  string `json:"arquivo_url" gorm:"type:varchar(500)"`
+	Observacoes string `json:"observacoes" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewDocumentoVeiculo cria uma nova instância de DocumentoVeiculo
+func NewDocumentoVeiculo(veiculoID uuid.UUID, tipo TipoDocumentoVeiculo, numero string,
+	emitidoEm, validoAte time.Time) *DocumentoVeiculo {
+	return &DocumentoVeiculo{
+		ID:        uuid.New(),
+		VeiculoID: veiculoID,
+		Tipo:      tipo,
+		Numero:    numero,
+		EmitidoEm: emitidoEm,
+		ValidoAte: validoAte,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Validar verifica se o documento é válido
+func (d *DocumentoVeiculo) Validar() error {
+	switch d.Tipo {
+	case DocumentoCRLV, DocumentoSeguro, DocumentoANTT, DocumentoCronotacografo:
+	case DocumentoAutorizacaoEstadual:
+		if len(d.UF) != 2 {
+			return ErrUFAutorizacaoObrigatoria
+		}
+	default:
+		return ErrTipoDocumentoInvalido
+	}
+
+	if d.Numero == "" {
+		return ErrNumeroDocumentoObrigatorio
+	}
+
+	if d.EmitidoEm.IsZero() || d.ValidoAte.IsZero() || !d.ValidoAte.After(d.EmitidoEm) {
+		return ErrValidadeDocumentoInvalida
+	}
+
+	return nil
+}
+
+// Vencido indica se o documento já perdeu a validade
+func (d *DocumentoVeiculo) Vencido(agora time.Time) bool {
+	return agora.After(d.ValidoAte)
+}
+
+// SituacaoDocumentacao deriva a situação geral da documentação do veículo a
+// partir dos documentos obrigatórios, valendo em cada tipo o de maior
+// validade. A documentação é válida se nenhum deles falta ou está vencido; o
+// vencimento é o do primeiro a vencer.
+func SituacaoDocumentacao(documentos []*DocumentoVeiculo, agora time.Time) (valida bool, vencimento time.Time) {
+	vigentes := DocumentosVigentes(documentos)
+
+	valida = true
+	for _, tipo := range DocumentosObrigatorios {
+		d, ok := vigentes[tipo]
+		if !ok {
+			valida = false
+			continue
+		}
+		if d.Vencido(agora) {
+			valida = false
+		}
+		if vencimento.IsZero() || d.ValidoAte.Before(vencimento) {
+			vencimento = d.ValidoAte
+		}
+	}
+
+	return valida, vencimento
+}
+
+// DocumentosVigentes retorna, para cada tipo, o documento de maior validade
+func DocumentosVigentes(documentos []*DocumentoVeiculo) map[TipoDocumentoVeiculo]*DocumentoVeiculo {
+	vigentes := make(map[TipoDocumentoVeiculo]*DocumentoVeiculo)
+	for _, d := range documentos {
+		if atual, ok := vigentes[d.Tipo]; !ok || d.ValidoAte.After(atual.ValidoAte) {
+			vigentes[d.Tipo] = d
+		}
+	}
+	return vigentes
+}
+
+// Erros de domínio
+var (
+	ErrTipoDocumentoInvalido      = NewDomainError("tipo de documento inválido")
+	ErrNumeroDocumentoObrigatorio = NewDomainError("número do documento é obrigatório")
+	ErrValidadeDocumentoInvalida  = NewDomainError("emissão e validade são obrigatórias e a validade deve ser posterior à emissão")
+	ErrUFAutorizacaoObrigatoria   = NewDomainError("UF é obrigatória para autorizações estaduais")
+)
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSituacaoDocumentacao(t *testing.T) {
+	agora := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	veiculoID := uuid.New()
+	documento := func(tipo TipoDocumentoVeiculo, validoAte time.Time) *DocumentoVeiculo {
+		return NewDocumentoVeiculo(veiculoID, tipo, "123", validoAte.AddDate(-1, 0, 0), validoAte)
+	}
+	mes := func(meses int) time.Time { return agora.AddDate(0, meses, 0) }
+	obrigatorios := func(validoAte ...time.Time) []*DocumentoVeiculo {
+		var documentos []*DocumentoVeiculo
+		for i, tipo := range DocumentosObrigatorios {
+			documentos = append(documentos, documento(tipo, validoAte[i]))
+		}
+		return documentos
+	}
+
+	casos := []struct {
+		nome       string
+		documentos []*DocumentoVeiculo
+		valida     bool
+		vencimento time.Time
+	}{
+		{"sem documentos", nil, false, time.Time{}},
+		{"todos em dia", obrigatorios(mes(6), mes(3), mes(12), mes(9)), true, mes(3)},
+		{"um vencido", obrigatorios(mes(6), mes(-1), mes(12), mes(9)), false, mes(-1)},
+		{"falta um obrigatório", obrigatorios(mes(6), mes(3), mes(12), mes(9))[1:], false, mes(3)},
+		{"vale o de maior validade do tipo", append(obrigatorios(mes(6), mes(-1), mes(12), mes(9)),
+			documento(DocumentoSeguro, mes(4))), true, mes(4)},
+		{"autorização estadual não conta", append(obrigatorios(mes(6), mes(3), mes(12), mes(9)),
+			documento(DocumentoAutorizacaoEstadual, mes(1))), true, mes(3)},
+		{"vence exatamente agora", obrigatorios(agora, mes(3), mes(12), mes(9)), true, agora},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			valida, vencimento := SituacaoDocumentacao(c.documentos, agora)
+			if valida != c.valida || !vencimento.Equal(c.vencimento) {
+				t.Errorf("SituacaoDocumentacao = (%v, %v), esperado (%v, %v)", valida, vencimento, c.valida, c.vencimento)
+			}
+		})
+	}
+}
+
+func TestVeiculoDocumentacaoEmDia(t *testing.T) {
+	agora := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	veiculo := &Veiculo{}
+
+	if veiculo.DocumentacaoEmDia(agora) {
+		t.Error("veículo sem documentação não está em dia")
+	}
+
+	veiculo.AtualizarDocumentacao(true, agora.AddDate(0, 1, 0))
+	if !veiculo.DocumentacaoEmDia(agora) {
+		t.Error("documentação válida até o mês seguinte deveria estar em dia")
+	}
+	if veiculo.DocumentacaoEmDia(agora.AddDate(0, 2, 0)) {
+		t.Error("documentação passada do vencimento não está em dia")
+	}
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TipoEventoViagem representa os tipos de evento do histórico de uma viagem
+type TipoEventoViagem string
+
+const (
+	EventoViagemCriada            TipoEventoViagem = "CRIADA"
+	EventoViagemReagendada        TipoEventoViagem = "REAGENDADA"
+	EventoViagemMotoristaAlterado TipoEventoViagem = "MOTORISTA_ALTERADO"
+	EventoViagemVeiculoAlterado   TipoEventoViagem = "VEICULO_ALTERADO"
+	EventoViagemAtualizada        TipoEventoViagem = "ATUALIZADA"
+	EventoViagemIniciada          TipoEventoViagem = "INICIADA"
+	EventoViagemConcluida         TipoEventoViagem = "CONCLUIDA"
+	EventoViagemCancelada         TipoEventoViagem = "CANCELADA"
+	EventoViagemJornadaLiberada   TipoEventoViagem = "JORNADA_LIBERADA"
+)
+
+// Ator identifica quem realizou uma alteração. Fica vazio quando a requisição
+// não é autenticada.
+type Ator struct {
+	ID     string `json:"id,omitempty" gorm:"column:ator_id;type:varchar(100)"`
+	Nome   string `json:"nome,omitempty" gorm:"column:ator_nome;type:varchar(100)"`
+	Perfil string `json:"perfil,omitempty" gorm:"column:ator_perfil;type:varchar(20)"`
+}
+
+// AlteracaoEvento registra o valor de um campo antes e depois do evento
+type AlteracaoEvento struct {
+	Campo  string `json:"campo"`
+	Antes  string `json:"antes,omitempty"`
+	Depois string `json:"depois,omitempty"`
+}
+
+// EventoViagem é uma entrada do histórico de uma viagem
+type EventoViagem struct {
+	ID         uuid.UUID         `json:"id" gorm:"type:uuid;primary_key"`
+	ViagemID   uuid.UUID         `json:"viagem_id" gorm:"type:uuid;not null;index"`
+	Tipo       TipoEventoViagem  `json:"tipo" gorm:"type:varchar(30);not null"`
+	Ator       Ator              `json:"ator" gorm:"embedded"`
+	Descricao  string            `json:"descricao,omitempty" gorm:"type:text"`
+	Alteracoes []AlteracaoEvento `json:"alteracoes,omitempty" gorm:"type:jsonb;serializer:json"`
+	OcorridoEm time.Time         `json:"ocorrido_em" gorm:"not null;index"`
+}
+
+// NewEventoViagem cria uma nova instância de EventoViagem
+func NewEventoViagem(viagemID uuid.UUID, tipo TipoEventoViagem, ator Ator, alteracoes ...AlteracaoEvento) *EventoViagem {
+	return &EventoViagem{
+		ID:         uuid.New(),
+		ViagemID:   viagemID,
+		Tipo:       tipo,
+		Ator:       ator,
+		Alteracoes: alteracoes,
+		OcorridoEm: time.Now(),
+	}
+}
+
+// NewEventoLiberacaoJornada registra a liberação da viagem apesar das
+// violações das regras de jornada, com a justificativa informada
+func NewEventoLiberacaoJornada(viagemID uuid.UUID, ator Ator, justificativa string, violacoes []ViolacaoJornada) *EventoViagem {
+	alteracoes := make([]AlteracaoEvento, len(violacoes))
+	for i, v := range violacoes {
+		alteracoes[i] = AlteracaoEvento{Campo: string(v.Regra), Depois: v.Descricao}
+	}
+	evento := NewEventoViagem(viagemID, EventoViagemJornadaLiberada, ator, alteracoes...)
+	evento.Descricao = justificativa
+	return evento
+}
+
+// EventosAlteracaoViagem compara a viagem antes e depois de uma alteração e
+// retorna os eventos correspondentes. Mudanças de período, motorista, veículo
+// e status geram eventos próprios; as demais são agrupadas em ATUALIZADA.
+func EventosAlteracaoViagem(antes, depois *Viagem, ator Ator) []*EventoViagem {
+	var eventos []*EventoViagem
+
+	if !antes.DataInicio.Equal(depois.DataInicio) || !antes.DataFim.Equal(depois.DataFim) {
+		eventos = append(eventos, NewEventoViagem(depois.ID, EventoViagemReagendada, ator,
+			alteracaoData("data_inicio", antes.DataInicio, depois.DataInicio),
+			alteracaoData("data_fim", antes.DataFim, depois.DataFim)))
+	}
+
+	if antes.MotoristaID != depois.MotoristaID {
+		eventos = append(eventos, NewEventoViagem(depois.ID, EventoViagemMotoristaAlterado, ator,
+			AlteracaoEvento{Campo: "motorista_id", Antes: antes.MotoristaID.String(), Depois: depois.MotoristaID.String()}))
+	}
+
+	secundarioAntes, secundarioDepois := uuidOpcional(antes.MotoristaSecundarioID), uuidOpcional(depois.MotoristaSecundarioID)
+	if secundarioAntes != secundarioDepois {
+		eventos = append(eventos, NewEventoViagem(depois.ID, EventoViagemMotoristaAlterado, ator,
+			AlteracaoEvento{Campo: "motorista_secundario_id", Antes: secundarioAntes, Depois: secundarioDepois}))
+	}
+
+	if antes.VeiculoID != depois.VeiculoID {
+		eventos = append(eventos, NewEventoViagem(depois.ID, EventoViagemVeiculoAlterado, ator,
+			AlteracaoEvento{Campo: "veiculo_id", Antes: antes.VeiculoID.String(), Depois: depois.VeiculoID.String()}))
+	}
+
+	var outras []AlteracaoEvento
+	outras = appendSeDiferente(outras, "origem", antes.Origem, depois.Origem)
+	outras = appendSeDiferente(outras, "destino", antes.Destino, depois.Destino)
+	outras = appendSeDiferente(outras, "valor", fmt.Sprintf("%.2f", antes.Valor), fmt.Sprintf("%.2f", depois.Valor))
+	outras = appendSeDiferente(outras, "observacoes", antes.Observacoes, depois.Observacoes)
+	outras = appendSeDiferente(outras, "direcao_prevista_minutos",
+		fmt.Sprint(antes.DirecaoPrevistaMinutos), fmt.Sprint(depois.DirecaoPrevistaMinutos))
+	if len(outras) > 0 {
+		eventos = append(eventos, NewEventoViagem(depois.ID, EventoViagemAtualizada, ator, outras...))
+	}
+
+	if antes.Status != depois.Status {
+		if tipo, ok := eventoPorStatus[depois.Status]; ok {
+			eventos = append(eventos, NewEventoViagem(depois.ID, tipo, ator,
+				AlteracaoEvento{Campo: "status", Antes: string(antes.Status), Depois: string(depois.Status)}))
+		}
+	}
+
+	return eventos
+}
+
+// eventoPorStatus associa o status de destino ao evento registrado
+var eventoPorStatus = map[StatusViagem]TipoEventoViagem{
+	StatusEmAndamento: EventoViagemIniciada,
+	StatusConcluida:   EventoViagemConcluida,
+	StatusCancelada:   EventoViagemCancelada,
+	StatusAgendada:    EventoViagemAtualizada,
+}
+
+func alteracaoData(campo string, antes, depois time.Time) AlteracaoEvento {
+	return AlteracaoEvento{Campo: campo, Antes: antes.Format(time.RFC3339), Depois: depois.Format(time.RFC3339)}
+}
+
+func appendSeDiferente(alteracoes []AlteracaoEvento, campo, antes, depois string) []AlteracaoEvento {
+	if antes == depois {
+		return alteracoes
+	}
+	return append(alteracoes, AlteracaoEvento{Campo: campo, Antes: antes, Depois: depois})
+}
+
+func uuidOpcional(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
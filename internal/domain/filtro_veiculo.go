@@ -0,0 +1,12 @@
+package domain
+
+// FiltroVeiculo reúne os critérios da listagem de veículos. Campos vazios não
+// filtram. O veículo precisa oferecer todas as comodidades informadas.
+type FiltroVeiculo struct {
+	Status      StatusVeiculo
+	Tipo        TipoVeiculo
+	Comodidades []string
+
+	Offset int
+	Limit  int
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CamposOrdenacaoViagem são os campos aceitos para ordenar a busca de viagens
+var CamposOrdenacaoViagem = []string{"data_inicio", "data_fim", "valor", "status", "created_at"}
+
+// FiltroViagem reúne os critérios da busca paginada de viagens. Campos vazios
+// não filtram. O período seleciona as viagens que ocupam qualquer parte dele.
+type FiltroViagem struct {
+	Status      StatusViagem
+	DataInicio  time.Time
+	DataFim     time.Time
+	VeiculoID   *uuid.UUID
+	MotoristaID *uuid.UUID
+	ClienteID   *uuid.UUID
+	Origem      string
+	Destino     string
+
+	OrdenarPor  string
+	Decrescente bool
+	Offset      int
+	Limit       int
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GrupoViagem representa uma reserva de grupo distribuída em várias viagens,
+// uma para cada par veículo/motorista necessário para acomodar os passageiros
+type GrupoViagem struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	ClienteID uuid.UUID `json:"cliente_id" gorm:"type:uuid;not null"`
+
+	QuantidadePassageiros int       `json:"quantidade_passageiros" gorm:"not null"`
+	Origem                string    `json:"origem" gorm:"not null"`
+	Destino               string    `json:"destino" gorm:"not null"`
+	DataInicio            time.Time `json:"data_inicio" gorm:"not null"`
+	DataFim               time.Time `json:"data_fim" gorm:"not null"`
+
+	Status      StatusViagem `json:"status" gorm:"type:varchar(20);not null;default:'AGENDADA'"`
+	Valor       float64      `json:"valor" gorm:"type:decimal(10,2);not null"`
+	Observacoes string       `json:"observacoes" gorm:"type:text"`
+
+	// Códigos das comodidades que todos os veículos do grupo devem oferecer
+	ComodidadesExigidas []string `json:"comodidades_exigidas,omitempty" gorm:"type:jsonb;serializer:json"`
+
+	// Relacionamentos
+	Viagens []*Viagem `json:"viagens,omitempty" gorm:"foreignKey:GrupoID"`
+	Cliente *Cliente  `json:"cliente,omitempty" gorm:"foreignKey:ClienteID"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewGrupoViagem cria uma nova instância de GrupoViagem
+func NewGrupoViagem(clienteID uuid.UUID, quantidadePassageiros int, origem, destino string,
+	dataInicio, dataFim time.Time, valor float64) *GrupoViagem {
+	return &GrupoViagem{
+		ID:                    uuid.New(),
+		ClienteID:             clienteID,
+		QuantidadePassageiros: quantidadePassageiros,
+		Origem:                origem,
+		Destino:               destino,
+		DataInicio:            dataInicio,
+		DataFim:               dataFim,
+		Status:                StatusAgendada,
+		Valor:                 valor,
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
+	}
+}
+
+// Validar verifica se o grupo é válido
+func (g *GrupoViagem) Validar() error {
+	if g.QuantidadePassageiros <= 0 {
+		return ErrQuantidadePassageirosInvalida
+	}
+
+	if g.DataInicio.After(g.DataFim) {
+		return ErrDataInicioMaiorQueFim
+	}
+
+	if g.DataInicio.Before(time.Now()) {
+		return ErrDataInicioPassada
+	}
+
+	if g.Valor <= 0 {
+		return ErrValorInvalido
+	}
+
+	if g.Origem == "" || g.Destino == "" {
+		return ErrOrigemDestinoObrigatorios
+	}
+
+	return nil
+}
+
+// Reagendar altera o período do grupo e de todas as suas viagens ativas.
+// Falha sem alterar nada se alguma delas já tiver começado ou terminado.
+func (g *GrupoViagem) Reagendar(dataInicio, dataFim time.Time) error {
+	for _, v := range g.Viagens {
+		if v.Status != StatusCancelada && v.Status != StatusAgendada {
+			return ErrViagemNaoReagendavel
+		}
+	}
+
+	g.DataInicio = dataInicio
+	g.DataFim = dataFim
+	g.UpdatedAt = time.Now()
+
+	for _, v := range g.Viagens {
+		if v.Status == StatusCancelada {
+			continue
+		}
+		v.DataInicio = dataInicio
+		v.DataFim = dataFim
+		v.UpdatedAt = time.Now()
+	}
+	return nil
+}
+
+// SelecionarVeiculosGrupo escolhe, entre os veículos informados, o menor
+// conjunto capaz de transportar a quantidade de passageiros. A cada passo usa o
+// menor veículo que comporta todos os passageiros restantes ou, se nenhum
+// comportar, o de maior capacidade.
+func SelecionarVeiculosGrupo(veiculos []*Veiculo, passageiros int) ([]*Veiculo, error) {
+	candidatos := make([]*Veiculo, 0, len(veiculos))
+	for _, v := range veiculos {
+		if v.Capacidade > 0 {
+			candidatos = append(candidatos, v)
+		}
+	}
+	sort.Slice(candidatos, func(i, j int) bool {
+		return candidatos[i].Capacidade < candidatos[j].Capacidade
+	})
+
+	var selecionados []*Veiculo
+	restantes := passageiros
+	for restantes > 0 {
+		if len(candidatos) == 0 {
+			return nil, ErrCapacidadeGrupoInsuficiente
+		}
+
+		idx := len(candidatos) - 1
+		for i, v := range candidatos {
+			if v.Capacidade >= restantes {
+				idx = i
+				break
+			}
+		}
+
+		selecionados = append(selecionados, candidatos[idx])
+		restantes -= candidatos[idx].Capacidade
+		candidatos = append(candidatos[:idx], candidatos[idx+1:]...)
+	}
+
+	return selecionados, nil
+}
+
+// DistribuirPassageiros divide os passageiros entre os veículos selecionados,
+// preenchendo cada veículo até sua capacidade na ordem informada
+func DistribuirPassageiros(veiculos []*Veiculo, passageiros int) []int {
+	distribuicao := make([]int, len(veiculos))
+	restantes := passageiros
+	for i, v := range veiculos {
+		n := v.Capacidade
+		if n > restantes {
+			n = restantes
+		}
+		distribuicao[i] = n
+		restantes -= n
+	}
+	return distribuicao
+}
+
+// RatearValor divide o valor total proporcionalmente à quantidade de
+// passageiros de cada parte, ajustando o arredondamento na última parte
+func RatearValor(valor float64, distribuicao []int) []float64 {
+	total := 0
+	for _, n := range distribuicao {
+		total += n
+	}
+
+	valores := make([]float64, len(distribuicao))
+	if total == 0 {
+		return valores
+	}
+
+	acumulado := 0.0
+	for i, n := range distribuicao {
+		if i == len(distribuicao)-1 {
+			valores[i] = math.Round((valor-acumulado)*100) / 100
+			break
+		}
+		valores[i] = math.Round(valor*float64(n)/float64(total)*100) / 100
+		acumulado += valores[i]
+	}
+	return valores
+}
+
+// Erros de domínio
+var (
+	ErrQuantidadePassageirosInvalida = NewDomainError("quantidade de passageiros deve ser maior que zero")
+	ErrCapacidadeGrupoInsuficiente   = NewDomainError("não há veículos disponíveis suficientes para acomodar o grupo")
+)
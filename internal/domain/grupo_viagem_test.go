@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSelecionarVeiculosGrupo(t *testing.T) {
+	var veiculos []*Veiculo
+	for _, capacidade := range []int{20, 4, 0, 45, 15} {
+		veiculos = append(veiculos, &Veiculo{Capacidade: capacidade})
+	}
+
+	casos := []struct {
+		nome        string
+		passageiros int
+		capacidades []int
+		erro        error
+	}{
+		{"cabe no menor veículo suficiente", 10, []int{15}, nil},
+		{"exatamente a capacidade", 4, []int{4}, nil},
+		{"maior veículo e depois o menor suficiente", 50, []int{45, 15}, nil},
+		{"todos os veículos", 84, []int{45, 20, 15, 4}, nil},
+		{"capacidade insuficiente", 85, nil, ErrCapacidadeGrupoInsuficiente},
+		{"sem passageiros", 0, nil, nil},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			selecionados, err := SelecionarVeiculosGrupo(veiculos, c.passageiros)
+			if !errors.Is(err, c.erro) {
+				t.Fatalf("erro = %v, esperado %v", err, c.erro)
+			}
+			var capacidades []int
+			for _, v := range selecionados {
+				capacidades = append(capacidades, v.Capacidade)
+			}
+			if !slices.Equal(capacidades, c.capacidades) {
+				t.Errorf("capacidades = %v, esperado %v", capacidades, c.capacidades)
+			}
+		})
+	}
+}
+
+func TestDistribuirPassageiros(t *testing.T) {
+	casos := []struct {
+		nome         string
+		capacidades  []int
+		passageiros  int
+		distribuicao []int
+	}{
+		{"último veículo parcial", []int{45, 15}, 50, []int{45, 5}},
+		{"todos cheios", []int{4, 15}, 19, []int{4, 15}},
+		{"veículo sobrando", []int{15, 4}, 10, []int{10, 0}},
+		{"sem passageiros", []int{15}, 0, []int{0}},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			var veiculos []*Veiculo
+			for _, capacidade := range c.capacidades {
+				veiculos = append(veiculos, &Veiculo{Capacidade: capacidade})
+			}
+			if distribuicao := DistribuirPassageiros(veiculos, c.passageiros); !slices.Equal(distribuicao, c.distribuicao) {
+				t.Errorf("DistribuirPassageiros = %v, esperado %v", distribuicao, c.distribuicao)
+			}
+		})
+	}
+}
+
+func TestRatearValor(t *testing.T) {
+	casos := []struct {
+		nome         string
+		valor        float64
+		distribuicao []int
+		valores      []float64
+	}{
+		{"proporcional", 1000, []int{45, 5}, []float64{900, 100}},
+		{"arredondamento na última parte", 100, []int{1, 1, 1}, []float64{33.33, 33.33, 33.34}},
+		{"parte única", 250.5, []int{10}, []float64{250.5}},
+		{"sem passageiros", 100, []int{0, 0}, []float64{0, 0}},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if valores := RatearValor(c.valor, c.distribuicao); !slices.Equal(valores, c.valores) {
+				t.Errorf("RatearValor = %v, esperado %v", valores, c.valores)
+			}
+		})
+	}
+}
+
+func TestGrupoViagemReagendar(t *testing.T) {
+	inicio := time.Date(2026, 7, 1, 8, 0, 0, 0, time.UTC)
+	novoInicio := inicio.AddDate(0, 0, 2)
+	novoFim := novoInicio.Add(6 * time.Hour)
+
+	casos := []struct {
+		nome   string
+		status []StatusViagem
+		erro   error
+	}{
+		{"viagens agendadas", []StatusViagem{StatusAgendada, StatusAgendada}, nil},
+		{"viagem cancelada é ignorada", []StatusViagem{StatusAgendada, StatusCancelada}, nil},
+		{"viagem em andamento", []StatusViagem{StatusAgendada, StatusEmAndamento}, ErrViagemNaoReagendavel},
+		{"viagem concluída", []StatusViagem{StatusConcluida}, ErrViagemNaoReagendavel},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			grupo := &GrupoViagem{DataInicio: inicio, DataFim: inicio.Add(6 * time.Hour)}
+			for _, status := range c.status {
+				grupo.Viagens = append(grupo.Viagens, &Viagem{DataInicio: grupo.DataInicio, DataFim: grupo.DataFim, Status: status})
+			}
+
+			err := grupo.Reagendar(novoInicio, novoFim)
+			if !errors.Is(err, c.erro) {
+				t.Fatalf("Reagendar() = %v, esperado %v", err, c.erro)
+			}
+
+			reagendado := err == nil
+			if grupo.DataInicio.Equal(novoInicio) != reagendado {
+				t.Errorf("grupo com início %v após Reagendar() = %v", grupo.DataInicio, err)
+			}
+			for _, v := range grupo.Viagens {
+				movida := v.DataInicio.Equal(novoInicio) && v.DataFim.Equal(novoFim)
+				if esperado := reagendado && v.Status != StatusCancelada; movida != esperado {
+					t.Errorf("viagem %s movida = %v, esperado %v", v.Status, movida, esperado)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErroCampo indica o campo do cadastro que não passou na validação
+type ErroCampo struct {
+	Campo    string `json:"campo"`
+	Mensagem string `json:"mensagem"`
+}
+
+func (e *ErroCampo) Error() string {
+	return e.Campo + ": " + e.Mensagem
+}
+
+// NormalizarRenavam remove pontuação e completa com zeros à esquerda os
+// RENAVAM antigos de 9 dígitos
+func NormalizarRenavam(renavam string) string {
+	var digitos strings.Builder
+	for _, r := range renavam {
+		if r >= '0' && r <= '9' {
+			digitos.WriteRune(r)
+		}
+	}
+
+	normalizado := digitos.String()
+	if len(normalizado) == 9 {
+		normalizado = "00" + normalizado
+	}
+	return normalizado
+}
+
+// pesosRenavam são os pesos aplicados aos 10 primeiros dígitos do RENAVAM
+var pesosRenavam = [10]int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// ValidarRenavam verifica o formato e o dígito verificador (módulo 11) do RENAVAM
+func ValidarRenavam(renavam string) error {
+	renavam = NormalizarRenavam(renavam)
+	if len(renavam) != 11 {
+		return ErrRenavamInvalido
+	}
+
+	soma := 0
+	for i, peso := range pesosRenavam {
+		soma += int(renavam[i]-'0') * peso
+	}
+
+	digito := soma * 10 % 11
+	if digito == 10 {
+		digito = 0
+	}
+
+	if int(renavam[10]-'0') != digito {
+		return ErrRenavamDigitoVerificador
+	}
+	return nil
+}
+
+// NormalizarChassi coloca o chassi em maiúsculas e remove espaços
+func NormalizarChassi(chassi string) string {
+	return strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(chassi)), " ", "")
+}
+
+// valoresChassi é a transliteração das letras do chassi usada no dígito
+// verificador. I, O e Q não são permitidas.
+var valoresChassi = map[rune]int{
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// pesosChassi são os pesos de cada posição no dígito verificador; a 9ª
+// posição é o próprio dígito
+var pesosChassi = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// codigosAnoModelo são os caracteres da 10ª posição do chassi, que se repetem
+// a cada 30 anos a partir de 1980
+const codigosAnoModelo = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// fabricantesChassi identifica o fabricante pelo WMI (três primeiros caracteres
+// do chassi), com os nomes pelos quais a marca costuma ser cadastrada
+var fabricantesChassi = map[string][]string{
+	"9BM": {"Mercedes-Benz", "Mercedes", "MB"},
+	"8AC": {"Mercedes-Benz", "Mercedes", "MB"},
+	"WDB": {"Mercedes-Benz", "Mercedes", "MB"},
+	"WDF": {"Mercedes-Benz", "Mercedes", "MB"},
+	"W1V": {"Mercedes-Benz", "Mercedes", "MB"},
+	"9BW": {"Volkswagen", "VW"},
+	"953": {"Volkswagen", "VW"},
+	"WV1": {"Volkswagen", "VW"},
+	"WV2": {"Volkswagen", "VW"},
+	"9BS": {"Scania"},
+	"YS4": {"Scania"},
+	"9BV": {"Volvo"},
+	"YV3": {"Volvo"},
+	"93Z": {"Iveco"},
+	"ZCF": {"Iveco"},
+	"93Y": {"Renault"},
+	"VF1": {"Renault"},
+	"9BD": {"Fiat"},
+	"9BF": {"Ford"},
+	"9BG": {"Chevrolet", "GM"},
+	"936": {"Peugeot"},
+	"VF3": {"Peugeot"},
+	"935": {"Citroën", "Citroen"},
+	"VF7": {"Citroën", "Citroen"},
+	"9BH": {"Hyundai"},
+	"9BR": {"Toyota"},
+}
+
+// InfoChassi traz o que é possível decodificar do chassi
+type InfoChassi struct {
+	WMI        string `json:"wmi"`
+	Fabricante string `json:"fabricante,omitempty"` // vazio quando o WMI não é conhecido
+	AnoModelo  int    `json:"ano_modelo"`           // o mais recente do ciclo de 30 anos até o próximo ano
+}
+
+// ValidarChassi verifica o formato do chassi (VIN, ISO 3779) e o caractere do
+// ano-modelo. O dígito verificador da 9ª posição só é obrigatório nos chassis
+// norte-americanos; os fabricantes brasileiros e europeus usam a posição
+// livremente.
+func ValidarChassi(chassi string) error {
+	chassi = NormalizarChassi(chassi)
+	if len(chassi) != 17 {
+		return ErrChassiInvalido
+	}
+
+	soma := 0
+	for i, r := range chassi {
+		valor, ok := valorChassi(r)
+		if !ok {
+			return ErrChassiCaractereInvalido
+		}
+		soma += valor * pesosChassi[i]
+	}
+
+	if !strings.ContainsRune(codigosAnoModelo, rune(chassi[9])) {
+		return ErrChassiAnoModeloInvalido
+	}
+
+	if !chassiNorteAmericano(chassi) {
+		return nil
+	}
+
+	digito := byte('0' + soma%11)
+	if soma%11 == 10 {
+		digito = 'X'
+	}
+	if chassi[8] != digito {
+		return ErrChassiDigitoVerificador
+	}
+
+	return nil
+}
+
+// chassiNorteAmericano indica se o chassi foi emitido na América do Norte
+// (WMI iniciado por 1 a 5), onde o dígito verificador é obrigatório
+func chassiNorteAmericano(chassi string) bool {
+	return chassi[0] >= '1' && chassi[0] <= '5'
+}
+
+func valorChassi(r rune) (int, bool) {
+	if r >= '0' && r <= '9' {
+		return int(r - '0'), true
+	}
+	valor, ok := valoresChassi[r]
+	return valor, ok
+}
+
+// DecodificarChassi extrai o fabricante e o ano-modelo de um chassi válido
+func DecodificarChassi(chassi string, agora time.Time) (*InfoChassi, error) {
+	if err := ValidarChassi(chassi); err != nil {
+		return nil, err
+	}
+	chassi = NormalizarChassi(chassi)
+
+	info := &InfoChassi{WMI: chassi[:3]}
+	if nomes, ok := fabricantesChassi[info.WMI]; ok {
+		info.Fabricante = nomes[0]
+	}
+
+	for _, ano := range anosModeloChassi(chassi[9]) {
+		if ano <= agora.Year()+1 {
+			info.AnoModelo = ano
+		}
+	}
+
+	return info, nil
+}
+
+// anosModeloChassi retorna, em ordem crescente, os anos que o caractere da
+// 10ª posição pode representar
+func anosModeloChassi(codigo byte) []int {
+	i := strings.IndexByte(codigosAnoModelo, codigo)
+	if i < 0 {
+		return nil
+	}
+	return []int{1980 + i, 2010 + i, 2040 + i}
+}
+
+// ConferirChassi compara o fabricante e o ano-modelo decodificados do chassi
+// com a marca e o ano cadastrados. O ano cadastrado pode ser o ano-modelo ou o
+// de fabricação, um ano antes. Fabricantes desconhecidos não são conferidos.
+func ConferirChassi(chassi, marca string, ano int) error {
+	if err := ValidarChassi(chassi); err != nil {
+		return err
+	}
+	chassi = NormalizarChassi(chassi)
+
+	if nomes, ok := fabricantesChassi[chassi[:3]]; ok && !marcaCorresponde(marca, nomes) {
+		return &ErroCampo{Campo: "marca", Mensagem: fmt.Sprintf("marca não corresponde ao fabricante do chassi (%s)", nomes[0])}
+	}
+
+	for _, anoModelo := range anosModeloChassi(chassi[9]) {
+		if ano == anoModelo || ano == anoModelo-1 {
+			return nil
+		}
+	}
+	return &ErroCampo{Campo: "ano", Mensagem: "ano não corresponde ao ano-modelo indicado no chassi"}
+}
+
+func marcaCorresponde(marca string, nomes []string) bool {
+	marca = normalizarMarca(marca)
+	if marca == "" {
+		return false
+	}
+	for _, nome := range nomes {
+		nome = normalizarMarca(nome)
+		if strings.Contains(marca, nome) || strings.Contains(nome, marca) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizarMarca mantém apenas letras e números, sem acentos, para comparar
+// grafias como "Mercedes Benz" e "MERCEDES-BENZ"
+func normalizarMarca(marca string) string {
+	marca = strings.NewReplacer("ë", "e", "é", "e", "ê", "e", "ã", "a", "á", "a", "ô", "o", "ó", "o").
+		Replace(strings.ToLower(marca))
+	var b strings.Builder
+	for _, r := range marca {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Erros de domínio
+var (
+	ErrRenavamInvalido          = &ErroCampo{Campo: "renavam", Mensagem: "RENAVAM deve ter 11 dígitos"}
+	ErrRenavamDigitoVerificador = &ErroCampo{Campo: "renavam", Mensagem: "dígito verificador do RENAVAM inválido"}
+	ErrChassiInvalido           = &ErroCampo{Campo: "chassi", Mensagem: "chassi deve ter 17 caracteres"}
+	ErrChassiCaractereInvalido  = &ErroCampo{Campo: "chassi", Mensagem: "chassi aceita apenas letras e números, exceto I, O e Q"}
+	ErrChassiAnoModeloInvalido  = &ErroCampo{Campo: "chassi", Mensagem: "caractere do ano-modelo (10ª posição) do chassi inválido"}
+	ErrChassiDigitoVerificador  = &ErroCampo{Campo: "chassi", Mensagem: "dígito verificador (9ª posição) do chassi norte-americano inválido"}
+)
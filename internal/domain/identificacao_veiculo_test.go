@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidarRenavam(t *testing.T) {
+	casos := []struct {
+		nome    string
+		renavam string
+		erro    error
+	}{
+		{"válido", "00639177450", nil},
+		{"válido com pontuação", "0063917745-0", nil},
+		{"antigo de 9 dígitos", "639177450", nil},
+		{"dígito 10 vira 0", "12345678900", nil},
+		{"dígito errado", "00639177451", ErrRenavamDigitoVerificador},
+		{"curto", "1234567", ErrRenavamInvalido},
+		{"vazio", "", ErrRenavamInvalido},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if err := ValidarRenavam(c.renavam); !errors.Is(err, c.erro) {
+				t.Errorf("ValidarRenavam(%q) = %v, esperado %v", c.renavam, err, c.erro)
+			}
+		})
+	}
+}
+
+func TestValidarChassi(t *testing.T) {
+	casos := []struct {
+		nome   string
+		chassi string
+		erro   error
+	}{
+		{"norte-americano válido", "1M8GDM9AXKP042788", nil},
+		{"norte-americano em minúsculas", "1m8gdm9axkp042788", nil},
+		{"norte-americano com dígito errado", "1M8GDM9A1KP042788", ErrChassiDigitoVerificador},
+		{"brasileiro sem dígito verificador", "9BWZZZ377VT004251", nil},
+		{"europeu sem dígito verificador", "WDB9634031L123456", nil},
+		{"curto", "9BWZZZ377VT00425", ErrChassiInvalido},
+		{"com letra O", "9BWZZZ377VTO04251", ErrChassiCaractereInvalido},
+		{"ano-modelo U", "9BWZZZ377UT004251", ErrChassiAnoModeloInvalido},
+		{"ano-modelo zero", "9BWZZZ3770T004251", ErrChassiAnoModeloInvalido},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if err := ValidarChassi(c.chassi); !errors.Is(err, c.erro) {
+				t.Errorf("ValidarChassi(%q) = %v, esperado %v", c.chassi, err, c.erro)
+			}
+		})
+	}
+}
+
+func TestDecodificarChassi(t *testing.T) {
+	agora := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	casos := []struct {
+		chassi     string
+		fabricante string
+		anoModelo  int
+	}{
+		{"9BWZZZ377VT004251", "Volkswagen", 2027},
+		{"9BWZZZ377WT004251", "Volkswagen", 1998},
+		{"WDB9634031L123456", "Mercedes-Benz", 2001},
+		{"1M8GDM9AXKP042788", "", 2019},
+	}
+
+	for _, c := range casos {
+		info, err := DecodificarChassi(c.chassi, agora)
+		if err != nil {
+			t.Fatalf("DecodificarChassi(%q): %v", c.chassi, err)
+		}
+		if info.Fabricante != c.fabricante || info.AnoModelo != c.anoModelo {
+			t.Errorf("DecodificarChassi(%q) = %+v, esperado %s %d", c.chassi, info, c.fabricante, c.anoModelo)
+		}
+	}
+}
+
+func TestConferirChassi(t *testing.T) {
+	casos := []struct {
+		nome  string
+		marca string
+		ano   int
+		campo string
+	}{
+		{"ano-modelo", "Volkswagen", 1997, ""},
+		{"ano de fabricação", "VW", 1996, ""},
+		{"marca com outra grafia", "VOLKSWAGEN CAMINHÕES", 1997, ""},
+		{"marca de outro fabricante", "Fiat", 1997, "marca"},
+		{"ano fora do ciclo", "Volkswagen", 2000, "ano"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			err := ConferirChassi("9BWZZZ377VT004251", c.marca, c.ano)
+			var erroCampo *ErroCampo
+			switch {
+			case c.campo == "" && err != nil:
+				t.Errorf("erro inesperado: %v", err)
+			case c.campo != "" && (!errors.As(err, &erroCampo) || erroCampo.Campo != c.campo):
+				t.Errorf("esperado erro no campo %s, obtido %v", c.campo, err)
+			}
+		})
+	}
+}
+
+func TestVeiculoValidarIdentificacao(t *testing.T) {
+	// Veículo cadastrado antes da validação, com RENAVAM e chassi inválidos
+	legado := NewVeiculo("ABC1234", "O500", "Mercedes-Benz", 2015, TipoOnibus, 44, "1M8GDM9A1KP042788", "00639177451")
+
+	editado := *legado
+	editado.Observacoes = "revisado"
+	if err := editado.ValidarIdentificacao(legado); err != nil {
+		t.Errorf("edição sem mudar a identificação deveria passar: %v", err)
+	}
+
+	editado.Renavam = "00639177452"
+	if err := editado.ValidarIdentificacao(legado); !errors.Is(err, ErrRenavamDigitoVerificador) {
+		t.Errorf("RENAVAM alterado deveria ser conferido, obtido %v", err)
+	}
+
+	editado.Renavam = legado.Renavam
+	editado.Chassi = "1M8GDM9A2KP042788"
+	if err := editado.ValidarIdentificacao(legado); !errors.Is(err, ErrChassiDigitoVerificador) {
+		t.Errorf("chassi alterado deveria ser conferido, obtido %v", err)
+	}
+
+	if err := legado.ValidarIdentificacao(nil); err == nil {
+		t.Error("no cadastro, a identificação inválida deveria ser recusada")
+	}
+}
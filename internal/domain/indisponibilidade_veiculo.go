@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MotivoIndisponibilidade representa os motivos de indisponibilidade programada de um veículo
+type MotivoIndisponibilidade string
+
+const (
+	IndisponibilidadeManutencao MotivoIndisponibilidade = "MANUTENCAO_PLANEJADA"
+	IndisponibilidadeVistoria   MotivoIndisponibilidade = "VISTORIA"
+	IndisponibilidadeUsoInterno MotivoIndisponibilidade = "USO_INTERNO"
+)
+
+// IndisponibilidadeVeiculo é um período programado em que o veículo não pode
+// ser reservado para viagens
+type IndisponibilidadeVeiculo struct {
+	ID         uuid.UUID               `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID  uuid.UUID               `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	Motivo     MotivoIndisponibilidade `json:"motivo" gorm:"type:varchar(30);not null"`
+	Descricao  string                  `json:"descricao" gorm:"type:text"`
+	DataInicio time.Time               `json:"data_inicio" gorm:"not null;index"`
+	DataFim    time.Time               `json:"data_fim" gorm:"not null;index"`
+
+	// Usuário que programou a indisponibilidade
+	ResponsavelID   string `json:"responsavel_id,omitempty" gorm:"type:varchar(100)"`
+	ResponsavelNome string `json:"responsavel_nome,omitempty" gorm:"type:varchar(100)"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewIndisponibilidadeVeiculo cria uma nova instância de IndisponibilidadeVeiculo
+func NewIndisponibilidadeVeiculo(veiculoID uuid.UUID, motivo MotivoIndisponibilidade, descricao string,
+	dataInicio, dataFim time.Time) *IndisponibilidadeVeiculo {
+	return &IndisponibilidadeVeiculo{
+		ID:         uuid.New(),
+		VeiculoID:  veiculoID,
+		Motivo:     motivo,
+		Descricao:  descricao,
+		DataInicio: dataInicio,
+		DataFim:    dataFim,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// Validar verifica se a indisponibilidade é válida
+func (i *IndisponibilidadeVeiculo) Validar() error {
+	switch i.Motivo {
+	case IndisponibilidadeManutencao, IndisponibilidadeVistoria, IndisponibilidadeUsoInterno:
+	default:
+		return ErrMotivoIndisponibilidadeInvalido
+	}
+
+	if !i.DataFim.After(i.DataInicio) {
+		return ErrPeriodoIndisponibilidadeInvalido
+	}
+
+	return nil
+}
+
+// Periodo retorna o intervalo em que o veículo fica indisponível
+func (i *IndisponibilidadeVeiculo) Periodo() Periodo {
+	return Periodo{DataInicio: i.DataInicio, DataFim: i.DataFim}
+}
+
+// Erros de domínio
+var (
+	ErrMotivoIndisponibilidadeInvalido  = NewDomainError("motivo de indisponibilidade inválido")
+	ErrPeriodoIndisponibilidadeInvalido = NewDomainError("fim da indisponibilidade deve ser posterior ao início")
+)
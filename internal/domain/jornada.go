@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limites de tempo de direção e descanso do motorista profissional (Lei 13.103/2015)
+const (
+	// DirecaoContinuaMaxima é o tempo máximo de direção sem pausa
+	DirecaoContinuaMaxima = 5*time.Hour + 30*time.Minute
+	// PausaMinimaDirecao é a pausa que interrompe a direção contínua
+	PausaMinimaDirecao = 30 * time.Minute
+	// JornadaMaximaDiaria é o maior período de trabalho que ainda permite o
+	// descanso mínimo entre jornadas dentro de 24 horas. A lei limita a jornada
+	// a 8 horas mais até 4 extras, mas não conta nelas o tempo de espera, as
+	// pausas nem o intervalo de refeição; como o sistema conhece só o período
+	// planejado, que inclui tudo isso, o limite verificável é o de 24h menos o
+	// descanso de 11h. As horas extras efetivas são apuradas no banco de horas.
+	JornadaMaximaDiaria = 24*time.Hour - DescansoMinimoEntreJornadas
+	// DescansoSemanalMinimo é o descanso contínuo exigido a cada 7 dias
+	DescansoSemanalMinimo = 35 * time.Hour
+	// PeriodoDescansoSemanal é o período em que o descanso semanal é exigido
+	PeriodoDescansoSemanal = 7 * 24 * time.Hour
+)
+
+// RegraJornada identifica a regra de jornada violada
+type RegraJornada string
+
+const (
+	RegraDirecaoContinua       RegraJornada = "DIRECAO_CONTINUA"
+	RegraDescansoEntreJornadas RegraJornada = "DESCANSO_ENTRE_JORNADAS"
+	RegraDescansoSemanal       RegraJornada = "DESCANSO_SEMANAL"
+)
+
+// ViolacaoJornada descreve uma regra de jornada desrespeitada pelo motorista e
+// as viagens envolvidas
+type ViolacaoJornada struct {
+	MotoristaID uuid.UUID    `json:"motorista_id"`
+	Regra       RegraJornada `json:"regra"`
+	Descricao   string       `json:"descricao"`
+	Viagens     []uuid.UUID  `json:"viagens,omitempty"`
+}
+
+// ErroJornada reúne as regras de jornada violadas por uma viagem
+type ErroJornada struct {
+	Violacoes []ViolacaoJornada
+}
+
+func (e *ErroJornada) Error() string {
+	descricoes := make([]string, len(e.Violacoes))
+	for i, v := range e.Violacoes {
+		descricoes[i] = v.Descricao
+	}
+	return "jornada do motorista excede os limites legais: " + strings.Join(descricoes, "; ")
+}
+
+// VerificarJornada aplica as regras de jornada do motorista à viagem
+// considerando as demais viagens dele. O sistema conhece apenas o período
+// planejado de cada viagem e, quando informada, a direção prevista: a
+// diferença entre os dois é o tempo disponível para pausas e pernoites. Uma
+// viagem sozinha só viola a direção contínua ou o descanso entre jornadas se
+// não houver revezamento nem tempo para as pausas e descansos exigidos; o
+// encadeamento de viagens é avaliado pelo período total.
+func VerificarJornada(motoristaID uuid.UUID, viagem *Viagem, agenda []*Viagem) []ViolacaoJornada {
+	viagens := []*Viagem{viagem}
+	for _, v := range agenda {
+		if v.ID != viagem.ID && v.Status != StatusCancelada {
+			viagens = append(viagens, v)
+		}
+	}
+	sort.Slice(viagens, func(i, j int) bool {
+		return viagens[i].DataInicio.Before(viagens[j].DataInicio)
+	})
+
+	var violacoes []ViolacaoJornada
+	direcao, folga := tempoLivre(viagem)
+
+	// Viagens separadas por menos que a pausa mínima formam direção contínua
+	if bloco := encadeadas(viagens, viagem, PausaMinimaDirecao); len(bloco) > 1 {
+		if duracao := duracaoTotal(bloco); duracao > DirecaoContinuaMaxima {
+			violacoes = append(violacoes, ViolacaoJornada{
+				MotoristaID: motoristaID,
+				Regra:       RegraDirecaoContinua,
+				Descricao: fmt.Sprintf("%s de direção sem pausa de %s entre viagens; o máximo é %s",
+					formatarDuracao(duracao), formatarDuracao(PausaMinimaDirecao), formatarDuracao(DirecaoContinuaMaxima)),
+				Viagens: idsViagens(bloco),
+			})
+		}
+	} else if !viagem.PossuiRevezamento() && direcao > DirecaoContinuaMaxima && folga < pausasNecessarias(direcao) {
+		violacoes = append(violacoes, ViolacaoJornada{
+			MotoristaID: motoristaID,
+			Regra:       RegraDirecaoContinua,
+			Descricao: fmt.Sprintf("%s de direção sem revezamento nem tempo para pausas de %s; o máximo sem pausa é %s",
+				formatarDuracao(direcao), formatarDuracao(PausaMinimaDirecao), formatarDuracao(DirecaoContinuaMaxima)),
+			Viagens: []uuid.UUID{viagem.ID},
+		})
+	}
+
+	// Viagens separadas por menos que o descanso mínimo são da mesma jornada
+	if jornada := encadeadas(viagens, viagem, DescansoMinimoEntreJornadas); len(jornada) > 1 {
+		if duracao := duracaoTotal(jornada); duracao > JornadaMaximaDiaria {
+			violacoes = append(violacoes, ViolacaoJornada{
+				MotoristaID: motoristaID,
+				Regra:       RegraDescansoEntreJornadas,
+				Descricao: fmt.Sprintf("jornada de %s sem descanso de %s; o máximo é %s",
+					formatarDuracao(duracao), formatarDuracao(DescansoMinimoEntreJornadas), formatarDuracao(JornadaMaximaDiaria)),
+				Viagens: idsViagens(jornada),
+			})
+		}
+	} else if !viagem.PossuiRevezamento() && direcao > JornadaMaximaDiaria && folga < DescansoMinimoEntreJornadas {
+		violacoes = append(violacoes, ViolacaoJornada{
+			MotoristaID: motoristaID,
+			Regra:       RegraDescansoEntreJornadas,
+			Descricao: fmt.Sprintf("jornada de %s sem revezamento nem tempo para descanso de %s; o máximo é %s",
+				formatarDuracao(direcao), formatarDuracao(DescansoMinimoEntreJornadas), formatarDuracao(JornadaMaximaDiaria)),
+			Viagens: []uuid.UUID{viagem.ID},
+		})
+	}
+
+	// Descanso semanal nos 7 dias que terminam com a viagem e nos 7 que começam com ela
+	for _, inicio := range []time.Time{viagem.DataFim.Add(-PeriodoDescansoSemanal), viagem.DataInicio} {
+		fim := inicio.Add(PeriodoDescansoSemanal)
+		if maiorDescanso(viagens, inicio, fim) < DescansoSemanalMinimo {
+			violacoes = append(violacoes, ViolacaoJornada{
+				MotoristaID: motoristaID,
+				Regra:       RegraDescansoSemanal,
+				Descricao: fmt.Sprintf("sem descanso de %s entre %s e %s",
+					formatarDuracao(DescansoSemanalMinimo), inicio.Format("02/01/2006 15:04"), fim.Format("02/01/2006 15:04")),
+				Viagens: idsViagens(sobrepostas(viagens, inicio, fim)),
+			})
+			break
+		}
+	}
+
+	return violacoes
+}
+
+// tempoLivre retorna a direção prevista da viagem e o tempo do período que
+// sobra para pausas e descansos; sem direção prevista informada, não sobra nada
+func tempoLivre(viagem *Viagem) (direcao, folga time.Duration) {
+	direcao = viagem.DirecaoPrevista()
+	return direcao, viagem.DataFim.Sub(viagem.DataInicio) - direcao
+}
+
+// pausasNecessarias retorna o tempo mínimo de pausas para que nenhum trecho
+// da direção passe da direção contínua máxima
+func pausasNecessarias(direcao time.Duration) time.Duration {
+	trechos := (direcao + DirecaoContinuaMaxima - 1) / DirecaoContinuaMaxima
+	return (trechos - 1) * PausaMinimaDirecao
+}
+
+// encadeadas retorna a sequência de viagens, ordenadas pelo início, que
+// contém a viagem informada e cujos intervalos entre si são menores que intervalo
+func encadeadas(viagens []*Viagem, viagem *Viagem, intervalo time.Duration) []*Viagem {
+	var bloco []*Viagem
+	var fimBloco time.Time
+	contemViagem := false
+
+	for _, v := range viagens {
+		if len(bloco) > 0 && v.DataInicio.Sub(fimBloco) >= intervalo {
+			if contemViagem {
+				return bloco
+			}
+			bloco = nil
+		}
+		if len(bloco) == 0 || v.DataFim.After(fimBloco) {
+			fimBloco = v.DataFim
+		}
+		bloco = append(bloco, v)
+		if v == viagem {
+			contemViagem = true
+		}
+	}
+
+	if contemViagem {
+		return bloco
+	}
+	return nil
+}
+
+// duracaoTotal retorna o período do início da primeira viagem ao fim da última
+func duracaoTotal(viagens []*Viagem) time.Duration {
+	inicio, fim := viagens[0].DataInicio, viagens[0].DataFim
+	for _, v := range viagens[1:] {
+		if v.DataFim.After(fim) {
+			fim = v.DataFim
+		}
+	}
+	return fim.Sub(inicio)
+}
+
+// maiorDescanso retorna o maior intervalo sem viagens entre inicio e fim
+func maiorDescanso(viagens []*Viagem, inicio, fim time.Time) time.Duration {
+	var maior time.Duration
+	cursor := inicio
+	for _, v := range sobrepostas(viagens, inicio, fim) {
+		if intervalo := v.DataInicio.Sub(cursor); intervalo > maior {
+			maior = intervalo
+		}
+		if v.DataFim.After(cursor) {
+			cursor = v.DataFim
+		}
+	}
+	if intervalo := fim.Sub(cursor); intervalo > maior {
+		maior = intervalo
+	}
+	return maior
+}
+
+func sobrepostas(viagens []*Viagem, inicio, fim time.Time) []*Viagem {
+	var result []*Viagem
+	for _, v := range viagens {
+		if v.DataInicio.Before(fim) && v.DataFim.After(inicio) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func idsViagens(viagens []*Viagem) []uuid.UUID {
+	ids := make([]uuid.UUID, len(viagens))
+	for i, v := range viagens {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+// formatarDuracao escreve a duração no formato 5h30 (ou 30min)
+func formatarDuracao(d time.Duration) string {
+	horas := int(d.Hours())
+	minutos := int(d.Minutes()) % 60
+	if horas == 0 {
+		return fmt.Sprintf("%dmin", minutos)
+	}
+	if minutos == 0 {
+		return fmt.Sprintf("%dh", horas)
+	}
+	return fmt.Sprintf("%dh%02d", horas, minutos)
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func viagemJornada(inicio time.Time, duracao time.Duration) *Viagem {
+	return &Viagem{ID: uuid.New(), DataInicio: inicio, DataFim: inicio.Add(duracao), Status: StatusAgendada}
+}
+
+func TestVerificarJornada(t *testing.T) {
+	motoristaID := uuid.New()
+	dia := time.Date(2026, 3, 2, 6, 0, 0, 0, time.UTC)
+	h := time.Hour
+
+	comRevezamento := func(v *Viagem) *Viagem {
+		secundario := uuid.New()
+		v.MotoristaSecundarioID = &secundario
+		return v
+	}
+	comDirecao := func(v *Viagem, direcao time.Duration) *Viagem {
+		v.DirecaoPrevistaMinutos = int(direcao.Minutes())
+		return v
+	}
+	cancelada := func(v *Viagem) *Viagem {
+		v.Status = StatusCancelada
+		return v
+	}
+
+	// Sete dias seguidos de viagem antes da nova, sem descanso de 35 horas
+	var semana []*Viagem
+	for i := 0; i < 7; i++ {
+		semana = append(semana, comDirecao(viagemJornada(dia.AddDate(0, 0, i), 10*h), 8*h))
+	}
+
+	casos := []struct {
+		nome   string
+		viagem *Viagem
+		agenda []*Viagem
+		regras []RegraJornada
+	}{
+		{
+			nome:   "viagem curta",
+			viagem: viagemJornada(dia, 4*h),
+		},
+		{
+			nome:   "viagem única sem pausa acima da direção contínua",
+			viagem: viagemJornada(dia, 8*h),
+			regras: []RegraJornada{RegraDirecaoContinua},
+		},
+		{
+			nome:   "viagem única com revezamento",
+			viagem: comRevezamento(viagemJornada(dia, 8*h)),
+		},
+		{
+			nome:   "direção prevista deixa tempo para a pausa",
+			viagem: comDirecao(viagemJornada(dia, 8*h), 7*h),
+		},
+		{
+			nome:   "direção prevista sem tempo para a pausa",
+			viagem: comDirecao(viagemJornada(dia, 8*h), 7*h+45*time.Minute),
+			regras: []RegraJornada{RegraDirecaoContinua},
+		},
+		{
+			nome:   "exatamente a direção contínua máxima",
+			viagem: viagemJornada(dia, DirecaoContinuaMaxima),
+		},
+		{
+			nome:   "viagem única acima da jornada sem tempo para descanso",
+			viagem: comDirecao(viagemJornada(dia, 20*h), 14*h),
+			regras: []RegraJornada{RegraDescansoEntreJornadas},
+		},
+		{
+			nome:   "viagem longa com pernoite planejado",
+			viagem: comDirecao(viagemJornada(dia, 30*h), 14*h),
+		},
+		{
+			nome:   "viagens encadeadas sem pausa",
+			viagem: viagemJornada(dia.Add(3*h+15*time.Minute), 3*h),
+			agenda: []*Viagem{viagemJornada(dia, 3*h)},
+			regras: []RegraJornada{RegraDirecaoContinua},
+		},
+		{
+			nome:   "viagens com pausa entre elas",
+			viagem: viagemJornada(dia.Add(4*h), 3*h),
+			agenda: []*Viagem{viagemJornada(dia, 3*h)},
+		},
+		{
+			nome:   "viagem cancelada não conta",
+			viagem: viagemJornada(dia.Add(3*h+15*time.Minute), 3*h),
+			agenda: []*Viagem{cancelada(viagemJornada(dia, 3*h))},
+		},
+		{
+			nome:   "jornada encadeada acima do máximo diário",
+			viagem: viagemJornada(dia.Add(12*h), 3*h),
+			agenda: []*Viagem{viagemJornada(dia, 5*h), viagemJornada(dia.Add(6*h), 5*h)},
+			regras: []RegraJornada{RegraDescansoEntreJornadas},
+		},
+		{
+			nome:   "sem descanso semanal",
+			viagem: comDirecao(viagemJornada(dia.AddDate(0, 0, 7), 10*h), 8*h),
+			agenda: semana,
+			regras: []RegraJornada{RegraDescansoSemanal},
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			var regras []RegraJornada
+			for _, v := range VerificarJornada(motoristaID, c.viagem, c.agenda) {
+				if v.MotoristaID != motoristaID {
+					t.Errorf("violação com motorista %s", v.MotoristaID)
+				}
+				regras = append(regras, v.Regra)
+			}
+			if !slices.Equal(regras, c.regras) {
+				t.Errorf("regras violadas = %v, esperado %v", regras, c.regras)
+			}
+		})
+	}
+}
+
+func TestPausasNecessarias(t *testing.T) {
+	casos := []struct {
+		direcao time.Duration
+		pausas  time.Duration
+	}{
+		{4 * time.Hour, 0},
+		{DirecaoContinuaMaxima, 0},
+		{DirecaoContinuaMaxima + time.Minute, PausaMinimaDirecao},
+		{11 * time.Hour, PausaMinimaDirecao},
+		{12 * time.Hour, 2 * PausaMinimaDirecao},
+	}
+
+	for _, c := range casos {
+		if pausas := pausasNecessarias(c.direcao); pausas != c.pausas {
+			t.Errorf("pausasNecessarias(%v) = %v, esperado %v", c.direcao, pausas, c.pausas)
+		}
+	}
+}
+
+func TestFormatarDuracao(t *testing.T) {
+	casos := map[time.Duration]string{
+		30 * time.Minute:             "30min",
+		5 * time.Hour:                "5h",
+		5*time.Hour + 30*time.Minute: "5h30",
+		13*time.Hour + 5*time.Minute: "13h05",
+	}
+	for duracao, esperado := range casos {
+		if texto := formatarDuracao(duracao); texto != esperado {
+			t.Errorf("formatarDuracao(%v) = %q, esperado %q", duracao, texto, esperado)
+		}
+	}
+}
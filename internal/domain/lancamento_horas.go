@@ -0,0 +1,383 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Jornada contratual e horário noturno do motorista empregado (CLT)
+const (
+	// JornadaDiariaPadrao é a jornada diária além da qual o trabalho é hora extra
+	JornadaDiariaPadrao = 8 * time.Hour
+	// JornadaSemanalPadrao é a jornada semanal além da qual o trabalho é hora extra
+	JornadaSemanalPadrao = 44 * time.Hour
+	// InicioHorarioNoturno e FimHorarioNoturno delimitam o trabalho noturno,
+	// das 22h às 5h
+	InicioHorarioNoturno = 22
+	FimHorarioNoturno    = 5
+	// HoraNoturnaReduzida é a duração da hora noturna: cada 52min30s
+	// trabalhados à noite contam como uma hora
+	HoraNoturnaReduzida = 52*time.Minute + 30*time.Second
+)
+
+// TipoLancamentoHoras representa a origem de um lançamento no banco de horas
+type TipoLancamentoHoras string
+
+const (
+	LancamentoViagem      TipoLancamentoHoras = "VIAGEM"      // gerado no check-out da viagem
+	LancamentoAjuste      TipoLancamentoHoras = "AJUSTE"      // correção manual, a crédito ou a débito
+	LancamentoCompensacao TipoLancamentoHoras = "COMPENSACAO" // folga que consome o saldo
+)
+
+// JornadaTrabalho define as jornadas diária e semanal do motorista
+type JornadaTrabalho struct {
+	Diaria  time.Duration
+	Semanal time.Duration
+}
+
+// JornadaTrabalhoPadrao retorna a jornada de 8 horas diárias e 44 semanais
+func JornadaTrabalhoPadrao() JornadaTrabalho {
+	return JornadaTrabalho{Diaria: JornadaDiariaPadrao, Semanal: JornadaSemanalPadrao}
+}
+
+// LancamentoHoras é um lançamento no banco de horas do motorista. Saldo é o
+// efeito do lançamento no banco, em minutos: nas viagens, as horas extras e o
+// acréscimo da hora noturna reduzida; nos ajustes, o valor informado; nas
+// compensações, as horas de folga, a débito. O saldo do banco é a soma dos
+// saldos dos lançamentos.
+type LancamentoHoras struct {
+	ID          uuid.UUID           `json:"id" gorm:"type:uuid;primary_key"`
+	MotoristaID uuid.UUID           `json:"motorista_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_lancamento_horas_viagem"`
+	ViagemID    *uuid.UUID          `json:"viagem_id,omitempty" gorm:"type:uuid;uniqueIndex:idx_lancamento_horas_viagem"`
+	Tipo        TipoLancamentoHoras `json:"tipo" gorm:"type:varchar(20);not null"`
+	Data        time.Time           `json:"data" gorm:"not null;index"` // início do trabalho ou data do lançamento manual
+	Fim         *time.Time          `json:"fim,omitempty"`              // fim do trabalho, nos lançamentos de viagem
+
+	Minutos          int `json:"minutos" gorm:"not null;default:0"` // trabalhados, ajustados ou compensados
+	MinutosNoturnos  int `json:"minutos_noturnos" gorm:"not null;default:0"`
+	MinutosExtras    int `json:"minutos_extras" gorm:"not null;default:0"`
+	AcrescimoNoturno int `json:"acrescimo_noturno" gorm:"not null;default:0"`
+	Saldo            int `json:"saldo" gorm:"not null"`
+
+	Descricao     string `json:"descricao" gorm:"type:text"`
+	RegistradoPor Ator   `json:"registrado_por" gorm:"embedded"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+// NewLancamentoViagem lança as horas trabalhadas pelo motorista na viagem
+// concluída, do horário do check-in ao do check-out. No revezamento, o tempo
+// de trabalho e o noturno são divididos igualmente. As horas extras são as
+// que a viagem acrescenta às das semanas em que foi realizada, considerando
+// os lançamentos de viagem já feitos nelas.
+func NewLancamentoViagem(viagem *Viagem, checkIn, checkOut *RegistroViagem, motoristaID uuid.UUID,
+	jornada JornadaTrabalho, anteriores []*LancamentoHoras) *LancamentoHoras {
+	inicio, fim := checkIn.RegistradoEm, checkOut.RegistradoEm
+	minutos := int(fim.Sub(inicio).Minutes())
+	minutosNoturnos := int(duracaoNoturna(inicio, fim).Minutes())
+	if viagem.PossuiRevezamento() {
+		minutos /= 2
+		minutosNoturnos /= 2
+	}
+
+	lancamento := &LancamentoHoras{
+		ID:               uuid.New(),
+		MotoristaID:      motoristaID,
+		ViagemID:         &viagem.ID,
+		Tipo:             LancamentoViagem,
+		Data:             inicio,
+		Fim:              &fim,
+		Minutos:          minutos,
+		MinutosNoturnos:  minutosNoturnos,
+		AcrescimoNoturno: acrescimoNoturno(minutosNoturnos),
+		Descricao:        viagem.Origem + " - " + viagem.Destino,
+		CreatedAt:        time.Now(),
+	}
+
+	comViagem := append(append([]*LancamentoHoras(nil), anteriores...), lancamento)
+	lancamento.MinutosExtras = max(HorasExtras(comViagem, jornada)-HorasExtras(anteriores, jornada), 0)
+	lancamento.Saldo = lancamento.MinutosExtras + lancamento.AcrescimoNoturno
+	return lancamento
+}
+
+// NewAjusteHoras cria um ajuste manual do banco de horas. Minutos negativos
+// debitam o banco.
+func NewAjusteHoras(motoristaID uuid.UUID, data time.Time, minutos int, descricao string) *LancamentoHoras {
+	return &LancamentoHoras{
+		ID:          uuid.New(),
+		MotoristaID: motoristaID,
+		Tipo:        LancamentoAjuste,
+		Data:        data,
+		Minutos:     minutos,
+		Saldo:       minutos,
+		Descricao:   descricao,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// NewCompensacaoHoras registra a folga compensatória, que consome os minutos
+// informados do banco
+func NewCompensacaoHoras(motoristaID uuid.UUID, data time.Time, minutos int, descricao string) *LancamentoHoras {
+	return &LancamentoHoras{
+		ID:          uuid.New(),
+		MotoristaID: motoristaID,
+		Tipo:        LancamentoCompensacao,
+		Data:        data,
+		Minutos:     minutos,
+		Saldo:       -minutos,
+		Descricao:   descricao,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Validar verifica se o lançamento manual é válido
+func (l *LancamentoHoras) Validar() error {
+	if l.Data.IsZero() {
+		return ErrDataLancamentoHorasObrigatoria
+	}
+
+	switch l.Tipo {
+	case LancamentoAjuste:
+		if l.Minutos == 0 {
+			return ErrMinutosAjusteInvalidos
+		}
+		if strings.TrimSpace(l.Descricao) == "" {
+			return ErrJustificativaAjusteObrigatoria
+		}
+	case LancamentoCompensacao:
+		if l.Minutos <= 0 {
+			return ErrMinutosCompensacaoInvalidos
+		}
+	case LancamentoViagem:
+	default:
+		return ErrTipoLancamentoHorasInvalido
+	}
+
+	return nil
+}
+
+// HorasExtras calcula, em minutos, as horas extras dos lançamentos de viagem.
+// Em cada semana (de segunda a domingo) vale o maior entre a soma do que
+// excede a jornada diária em cada dia e o que excede a jornada semanal, para
+// não contar duas vezes o mesmo excesso.
+func HorasExtras(lancamentos []*LancamentoHoras, jornada JornadaTrabalho) int {
+	porDia := make(map[time.Time]float64)
+	for _, l := range lancamentos {
+		if l.Tipo != LancamentoViagem || l.Fim == nil {
+			continue
+		}
+		for dia, minutos := range distribuirPorDia(l.Data, *l.Fim, l.Minutos) {
+			porDia[dia] += minutos
+		}
+	}
+
+	type totalSemana struct{ diario, semanal float64 }
+	semanas := make(map[time.Time]*totalSemana)
+	diaria := jornada.Diaria.Minutes()
+	for dia, minutos := range porDia {
+		semana := inicioSemana(dia)
+		if semanas[semana] == nil {
+			semanas[semana] = &totalSemana{}
+		}
+		semanas[semana].semanal += minutos
+		if minutos > diaria {
+			semanas[semana].diario += minutos - diaria
+		}
+	}
+
+	var extras float64
+	semanal := jornada.Semanal.Minutes()
+	for _, s := range semanas {
+		excessoSemanal := s.semanal - semanal
+		if s.diario > excessoSemanal {
+			extras += s.diario
+		} else {
+			extras += excessoSemanal
+		}
+	}
+	return int(extras + 0.5)
+}
+
+// distribuirPorDia reparte os minutos trabalhados entre os dias do período
+// na proporção do tempo em cada dia
+func distribuirPorDia(inicio, fim time.Time, minutos int) map[time.Time]float64 {
+	dias := make(map[time.Time]float64)
+	duracao := fim.Sub(inicio)
+	if duracao <= 0 {
+		dias[inicioDia(inicio)] = float64(minutos)
+		return dias
+	}
+
+	for dia := inicioDia(inicio); dia.Before(fim); dia = dia.AddDate(0, 0, 1) {
+		trecho := sobreposicao(inicio, fim, dia, dia.AddDate(0, 0, 1))
+		dias[dia] = float64(minutos) * float64(trecho) / float64(duracao)
+	}
+	return dias
+}
+
+// duracaoNoturna retorna o tempo do período entre 22h e 5h
+func duracaoNoturna(inicio, fim time.Time) time.Duration {
+	var noturna time.Duration
+	// A noite que termina no dia do início começou às 22h do dia anterior
+	for dia := inicioDia(inicio).AddDate(0, 0, -1); dia.Before(fim); dia = dia.AddDate(0, 0, 1) {
+		inicioNoite := dia.Add(InicioHorarioNoturno * time.Hour)
+		fimNoite := dia.AddDate(0, 0, 1).Add(FimHorarioNoturno * time.Hour)
+		noturna += sobreposicao(inicio, fim, inicioNoite, fimNoite)
+	}
+	return noturna
+}
+
+// acrescimoNoturno retorna os minutos que a hora noturna reduzida acrescenta
+// ao tempo trabalhado à noite
+func acrescimoNoturno(minutosNoturnos int) int {
+	computados := float64(minutosNoturnos) * float64(time.Hour) / float64(HoraNoturnaReduzida)
+	return int(computados+0.5) - minutosNoturnos
+}
+
+func inicioDia(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// inicioSemana retorna a segunda-feira da semana do dia
+func inicioSemana(t time.Time) time.Time {
+	dia := inicioDia(t)
+	return dia.AddDate(0, 0, -((int(dia.Weekday()) + 6) % 7))
+}
+
+// SemanasDoPeriodo retorna as semanas completas, de segunda a domingo, que
+// contêm o período. Um dia antes é incluído para alcançar o trabalho iniciado
+// na véspera e que avança sobre a primeira semana.
+func SemanasDoPeriodo(inicio, fim time.Time) Periodo {
+	return Periodo{
+		DataInicio: inicioSemana(inicio).AddDate(0, 0, -1),
+		DataFim:    inicioSemana(fim).AddDate(0, 0, 7),
+	}
+}
+
+// AgrupamentoHoras define os períodos do extrato do banco de horas
+type AgrupamentoHoras string
+
+const (
+	AgruparPorDia    AgrupamentoHoras = "DIA"
+	AgruparPorSemana AgrupamentoHoras = "SEMANA"
+	AgruparPorMes    AgrupamentoHoras = "MES"
+)
+
+// Validar verifica se o agrupamento é válido
+func (a AgrupamentoHoras) Validar() error {
+	switch a {
+	case AgruparPorDia, AgruparPorSemana, AgruparPorMes:
+		return nil
+	default:
+		return ErrAgrupamentoHorasInvalido
+	}
+}
+
+// inicioPeriodo retorna o início do período do agrupamento que contém t e o
+// início do período seguinte
+func (a AgrupamentoHoras) inicioPeriodo(t time.Time) (time.Time, time.Time) {
+	switch a {
+	case AgruparPorDia:
+		dia := inicioDia(t)
+		return dia, dia.AddDate(0, 0, 1)
+	case AgruparPorSemana:
+		semana := inicioSemana(t)
+		return semana, semana.AddDate(0, 0, 7)
+	default:
+		mes := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		return mes, mes.AddDate(0, 1, 0)
+	}
+}
+
+// SaldoPeriodoHoras resume os lançamentos de um período, em minutos
+type SaldoPeriodoHoras struct {
+	Inicio time.Time `json:"inicio"`
+	Fim    time.Time `json:"fim"`
+
+	Trabalhados      int `json:"trabalhados"`
+	Noturnos         int `json:"noturnos"`
+	Extras           int `json:"extras"`
+	AcrescimoNoturno int `json:"acrescimo_noturno"`
+	Ajustes          int `json:"ajustes"`
+	Compensados      int `json:"compensados"`
+	Saldo            int `json:"saldo"`           // efeito do período no banco
+	SaldoAcumulado   int `json:"saldo_acumulado"` // saldo do banco ao fim do período
+}
+
+func (s *SaldoPeriodoHoras) somar(l *LancamentoHoras) {
+	switch l.Tipo {
+	case LancamentoViagem:
+		s.Trabalhados += l.Minutos
+		s.Noturnos += l.MinutosNoturnos
+		s.Extras += l.MinutosExtras
+		s.AcrescimoNoturno += l.AcrescimoNoturno
+	case LancamentoAjuste:
+		s.Ajustes += l.Minutos
+	case LancamentoCompensacao:
+		s.Compensados += l.Minutos
+	}
+	s.Saldo += l.Saldo
+}
+
+// ExtratoBancoHoras traz o saldo do banco de horas do motorista antes do
+// período, o resumo de cada dia, semana ou mês com lançamentos e o total
+type ExtratoBancoHoras struct {
+	MotoristaID   uuid.UUID           `json:"motorista_id"`
+	DataInicio    time.Time           `json:"data_inicio"`
+	DataFim       time.Time           `json:"data_fim"`
+	SaldoAnterior int                 `json:"saldo_anterior"`
+	Periodos      []SaldoPeriodoHoras `json:"periodos"`
+	Total         SaldoPeriodoHoras   `json:"total"`
+	Lancamentos   []*LancamentoHoras  `json:"lancamentos"`
+}
+
+// CalcularExtratoBancoHoras agrupa os lançamentos do período, partindo do
+// saldo acumulado antes dele
+func CalcularExtratoBancoHoras(motoristaID uuid.UUID, dataInicio, dataFim time.Time, agrupamento AgrupamentoHoras,
+	saldoAnterior int, lancamentos []*LancamentoHoras) *ExtratoBancoHoras {
+	ordenados := append([]*LancamentoHoras(nil), lancamentos...)
+	sort.SliceStable(ordenados, func(i, j int) bool {
+		return ordenados[i].Data.Before(ordenados[j].Data)
+	})
+
+	extrato := &ExtratoBancoHoras{
+		MotoristaID:   motoristaID,
+		DataInicio:    dataInicio,
+		DataFim:       dataFim,
+		SaldoAnterior: saldoAnterior,
+		Periodos:      []SaldoPeriodoHoras{},
+		Total:         SaldoPeriodoHoras{Inicio: dataInicio, Fim: dataFim},
+		Lancamentos:   ordenados,
+	}
+
+	acumulado := saldoAnterior
+	for _, l := range ordenados {
+		inicio, fim := agrupamento.inicioPeriodo(l.Data)
+		if n := len(extrato.Periodos); n == 0 || !extrato.Periodos[n-1].Inicio.Equal(inicio) {
+			extrato.Periodos = append(extrato.Periodos, SaldoPeriodoHoras{Inicio: inicio, Fim: fim})
+		}
+		periodo := &extrato.Periodos[len(extrato.Periodos)-1]
+		periodo.somar(l)
+		extrato.Total.somar(l)
+
+		acumulado += l.Saldo
+		periodo.SaldoAcumulado = acumulado
+	}
+	extrato.Total.SaldoAcumulado = acumulado
+
+	return extrato
+}
+
+// Erros de domínio
+var (
+	ErrTipoLancamentoHorasInvalido    = NewDomainError("tipo de lançamento de horas inválido")
+	ErrDataLancamentoHorasObrigatoria = NewDomainError("data do lançamento de horas é obrigatória")
+	ErrMinutosAjusteInvalidos         = NewDomainError("ajuste do banco de horas deve ter minutos diferentes de zero")
+	ErrJustificativaAjusteObrigatoria = NewDomainError("justificativa do ajuste do banco de horas é obrigatória")
+	ErrMinutosCompensacaoInvalidos    = NewDomainError("minutos compensados devem ser maiores que zero")
+	ErrSaldoBancoHorasInsuficiente    = NewDomainError("saldo do banco de horas insuficiente para a compensação")
+	ErrAgrupamentoHorasInvalido       = NewDomainError("agrupamento do extrato deve ser DIA, SEMANA ou MES")
+)
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// segunda-feira
+var inicioSemanaTeste = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+func lancamentoViagemTeste(inicio time.Time, duracao time.Duration) *LancamentoHoras {
+	fim := inicio.Add(duracao)
+	return &LancamentoHoras{Tipo: LancamentoViagem, Data: inicio, Fim: &fim, Minutos: int(duracao.Minutes())}
+}
+
+func TestDuracaoNoturna(t *testing.T) {
+	d := func(dia, hora, minuto int) time.Time { return time.Date(2026, 3, dia, hora, minuto, 0, 0, time.UTC) }
+
+	casos := []struct {
+		nome    string
+		inicio  time.Time
+		fim     time.Time
+		noturna time.Duration
+	}{
+		{"diurna", d(2, 8, 0), d(2, 18, 0), 0},
+		{"atravessa a noite", d(2, 20, 0), d(3, 8, 0), 7 * time.Hour},
+		{"começa de madrugada", d(2, 3, 0), d(2, 9, 0), 2 * time.Hour},
+		{"termina às 22h30", d(2, 18, 0), d(2, 22, 30), 30 * time.Minute},
+		{"duas noites", d(2, 12, 0), d(4, 12, 0), 14 * time.Hour},
+		{"período vazio", d(2, 23, 0), d(2, 23, 0), 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if noturna := duracaoNoturna(c.inicio, c.fim); noturna != c.noturna {
+				t.Errorf("duracaoNoturna = %v, esperado %v", noturna, c.noturna)
+			}
+		})
+	}
+}
+
+func TestAcrescimoNoturno(t *testing.T) {
+	casos := map[int]int{
+		0:   0,
+		105: 15, // 1h45 noturnas contam como 2 horas
+		420: 60, // a noite inteira, das 22h às 5h, conta como 8 horas
+		60:  9,  // 68,57 minutos arredondados
+	}
+	for noturnos, esperado := range casos {
+		if acrescimo := acrescimoNoturno(noturnos); acrescimo != esperado {
+			t.Errorf("acrescimoNoturno(%d) = %d, esperado %d", noturnos, acrescimo, esperado)
+		}
+	}
+}
+
+func TestHorasExtras(t *testing.T) {
+	h := time.Hour
+	dias := func(quantidade int, duracao time.Duration) []*LancamentoHoras {
+		var lancamentos []*LancamentoHoras
+		for i := 0; i < quantidade; i++ {
+			lancamentos = append(lancamentos, lancamentoViagemTeste(inicioSemanaTeste.AddDate(0, 0, i).Add(7*h), duracao))
+		}
+		return lancamentos
+	}
+
+	casos := []struct {
+		nome        string
+		lancamentos []*LancamentoHoras
+		extras      int
+	}{
+		{"sem lançamentos", nil, 0},
+		{"dia dentro da jornada", dias(1, 8*h), 0},
+		{"dia acima da jornada", dias(1, 10*h), 120},
+		{"semana dentro da jornada", dias(5, 8*h), 0},
+		{"semana acima da jornada semanal", dias(6, 8*h), 240},
+		{"vale o maior excesso da semana", dias(6, 9*h), 600},
+		{"virada do dia reparte o tempo", []*LancamentoHoras{lancamentoViagemTeste(inicioSemanaTeste.Add(20*h), 12*h)}, 0},
+		{"virada da semana reparte o tempo", []*LancamentoHoras{lancamentoViagemTeste(inicioSemanaTeste.AddDate(0, 0, 6).Add(12*h), 24*h)}, 480},
+		{"ajustes não contam", []*LancamentoHoras{NewAjusteHoras(uuid.New(), inicioSemanaTeste, 600, "ajuste")}, 0},
+	}
+
+	jornada := JornadaTrabalhoPadrao()
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if extras := HorasExtras(c.lancamentos, jornada); extras != c.extras {
+				t.Errorf("HorasExtras = %d, esperado %d", extras, c.extras)
+			}
+		})
+	}
+}
+
+func TestNewLancamentoViagem(t *testing.T) {
+	motoristaID := uuid.New()
+	jornada := JornadaTrabalhoPadrao()
+	viagem := func(inicio time.Time, duracao time.Duration) (*Viagem, *RegistroViagem, *RegistroViagem) {
+		fim := inicio.Add(duracao)
+		v := &Viagem{ID: uuid.New(), Origem: "São Paulo", Destino: "Curitiba", Status: StatusConcluida, InicioReal: &inicio, FimReal: &fim}
+		return v, NewRegistroViagem(v, RegistroCheckIn, LeituraViagem{RegistradoEm: inicio}),
+			NewRegistroViagem(v, RegistroCheckOut, LeituraViagem{RegistradoEm: fim})
+	}
+
+	t.Run("horas extras do dia", func(t *testing.T) {
+		v, checkIn, checkOut := viagem(inicioSemanaTeste.Add(7*time.Hour), 10*time.Hour)
+		l := NewLancamentoViagem(v, checkIn, checkOut, motoristaID, jornada, nil)
+		if l.Minutos != 600 || l.MinutosExtras != 120 || l.MinutosNoturnos != 0 || l.Saldo != 120 {
+			t.Errorf("lançamento = %+v", l)
+		}
+		if l.Tipo != LancamentoViagem || l.MotoristaID != motoristaID || l.Descricao != "São Paulo - Curitiba" {
+			t.Errorf("identificação do lançamento = %s %s %q", l.Tipo, l.MotoristaID, l.Descricao)
+		}
+	})
+
+	t.Run("considera as viagens anteriores", func(t *testing.T) {
+		anteriores := make([]*LancamentoHoras, 1, 2)
+		anteriores[0] = lancamentoViagemTeste(inicioSemanaTeste.Add(6*time.Hour), 4*time.Hour)
+
+		v, checkIn, checkOut := viagem(inicioSemanaTeste.Add(11*time.Hour), 10*time.Hour)
+		l := NewLancamentoViagem(v, checkIn, checkOut, motoristaID, jornada, anteriores)
+		if l.MinutosExtras != 360 {
+			t.Errorf("MinutosExtras = %d, esperado 360", l.MinutosExtras)
+		}
+		if anteriores[:2][1] != nil {
+			t.Error("lançamentos anteriores foram alterados")
+		}
+	})
+
+	t.Run("revezamento divide o trabalho e a noite", func(t *testing.T) {
+		v, checkIn, checkOut := viagem(inicioSemanaTeste.Add(20*time.Hour), 12*time.Hour)
+		secundario := uuid.New()
+		v.MotoristaSecundarioID = &secundario
+
+		l := NewLancamentoViagem(v, checkIn, checkOut, motoristaID, jornada, nil)
+		if l.Minutos != 360 || l.MinutosNoturnos != 210 || l.AcrescimoNoturno != 30 || l.MinutosExtras != 0 || l.Saldo != 30 {
+			t.Errorf("lançamento = %+v", l)
+		}
+	})
+
+	t.Run("usa os registros e não o período gravado na viagem", func(t *testing.T) {
+		v, checkIn, checkOut := viagem(inicioSemanaTeste.Add(8*time.Hour), 4*time.Hour)
+		inicio, fim := inicioSemanaTeste, inicioSemanaTeste.AddDate(0, 0, 1)
+		v.InicioReal, v.FimReal = &inicio, &fim
+
+		l := NewLancamentoViagem(v, checkIn, checkOut, motoristaID, jornada, nil)
+		if l.Minutos != 240 || l.MinutosExtras != 0 || !l.Data.Equal(checkIn.RegistradoEm) || !l.Fim.Equal(checkOut.RegistradoEm) {
+			t.Errorf("lançamento = %+v", l)
+		}
+	})
+}
+
+func TestSemanasDoPeriodo(t *testing.T) {
+	// De quarta a quinta da semana seguinte
+	semanas := SemanasDoPeriodo(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), time.Date(2026, 3, 12, 18, 0, 0, 0, time.UTC))
+
+	if inicio := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC); !semanas.DataInicio.Equal(inicio) {
+		t.Errorf("DataInicio = %v, esperado %v", semanas.DataInicio, inicio)
+	}
+	if fim := time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC); !semanas.DataFim.Equal(fim) {
+		t.Errorf("DataFim = %v, esperado %v", semanas.DataFim, fim)
+	}
+}
+
+func TestLancamentoHorasValidar(t *testing.T) {
+	motoristaID := uuid.New()
+	casos := []struct {
+		nome       string
+		lancamento *LancamentoHoras
+		erro       error
+	}{
+		{"ajuste válido", NewAjusteHoras(motoristaID, inicioSemanaTeste, -30, "correção"), nil},
+		{"ajuste sem minutos", NewAjusteHoras(motoristaID, inicioSemanaTeste, 0, "correção"), ErrMinutosAjusteInvalidos},
+		{"ajuste sem justificativa", NewAjusteHoras(motoristaID, inicioSemanaTeste, 30, " "), ErrJustificativaAjusteObrigatoria},
+		{"compensação válida", NewCompensacaoHoras(motoristaID, inicioSemanaTeste, 60, ""), nil},
+		{"compensação negativa", NewCompensacaoHoras(motoristaID, inicioSemanaTeste, -60, ""), ErrMinutosCompensacaoInvalidos},
+		{"sem data", NewAjusteHoras(motoristaID, time.Time{}, 30, "correção"), ErrDataLancamentoHorasObrigatoria},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if err := c.lancamento.Validar(); !errors.Is(err, c.erro) {
+				t.Errorf("Validar() = %v, esperado %v", err, c.erro)
+			}
+		})
+	}
+}
+
+func TestCalcularExtratoBancoHoras(t *testing.T) {
+	motoristaID := uuid.New()
+	viagem := lancamentoViagemTeste(inicioSemanaTeste.Add(7*time.Hour), 10*time.Hour)
+	viagem.MinutosExtras, viagem.Saldo = 120, 120
+	ajuste := NewAjusteHoras(motoristaID, inicioSemanaTeste.AddDate(0, 0, 1), 30, "correção")
+	compensacao := NewCompensacaoHoras(motoristaID, inicioSemanaTeste.AddDate(0, 0, 8), 90, "folga")
+
+	// Fora de ordem: o extrato ordena pela data
+	lancamentos := []*LancamentoHoras{compensacao, ajuste, viagem}
+	fim := inicioSemanaTeste.AddDate(0, 0, 14)
+
+	extrato := CalcularExtratoBancoHoras(motoristaID, inicioSemanaTeste, fim, AgruparPorSemana, 60, lancamentos)
+	if len(extrato.Periodos) != 2 {
+		t.Fatalf("períodos = %+v", extrato.Periodos)
+	}
+	primeira, segunda := extrato.Periodos[0], extrato.Periodos[1]
+	if primeira.Trabalhados != 600 || primeira.Extras != 120 || primeira.Ajustes != 30 || primeira.Saldo != 150 || primeira.SaldoAcumulado != 210 {
+		t.Errorf("primeira semana = %+v", primeira)
+	}
+	if !segunda.Inicio.Equal(inicioSemanaTeste.AddDate(0, 0, 7)) || segunda.Compensados != 90 || segunda.Saldo != -90 || segunda.SaldoAcumulado != 120 {
+		t.Errorf("segunda semana = %+v", segunda)
+	}
+	if extrato.Total.Saldo != 60 || extrato.Total.SaldoAcumulado != 120 || extrato.Lancamentos[0] != viagem {
+		t.Errorf("total = %+v", extrato.Total)
+	}
+
+	if porDia := CalcularExtratoBancoHoras(motoristaID, inicioSemanaTeste, fim, AgruparPorDia, 60, lancamentos); len(porDia.Periodos) != 3 {
+		t.Errorf("extrato por dia com %d períodos, esperado 3", len(porDia.Periodos))
+	}
+
+	vazio := CalcularExtratoBancoHoras(motoristaID, inicioSemanaTeste, fim, AgruparPorMes, 60, nil)
+	if len(vazio.Periodos) != 0 || vazio.Total.SaldoAcumulado != 60 {
+		t.Errorf("extrato sem lançamentos = %+v", vazio)
+	}
+}
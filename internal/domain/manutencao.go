@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IntervaloManutencaoPadrao é o prazo até a próxima manutenção de veículos
+// sem plano de manutenção por tempo
+const IntervaloManutencaoPadrao = 6 // meses
+
+// MargemAvisoManutencaoKm é a quilometragem antes do limite do plano a partir
+// da qual a manutenção passa a ser considerada próxima
+const MargemAvisoManutencaoKm = 1000
+
+// TipoManutencao representa os tipos de manutenção
+type TipoManutencao string
+
+const (
+	ManutencaoPreventiva TipoManutencao = "PREVENTIVA"
+	ManutencaoCorretiva  TipoManutencao = "CORRETIVA"
+)
+
+// StatusOrdemManutencao representa os possíveis status de uma ordem de manutenção
+type StatusOrdemManutencao string
+
+const (
+	StatusOrdemAgendada    StatusOrdemManutencao = "AGENDADA"
+	StatusOrdemEmAndamento StatusOrdemManutencao = "EM_ANDAMENTO"
+	StatusOrdemConcluida   StatusOrdemManutencao = "CONCLUIDA"
+	StatusOrdemCancelada   StatusOrdemManutencao = "CANCELADA"
+)
+
+// OrdemManutencao é um serviço de manutenção de um veículo. Enquanto está em
+// andamento, o veículo fica em manutenção.
+type OrdemManutencao struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID uuid.UUID      `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	PlanoID   *uuid.UUID     `json:"plano_id,omitempty" gorm:"type:uuid;index"`
+	Tipo      TipoManutencao `json:"tipo" gorm:"type:varchar(20);not null"`
+	Descricao string         `json:"descricao" gorm:"type:text;not null"`
+
+	Fornecedor     string  `json:"fornecedor" gorm:"type:varchar(100)"`
+	CustoPecas     float64 `json:"custo_pecas" gorm:"type:decimal(10,2);not null;default:0"`
+	CustoMaoDeObra float64 `json:"custo_mao_de_obra" gorm:"type:decimal(10,2);not null;default:0"`
+	Odometro       int     `json:"odometro" gorm:"not null;default:0"` // leitura no serviço
+
+	Status       StatusOrdemManutencao `json:"status" gorm:"type:varchar(20);not null;default:'AGENDADA';index"`
+	AgendadaPara time.Time             `json:"agendada_para" gorm:"not null"`
+	IniciadaEm   *time.Time            `json:"iniciada_em,omitempty"`
+	ConcluidaEm  *time.Time            `json:"concluida_em,omitempty"`
+	Observacoes  string                `json:"observacoes" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewOrdemManutencao cria uma nova instância de OrdemManutencao
+func NewOrdemManutencao(veiculoID uuid.UUID, tipo TipoManutencao, descricao string, agendadaPara time.Time) *OrdemManutencao {
+	return &OrdemManutencao{
+		ID:           uuid.New(),
+		VeiculoID:    veiculoID,
+		Tipo:         tipo,
+		Descricao:    descricao,
+		Status:       StatusOrdemAgendada,
+		AgendadaPara: agendadaPara,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Validar verifica se a ordem de manutenção é válida
+func (o *OrdemManutencao) Validar() error {
+	if o.Tipo != ManutencaoPreventiva && o.Tipo != ManutencaoCorretiva {
+		return ErrTipoManutencaoInvalido
+	}
+
+	if o.Descricao == "" {
+		return ErrDescricaoManutencaoObrigatoria
+	}
+
+	if o.CustoPecas < 0 || o.CustoMaoDeObra < 0 {
+		return ErrCustoManutencaoInvalido
+	}
+
+	if o.Odometro < 0 {
+		return ErrOdometroInvalido
+	}
+
+	return nil
+}
+
+// CustoTotal retorna a soma das peças e da mão de obra
+func (o *OrdemManutencao) CustoTotal() float64 {
+	return o.CustoPecas + o.CustoMaoDeObra
+}
+
+// Iniciar coloca a ordem agendada em andamento e o veículo em manutenção. O
+// veículo em viagem só entra em manutenção depois do check-out.
+func (o *OrdemManutencao) Iniciar(veiculo *Veiculo, agora time.Time) error {
+	if o.Status != StatusOrdemAgendada {
+		return ErrOrdemNaoIniciavel
+	}
+
+	if veiculo.Status == StatusEmUso {
+		return ErrVeiculoEmViagem
+	}
+
+	o.Status = StatusOrdemEmAndamento
+	o.IniciadaEm = &agora
+	o.UpdatedAt = agora
+	veiculo.AtualizarStatus(StatusManutencao)
+	return nil
+}
+
+// Concluir encerra a ordem em andamento com os custos finais e a leitura do
+// odômetro no serviço, que atualiza a do veículo
+func (o *OrdemManutencao) Concluir(veiculo *Veiculo, odometro int, custoPecas, custoMaoDeObra float64, agora time.Time) error {
+	if o.Status != StatusOrdemEmAndamento {
+		return ErrOrdemNaoEmAndamento
+	}
+
+	if custoPecas < 0 || custoMaoDeObra < 0 {
+		return ErrCustoManutencaoInvalido
+	}
+
+	if odometro == 0 {
+		odometro = veiculo.OdometroAtual
+	}
+	if err := veiculo.AtualizarOdometro(odometro); err != nil {
+		return err
+	}
+
+	o.Odometro = odometro
+	o.CustoPecas = custoPecas
+	o.CustoMaoDeObra = custoMaoDeObra
+	o.Status = StatusOrdemConcluida
+	o.ConcluidaEm = &agora
+	o.UpdatedAt = agora
+	return nil
+}
+
+// Cancelar desiste da ordem agendada ou interrompe a ordem em andamento
+func (o *OrdemManutencao) Cancelar(agora time.Time) error {
+	if o.Status != StatusOrdemAgendada && o.Status != StatusOrdemEmAndamento {
+		return ErrOrdemNaoCancelavel
+	}
+
+	o.Status = StatusOrdemCancelada
+	o.UpdatedAt = agora
+	return nil
+}
+
+// PlanoManutencao define a manutenção preventiva periódica de um veículo, a
+// cada intervalo de meses ou de quilômetros, o que vencer primeiro
+type PlanoManutencao struct {
+	ID             uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID      uuid.UUID `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	Descricao      string    `json:"descricao" gorm:"type:varchar(200);not null"`
+	IntervaloMeses int       `json:"intervalo_meses" gorm:"not null;default:0"`
+	IntervaloKm    int       `json:"intervalo_km" gorm:"not null;default:0"`
+
+	// Última execução, ponto de partida para o próximo vencimento
+	UltimaExecucao time.Time `json:"ultima_execucao" gorm:"not null"`
+	UltimoOdometro int       `json:"ultimo_odometro" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewPlanoManutencao cria um plano cuja contagem começa na situação atual do veículo
+func NewPlanoManutencao(veiculo *Veiculo, descricao string, intervaloMeses, intervaloKm int) *PlanoManutencao {
+	return &PlanoManutencao{
+		ID:             uuid.New(),
+		VeiculoID:      veiculo.ID,
+		Descricao:      descricao,
+		IntervaloMeses: intervaloMeses,
+		IntervaloKm:    intervaloKm,
+		UltimaExecucao: time.Now(),
+		UltimoOdometro: veiculo.OdometroAtual,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// Validar verifica se o plano de manutenção é válido
+func (p *PlanoManutencao) Validar() error {
+	if p.Descricao == "" {
+		return ErrDescricaoManutencaoObrigatoria
+	}
+
+	if p.IntervaloMeses < 0 || p.IntervaloKm < 0 || (p.IntervaloMeses == 0 && p.IntervaloKm == 0) {
+		return ErrIntervaloManutencaoInvalido
+	}
+
+	return nil
+}
+
+// ProximaData retorna a data de vencimento por tempo, zero se o plano é só por km
+func (p *PlanoManutencao) ProximaData() time.Time {
+	if p.IntervaloMeses == 0 {
+		return time.Time{}
+	}
+	return p.UltimaExecucao.AddDate(0, p.IntervaloMeses, 0)
+}
+
+// ProximoOdometro retorna o odômetro de vencimento, zero se o plano é só por tempo
+func (p *PlanoManutencao) ProximoOdometro() int {
+	if p.IntervaloKm == 0 {
+		return 0
+	}
+	return p.UltimoOdometro + p.IntervaloKm
+}
+
+// Vencido indica se o plano venceu por tempo ou por quilometragem
+func (p *PlanoManutencao) Vencido(agora time.Time, odometro int) bool {
+	if data := p.ProximaData(); !data.IsZero() && !agora.Before(data) {
+		return true
+	}
+	if km := p.ProximoOdometro(); km > 0 && odometro >= km {
+		return true
+	}
+	return false
+}
+
+// RegistrarExecucao reinicia a contagem do plano a partir da manutenção realizada
+func (p *PlanoManutencao) RegistrarExecucao(realizadaEm time.Time, odometro int) {
+	p.UltimaExecucao = realizadaEm
+	p.UltimoOdometro = odometro
+	p.UpdatedAt = time.Now()
+}
+
+// ProximaManutencao combina os planos do veículo e retorna o vencimento mais
+// próximo por tempo e por quilometragem. Sem plano por tempo, usa o intervalo
+// padrão a partir da última manutenção.
+func ProximaManutencao(ultima time.Time, planos []*PlanoManutencao) (data time.Time, odometro int) {
+	for _, p := range planos {
+		if d := p.ProximaData(); !d.IsZero() && (data.IsZero() || d.Before(data)) {
+			data = d
+		}
+		if km := p.ProximoOdometro(); km > 0 && (odometro == 0 || km < odometro) {
+			odometro = km
+		}
+	}
+
+	if data.IsZero() && !ultima.IsZero() {
+		data = ultima.AddDate(0, IntervaloManutencaoPadrao, 0)
+	}
+	return data, odometro
+}
+
+// Erros de domínio
+var (
+	ErrTipoManutencaoInvalido         = NewDomainError("tipo de manutenção inválido")
+	ErrDescricaoManutencaoObrigatoria = NewDomainError("descrição da manutenção é obrigatória")
+	ErrCustoManutencaoInvalido        = NewDomainError("custos da manutenção não podem ser negativos")
+	ErrIntervaloManutencaoInvalido    = NewDomainError("informe o intervalo do plano em meses, em km ou ambos")
+	ErrOrdemNaoIniciavel              = NewDomainError("somente ordens agendadas podem ser iniciadas")
+	ErrOrdemNaoEmAndamento            = NewDomainError("somente ordens em andamento podem ser concluídas")
+	ErrOrdemNaoCancelavel             = NewDomainError("ordem de manutenção já concluída ou cancelada")
+	ErrVeiculoEmViagem                = NewDomainError("veículo em viagem; registre o check-out antes da manutenção")
+	ErrPlanoOutroVeiculo              = NewDomainError("plano de manutenção pertence a outro veículo")
+)
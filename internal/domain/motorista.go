@@ -51,7 +51,6 @@ type Motorista struct {
 
 	// Informações adicionais
 	Observacoes string `json:"observacoes" gorm:"type:text"`
-	BancoHoras  int    `json:"banco_horas" gorm:"type:int;default:0"` // em minutos
 
 	CreatedAt time.Time `json:"created_at" gorm:"not null"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
@@ -130,12 +129,6 @@ func (m *Motorista) AtualizarDisponibilidade(disponivel bool) {
 	m.UpdatedAt = time.Now()
 }
 
-// AdicionarBancoHoras adiciona horas ao banco de horas do motorista
-func (m *Motorista) AdicionarBancoHoras(minutos int) {
-	m.BancoHoras += minutos
-	m.UpdatedAt = time.Now()
-}
-
 // Erros de domínio
 var (
 	ErrNomeObrigatorio     = NewDomainError("nome é obrigatório")
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Padrão antigo: três letras e quatro números (ABC1234)
+	regexPlacaAntiga = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	// Padrão Mercosul: três letras, número, letra e dois números (ABC1D23)
+	regexPlacaMercosul = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+// NormalizarPlaca coloca a placa em maiúsculas e remove hífen e espaços, de
+// forma que "abc-1234" e "ABC1234" sejam a mesma placa
+func NormalizarPlaca(placa string) string {
+	placa = strings.ToUpper(strings.TrimSpace(placa))
+	return strings.NewReplacer("-", "", " ", "").Replace(placa)
+}
+
+// PlacaValida indica se a placa, depois de normalizada, segue o padrão antigo
+// ou o Mercosul
+func PlacaValida(placa string) bool {
+	placa = NormalizarPlaca(placa)
+	return regexPlacaAntiga.MatchString(placa) || regexPlacaMercosul.MatchString(placa)
+}
+
+// PlacaMercosul converte a placa do padrão antigo para o Mercosul, trocando o
+// segundo número pela letra correspondente (0 = A, 1 = B, ..., 9 = J). Placas
+// que já estão no padrão Mercosul são devolvidas normalizadas.
+func PlacaMercosul(placa string) string {
+	placa = NormalizarPlaca(placa)
+	if !regexPlacaAntiga.MatchString(placa) {
+		return placa
+	}
+	return placa[:4] + string(rune('A'+placa[4]-'0')) + placa[5:]
+}
+
+// PlacaAntiga converte a placa Mercosul para o padrão antigo quando a letra
+// tem número correspondente (A a J). Do contrário, devolve a placa normalizada.
+func PlacaAntiga(placa string) string {
+	placa = NormalizarPlaca(placa)
+	if !regexPlacaMercosul.MatchString(placa) || placa[4] > 'J' {
+		return placa
+	}
+	return placa[:4] + string(rune('0'+placa[4]-'A')) + placa[5:]
+}
+
+// FormasPlaca retorna as grafias equivalentes da placa nos dois padrões, para
+// que o veículo seja encontrado por qualquer uma delas
+func FormasPlaca(placa string) []string {
+	mercosul, antiga := PlacaMercosul(placa), PlacaAntiga(placa)
+	if mercosul == antiga {
+		return []string{mercosul}
+	}
+	return []string{mercosul, antiga}
+}
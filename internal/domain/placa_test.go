@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlacaMercosul(t *testing.T) {
+	casos := map[string]string{
+		"ABC1234":  "ABC1C34",
+		"abc-1234": "ABC1C34",
+		"ABC1034":  "ABC1A34",
+		"ABC1934":  "ABC1J34",
+		"ABC1C34":  "ABC1C34",
+		"abc 1k34": "ABC1K34",
+		"AB12":     "AB12",
+	}
+	for placa, esperado := range casos {
+		if mercosul := PlacaMercosul(placa); mercosul != esperado {
+			t.Errorf("PlacaMercosul(%q) = %q, esperado %q", placa, mercosul, esperado)
+		}
+	}
+}
+
+func TestPlacaAntiga(t *testing.T) {
+	casos := map[string]string{
+		"ABC1C34":  "ABC1234",
+		"abc-1c34": "ABC1234",
+		"ABC1A34":  "ABC1034",
+		"ABC1J34":  "ABC1934",
+		"ABC1K34":  "ABC1K34",
+		"ABC-1234": "ABC1234",
+		"AB12":     "AB12",
+	}
+	for placa, esperado := range casos {
+		if antiga := PlacaAntiga(placa); antiga != esperado {
+			t.Errorf("PlacaAntiga(%q) = %q, esperado %q", placa, antiga, esperado)
+		}
+	}
+}
+
+func TestFormasPlaca(t *testing.T) {
+	casos := []struct {
+		placa  string
+		formas []string
+	}{
+		{"ABC-1234", []string{"ABC1C34", "ABC1234"}},
+		{"abc1c34", []string{"ABC1C34", "ABC1234"}},
+		{"ABC1J34", []string{"ABC1J34", "ABC1934"}},
+		{"ABC1K34", []string{"ABC1K34"}},
+		{"AB12", []string{"AB12"}},
+	}
+
+	for _, c := range casos {
+		if formas := FormasPlaca(c.placa); !slices.Equal(formas, c.formas) {
+			t.Errorf("FormasPlaca(%q) = %q, esperado %q", c.placa, formas, c.formas)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PoliticaCancelamento define o percentual de reembolso de uma viagem
+// cancelada conforme a antecedência em relação à data de início. Uma política
+// sem cliente é a política padrão da agência.
+type PoliticaCancelamento struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	ClienteID *uuid.UUID `json:"cliente_id,omitempty" gorm:"type:uuid;uniqueIndex"`
+	Nome      string     `json:"nome" gorm:"type:varchar(100);not null"`
+
+	Faixas []FaixaCancelamento `json:"faixas" gorm:"foreignKey:PoliticaID;constraint:OnDelete:CASCADE"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// FaixaCancelamento representa o reembolso aplicado quando o cancelamento
+// ocorre com pelo menos HorasAntecedencia horas antes do início da viagem
+type FaixaCancelamento struct {
+	ID                  uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	PoliticaID          uuid.UUID `json:"-" gorm:"type:uuid;not null;index"`
+	HorasAntecedencia   int       `json:"horas_antecedencia" gorm:"not null"`
+	PercentualReembolso float64   `json:"percentual_reembolso" gorm:"type:decimal(5,2);not null"`
+}
+
+// NewPoliticaCancelamento cria uma nova instância de PoliticaCancelamento
+func NewPoliticaCancelamento(clienteID *uuid.UUID, nome string, faixas []FaixaCancelamento) *PoliticaCancelamento {
+	p := &PoliticaCancelamento{
+		ID:        uuid.New(),
+		ClienteID: clienteID,
+		Nome:      nome,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	p.DefinirFaixas(faixas)
+	return p
+}
+
+// PoliticaCancelamentoPadrao retorna a política usada quando não há política
+// cadastrada: reembolso integral com mais de 7 dias de antecedência, 50% com
+// pelo menos 48 horas e nenhum reembolso abaixo disso
+func PoliticaCancelamentoPadrao() *PoliticaCancelamento {
+	return NewPoliticaCancelamento(nil, "Padrão", []FaixaCancelamento{
+		{HorasAntecedencia: 7 * 24, PercentualReembolso: 100},
+		{HorasAntecedencia: 48, PercentualReembolso: 50},
+		{HorasAntecedencia: 0, PercentualReembolso: 0},
+	})
+}
+
+// DefinirFaixas substitui as faixas da política
+func (p *PoliticaCancelamento) DefinirFaixas(faixas []FaixaCancelamento) {
+	p.Faixas = make([]FaixaCancelamento, len(faixas))
+	for i, f := range faixas {
+		f.ID = uuid.New()
+		f.PoliticaID = p.ID
+		p.Faixas[i] = f
+	}
+	p.UpdatedAt = time.Now()
+}
+
+// Validar verifica se a política é válida
+func (p *PoliticaCancelamento) Validar() error {
+	if p.Nome == "" {
+		return ErrNomeObrigatorio
+	}
+
+	if len(p.Faixas) == 0 {
+		return ErrFaixasCancelamentoObrigatorias
+	}
+
+	horas := make(map[int]bool)
+	for _, f := range p.Faixas {
+		if f.HorasAntecedencia < 0 || horas[f.HorasAntecedencia] {
+			return ErrFaixaCancelamentoInvalida
+		}
+		if f.PercentualReembolso < 0 || f.PercentualReembolso > 100 {
+			return ErrFaixaCancelamentoInvalida
+		}
+		horas[f.HorasAntecedencia] = true
+	}
+
+	return nil
+}
+
+// PercentualReembolso retorna o percentual de reembolso para um cancelamento
+// feito com a antecedência informada. Sem faixa aplicável não há reembolso.
+func (p *PoliticaCancelamento) PercentualReembolso(antecedencia time.Duration) float64 {
+	faixas := make([]FaixaCancelamento, len(p.Faixas))
+	copy(faixas, p.Faixas)
+	sort.Slice(faixas, func(i, j int) bool {
+		return faixas[i].HorasAntecedencia > faixas[j].HorasAntecedencia
+	})
+
+	for _, f := range faixas {
+		if antecedencia >= time.Duration(f.HorasAntecedencia)*time.Hour {
+			return f.PercentualReembolso
+		}
+	}
+	return 0
+}
+
+// CalcularCancelamento retorna a taxa retida e o valor a reembolsar para o
+// cancelamento de uma viagem de valor e início informados na data informada
+func (p *PoliticaCancelamento) CalcularCancelamento(valor float64, dataInicio, dataCancelamento time.Time) (taxa, reembolso float64) {
+	percentual := p.PercentualReembolso(dataInicio.Sub(dataCancelamento))
+	reembolso = math.Round(valor*percentual) / 100
+	taxa = math.Round((valor-reembolso)*100) / 100
+	return taxa, reembolso
+}
+
+// Erros de domínio
+var (
+	ErrFaixasCancelamentoObrigatorias = NewDomainError("a política deve ter ao menos uma faixa de cancelamento")
+	ErrFaixaCancelamentoInvalida      = NewDomainError("faixa de cancelamento inválida")
+)
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCalcularCancelamento(t *testing.T) {
+	inicio := time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC)
+	padrao := PoliticaCancelamentoPadrao()
+	semFaixaZero := NewPoliticaCancelamento(nil, "Flexível", []FaixaCancelamento{
+		{HorasAntecedencia: 24, PercentualReembolso: 80},
+		{HorasAntecedencia: 72, PercentualReembolso: 100},
+	})
+
+	casos := []struct {
+		nome         string
+		politica     *PoliticaCancelamento
+		valor        float64
+		antecedencia time.Duration
+		taxa         float64
+		reembolso    float64
+	}{
+		{"padrão com 10 dias", padrao, 1000, 10 * 24 * time.Hour, 0, 1000},
+		{"padrão com exatamente 7 dias", padrao, 1000, 7 * 24 * time.Hour, 0, 1000},
+		{"padrão um minuto antes dos 7 dias", padrao, 1000, 7*24*time.Hour - time.Minute, 500, 500},
+		{"padrão com exatamente 48 horas", padrao, 1000, 48 * time.Hour, 500, 500},
+		{"padrão com 47 horas", padrao, 1000, 47 * time.Hour, 1000, 0},
+		{"padrão depois do início", padrao, 1000, -time.Hour, 1000, 0},
+		{"arredonda centavos", padrao, 333.33, 3 * 24 * time.Hour, 166.66, 166.67},
+		{"faixas fora de ordem", semFaixaZero, 500, 48 * time.Hour, 100, 400},
+		{"faixas fora de ordem, maior faixa", semFaixaZero, 500, 100 * time.Hour, 0, 500},
+		{"abaixo da menor faixa", semFaixaZero, 500, 12 * time.Hour, 500, 0},
+		{"valor zero", padrao, 0, 10 * 24 * time.Hour, 0, 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			taxa, reembolso := c.politica.CalcularCancelamento(c.valor, inicio, inicio.Add(-c.antecedencia))
+			if taxa != c.taxa || reembolso != c.reembolso {
+				t.Errorf("CalcularCancelamento = (%.2f, %.2f), esperado (%.2f, %.2f)", taxa, reembolso, c.taxa, c.reembolso)
+			}
+			if math.Abs(taxa+reembolso-c.valor) > 0.001 {
+				t.Errorf("taxa + reembolso = %.2f, esperado %.2f", taxa+reembolso, c.valor)
+			}
+		})
+	}
+}
+
+func TestPoliticaCancelamentoValidar(t *testing.T) {
+	casos := []struct {
+		nome   string
+		faixas []FaixaCancelamento
+		erro   error
+	}{
+		{"válida", []FaixaCancelamento{{HorasAntecedencia: 0, PercentualReembolso: 0}}, nil},
+		{"sem faixas", nil, ErrFaixasCancelamentoObrigatorias},
+		{"antecedência negativa", []FaixaCancelamento{{HorasAntecedencia: -1, PercentualReembolso: 10}}, ErrFaixaCancelamentoInvalida},
+		{"antecedência repetida", []FaixaCancelamento{
+			{HorasAntecedencia: 24, PercentualReembolso: 10},
+			{HorasAntecedencia: 24, PercentualReembolso: 20},
+		}, ErrFaixaCancelamentoInvalida},
+		{"percentual acima de 100", []FaixaCancelamento{{HorasAntecedencia: 0, PercentualReembolso: 101}}, ErrFaixaCancelamentoInvalida},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			politica := NewPoliticaCancelamento(nil, "Teste", c.faixas)
+			if err := politica.Validar(); !errors.Is(err, c.erro) {
+				t.Errorf("Validar() = %v, esperado %v", err, c.erro)
+			}
+		})
+	}
+}
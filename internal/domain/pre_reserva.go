@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusPreReserva representa os possíveis status de uma pré-reserva
+type StatusPreReserva string
+
+const (
+	StatusPreReservaAtiva      StatusPreReserva = "ATIVA"
+	StatusPreReservaConfirmada StatusPreReserva = "CONFIRMADA"
+	StatusPreReservaExpirada   StatusPreReserva = "EXPIRADA"
+	StatusPreReservaLiberada   StatusPreReserva = "LIBERADA"
+)
+
+// PreReserva bloqueia um veículo e um motorista para um cliente até ExpiraEm,
+// enquanto o cliente decide. Pode ser confirmada em uma viagem agendada.
+type PreReserva struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	VeiculoID   uuid.UUID `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	MotoristaID uuid.UUID `json:"motorista_id" gorm:"type:uuid;not null;index"`
+	ClienteID   uuid.UUID `json:"cliente_id" gorm:"type:uuid;not null"`
+
+	Origem      string    `json:"origem" gorm:"not null"`
+	Destino     string    `json:"destino" gorm:"not null"`
+	DataInicio  time.Time `json:"data_inicio" gorm:"not null"`
+	DataFim     time.Time `json:"data_fim" gorm:"not null"`
+	Valor       float64   `json:"valor" gorm:"type:decimal(10,2);not null"`
+	Observacoes string    `json:"observacoes" gorm:"type:text"`
+
+	Status   StatusPreReserva `json:"status" gorm:"type:varchar(20);not null;default:'ATIVA';index"`
+	ExpiraEm time.Time        `json:"expira_em" gorm:"not null;index"`
+	ViagemID *uuid.UUID       `json:"viagem_id,omitempty" gorm:"type:uuid"`
+
+	// Vendedor responsável, avisado quando a pré-reserva expira
+	ResponsavelNome  string `json:"responsavel_nome" gorm:"type:varchar(100)"`
+	ResponsavelEmail string `json:"responsavel_email" gorm:"type:varchar(100);not null"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewPreReserva cria uma nova instância de PreReserva
+func NewPreReserva(veiculoID, motoristaID, clienteID uuid.UUID, origem, destino string,
+	dataInicio, dataFim time.Time, valor float64, expiraEm time.Time) *PreReserva {
+	return &PreReserva{
+		ID:          uuid.New(),
+		VeiculoID:   veiculoID,
+		MotoristaID: motoristaID,
+		ClienteID:   clienteID,
+		Origem:      origem,
+		Destino:     destino,
+		DataInicio:  dataInicio,
+		DataFim:     dataFim,
+		Valor:       valor,
+		Status:      StatusPreReservaAtiva,
+		ExpiraEm:    expiraEm,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validar verifica se a pré-reserva é válida
+func (p *PreReserva) Validar() error {
+	if p.DataInicio.After(p.DataFim) {
+		return ErrDataInicioMaiorQueFim
+	}
+
+	if p.Origem == "" || p.Destino == "" {
+		return ErrOrigemDestinoObrigatorios
+	}
+
+	if p.Valor <= 0 {
+		return ErrValorInvalido
+	}
+
+	// O prazo deve estar no futuro e terminar antes do início da viagem
+	if !p.ExpiraEm.After(time.Now()) || p.ExpiraEm.After(p.DataInicio) {
+		return ErrPrazoPreReservaInvalido
+	}
+
+	if p.ResponsavelEmail == "" {
+		return ErrResponsavelPreReservaObrigatorio
+	}
+
+	return nil
+}
+
+// Ativa indica se a pré-reserva ainda bloqueia o veículo e o motorista
+func (p *PreReserva) Ativa(agora time.Time) bool {
+	return p.Status == StatusPreReservaAtiva && agora.Before(p.ExpiraEm)
+}
+
+// Confirmar encerra a pré-reserva e retorna a viagem agendada correspondente
+func (p *PreReserva) Confirmar(agora time.Time) (*Viagem, error) {
+	if !p.Ativa(agora) {
+		return nil, ErrPreReservaInativa
+	}
+
+	viagem := NewViagem(p.VeiculoID, p.MotoristaID, p.ClienteID, p.Origem, p.Destino,
+		p.DataInicio, p.DataFim, p.Valor)
+	viagem.Observacoes = p.Observacoes
+
+	p.Status = StatusPreReservaConfirmada
+	p.ViagemID = &viagem.ID
+	p.UpdatedAt = agora
+	return viagem, nil
+}
+
+// Liberar desfaz a pré-reserva antes do prazo, liberando os recursos
+func (p *PreReserva) Liberar(agora time.Time) error {
+	if !p.Ativa(agora) {
+		return ErrPreReservaInativa
+	}
+
+	p.Status = StatusPreReservaLiberada
+	p.UpdatedAt = agora
+	return nil
+}
+
+// Expirar marca como expirada uma pré-reserva ativa cujo prazo já passou
+func (p *PreReserva) Expirar(agora time.Time) {
+	if p.Status != StatusPreReservaAtiva || agora.Before(p.ExpiraEm) {
+		return
+	}
+
+	p.Status = StatusPreReservaExpirada
+	p.UpdatedAt = agora
+}
+
+// Erros de domínio
+var (
+	ErrPrazoPreReservaInvalido          = NewDomainError("prazo da pré-reserva deve estar no futuro e não pode passar do início da viagem")
+	ErrResponsavelPreReservaObrigatorio = NewDomainError("e-mail do responsável pela pré-reserva é obrigatório")
+	ErrPreReservaInativa                = NewDomainError("pré-reserva já confirmada, liberada ou expirada")
+)
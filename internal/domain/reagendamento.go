@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Parâmetros da busca de alternativas no reagendamento
+const (
+	// HorizonteReagendamento é até onde, antes e depois do período pedido, se
+	// procuram horários livres para o veículo e o motorista originais
+	HorizonteReagendamento = 7 * 24 * time.Hour
+	// LimiteHorariosLivres é a quantidade de horários livres sugeridos
+	LimiteHorariosLivres = 3
+)
+
+// Periodo é um intervalo de tempo com início e fim
+type Periodo struct {
+	DataInicio time.Time `json:"data_inicio"`
+	DataFim    time.Time `json:"data_fim"`
+}
+
+// Sobrepoe indica se os dois períodos ocupam algum instante em comum
+func (p Periodo) Sobrepoe(outro Periodo) bool {
+	return p.DataInicio.Before(outro.DataFim) && p.DataFim.After(outro.DataInicio)
+}
+
+// ConflitoReagendamento explica por que a viagem não pode ser movida para o
+// novo período mantendo os recursos e reúne as alternativas encontradas
+type ConflitoReagendamento struct {
+	VeiculoOcupado   bool
+	MotoristaOcupado bool
+
+	// Viagens, pré-reservas e indisponibilidades programadas que ocupam o
+	// veículo ou o motorista no novo período
+	Viagens            []*Viagem
+	PreReservas        []*PreReserva
+	Indisponibilidades []*IndisponibilidadeVeiculo
+
+	// Recursos livres no novo período que podem substituir os ocupados
+	VeiculosAlternativos   []*Veiculo
+	MotoristasAlternativos []*Motorista
+
+	// Períodos próximos em que o veículo e o motorista originais estão livres
+	HorariosLivres []Periodo
+}
+
+func (c *ConflitoReagendamento) Error() string {
+	switch {
+	case c.VeiculoOcupado && c.MotoristaOcupado:
+		return "veículo e motorista indisponíveis para o novo período"
+	case c.VeiculoOcupado:
+		return "veículo indisponível para o novo período"
+	default:
+		return "motorista indisponível para o novo período"
+	}
+}
+
+// HorariosLivres procura os períodos com a mesma duração do desejado, mais
+// próximos dele, que não se sobrepõem aos ocupados. A busca fica dentro do
+// horizonte em torno do início desejado e não sugere períodos antes de agora.
+func HorariosLivres(desejado Periodo, ocupados []Periodo, agora time.Time, horizonte time.Duration, limite int) []Periodo {
+	duracao := desejado.DataFim.Sub(desejado.DataInicio)
+	minimo := desejado.DataInicio.Add(-horizonte)
+	if minimo.Before(agora) {
+		minimo = agora
+	}
+	maximo := desejado.DataInicio.Add(horizonte)
+
+	// Um horário livre mais próximo sempre começa logo após um período
+	// ocupado ou termina logo antes de um
+	candidatos := []time.Time{desejado.DataInicio, minimo}
+	for _, o := range ocupados {
+		candidatos = append(candidatos, o.DataFim, o.DataInicio.Add(-duracao))
+	}
+
+	distancia := func(t time.Time) time.Duration {
+		d := t.Sub(desejado.DataInicio)
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+	sort.Slice(candidatos, func(i, j int) bool {
+		return distancia(candidatos[i]) < distancia(candidatos[j])
+	})
+
+	var livres []Periodo
+	for _, inicio := range candidatos {
+		if len(livres) == limite {
+			break
+		}
+		if inicio.Before(minimo) || inicio.After(maximo) {
+			continue
+		}
+
+		periodo := Periodo{DataInicio: inicio, DataFim: inicio.Add(duracao)}
+		disponivel := true
+		for _, o := range ocupados {
+			if periodo.Sobrepoe(o) {
+				disponivel = false
+				break
+			}
+		}
+		for _, l := range livres {
+			if l.DataInicio.Equal(periodo.DataInicio) {
+				disponivel = false
+				break
+			}
+		}
+		if disponivel {
+			livres = append(livres, periodo)
+		}
+	}
+
+	return livres
+}
+
+// Erros de domínio
+var (
+	ErrViagemNaoReagendavel = NewDomainError("somente viagens agendadas podem ser reagendadas")
+)
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHorariosLivres(t *testing.T) {
+	dia := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	hora := func(h, m int) time.Time { return dia.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	periodo := func(inicio time.Time, duracao time.Duration) Periodo {
+		return Periodo{DataInicio: inicio, DataFim: inicio.Add(duracao)}
+	}
+
+	desejado := periodo(hora(10, 0), 2*time.Hour)
+	passado := dia.AddDate(0, -1, 0)
+
+	casos := []struct {
+		nome      string
+		ocupados  []Periodo
+		agora     time.Time
+		horizonte time.Duration
+		limite    int
+		inicios   []time.Time
+	}{
+		{
+			nome:      "período desejado livre",
+			agora:     passado,
+			horizonte: HorizonteReagendamento,
+			limite:    1,
+			inicios:   []time.Time{hora(10, 0)},
+		},
+		{
+			nome:      "mais próximos primeiro",
+			ocupados:  []Periodo{periodo(hora(9, 0), 3*time.Hour)},
+			agora:     passado,
+			horizonte: HorizonteReagendamento,
+			limite:    2,
+			inicios:   []time.Time{hora(12, 0), hora(7, 0)},
+		},
+		{
+			nome:      "encaixe entre ocupados sem repetir horário",
+			ocupados:  []Periodo{periodo(hora(8, 0), 150*time.Minute), periodo(hora(12, 30), 150*time.Minute)},
+			agora:     passado,
+			horizonte: HorizonteReagendamento,
+			limite:    2,
+			inicios:   []time.Time{hora(10, 30), hora(6, 0)},
+		},
+		{
+			nome:      "não sugere antes de agora",
+			ocupados:  []Periodo{periodo(hora(9, 0), 3*time.Hour)},
+			agora:     hora(9, 0),
+			horizonte: HorizonteReagendamento,
+			limite:    LimiteHorariosLivres,
+			inicios:   []time.Time{hora(12, 0)},
+		},
+		{
+			nome:      "nada livre dentro do horizonte",
+			ocupados:  []Periodo{periodo(hora(9, 0), 3*time.Hour)},
+			agora:     passado,
+			horizonte: time.Hour,
+			limite:    LimiteHorariosLivres,
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			var inicios []time.Time
+			for _, p := range HorariosLivres(desejado, c.ocupados, c.agora, c.horizonte, c.limite) {
+				if p.DataFim.Sub(p.DataInicio) != 2*time.Hour {
+					t.Errorf("período %v–%v com duração diferente da desejada", p.DataInicio, p.DataFim)
+				}
+				inicios = append(inicios, p.DataInicio)
+			}
+			if !slices.EqualFunc(inicios, c.inicios, time.Time.Equal) {
+				t.Errorf("inícios = %v, esperado %v", inicios, c.inicios)
+			}
+		})
+	}
+}
+
+func TestPeriodoSobrepoe(t *testing.T) {
+	inicio := time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)
+	p := Periodo{DataInicio: inicio, DataFim: inicio.Add(2 * time.Hour)}
+
+	casos := []struct {
+		nome   string
+		outro  Periodo
+		espera bool
+	}{
+		{"igual", p, true},
+		{"contido", Periodo{inicio.Add(30 * time.Minute), inicio.Add(time.Hour)}, true},
+		{"começa antes e termina durante", Periodo{inicio.Add(-time.Hour), inicio.Add(time.Hour)}, true},
+		{"termina no início", Periodo{inicio.Add(-time.Hour), inicio}, false},
+		{"começa no fim", Periodo{inicio.Add(2 * time.Hour), inicio.Add(3 * time.Hour)}, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if p.Sobrepoe(c.outro) != c.espera || c.outro.Sobrepoe(p) != c.espera {
+				t.Errorf("Sobrepoe = %v, esperado %v", !c.espera, c.espera)
+			}
+		})
+	}
+}
+
+func TestConflitoReagendamentoError(t *testing.T) {
+	casos := []struct {
+		nome     string
+		conflito ConflitoReagendamento
+		mensagem string
+	}{
+		{"veículo e motorista", ConflitoReagendamento{VeiculoOcupado: true, MotoristaOcupado: true}, "veículo e motorista indisponíveis para o novo período"},
+		{"somente veículo", ConflitoReagendamento{VeiculoOcupado: true}, "veículo indisponível para o novo período"},
+		{"somente motorista", ConflitoReagendamento{MotoristaOcupado: true}, "motorista indisponível para o novo período"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			var err error = &c.conflito
+			if err.Error() != c.mensagem {
+				t.Errorf("Error() = %q, esperado %q", err.Error(), c.mensagem)
+			}
+		})
+	}
+}
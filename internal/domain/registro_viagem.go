@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TipoRegistroViagem indica se o registro foi feito na saída ou na chegada
+type TipoRegistroViagem string
+
+const (
+	RegistroCheckIn  TipoRegistroViagem = "CHECK_IN"
+	RegistroCheckOut TipoRegistroViagem = "CHECK_OUT"
+)
+
+// RegistroViagem guarda as leituras feitas pelo motorista ao iniciar ou
+// encerrar uma viagem
+type RegistroViagem struct {
+	ID          uuid.UUID          `json:"id" gorm:"type:uuid;primary_key"`
+	ViagemID    uuid.UUID          `json:"viagem_id" gorm:"type:uuid;not null;index"`
+	VeiculoID   uuid.UUID          `json:"veiculo_id" gorm:"type:uuid;not null;index"`
+	MotoristaID uuid.UUID          `json:"motorista_id" gorm:"type:uuid;not null"`
+	Tipo        TipoRegistroViagem `json:"tipo" gorm:"type:varchar(10);not null"`
+
+	Odometro         int       `json:"odometro" gorm:"not null"`
+	NivelCombustivel int       `json:"nivel_combustivel" gorm:"not null"` // percentual do tanque
+	RegistradoEm     time.Time `json:"registrado_em" gorm:"not null"`
+	Fotos            []string  `json:"fotos,omitempty" gorm:"type:jsonb;serializer:json"`
+	Observacoes      string    `json:"observacoes" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+// LeituraViagem reúne os dados informados pelo motorista no check-in ou no check-out
+type LeituraViagem struct {
+	Odometro         int
+	NivelCombustivel int
+	RegistradoEm     time.Time
+	Fotos            []string
+	Observacoes      string
+}
+
+// NewRegistroViagem cria uma nova instância de RegistroViagem
+func NewRegistroViagem(viagem *Viagem, tipo TipoRegistroViagem, leitura LeituraViagem) *RegistroViagem {
+	return &RegistroViagem{
+		ID:               uuid.New(),
+		ViagemID:         viagem.ID,
+		VeiculoID:        viagem.VeiculoID,
+		MotoristaID:      viagem.MotoristaID,
+		Tipo:             tipo,
+		Odometro:         leitura.Odometro,
+		NivelCombustivel: leitura.NivelCombustivel,
+		RegistradoEm:     leitura.RegistradoEm,
+		Fotos:            leitura.Fotos,
+		Observacoes:      leitura.Observacoes,
+		CreatedAt:        time.Now(),
+	}
+}
+
+// Validar verifica se o registro é válido
+func (r *RegistroViagem) Validar() error {
+	if r.Odometro < 0 {
+		return ErrOdometroInvalido
+	}
+
+	if r.NivelCombustivel < 0 || r.NivelCombustivel > 100 {
+		return ErrNivelCombustivelInvalido
+	}
+
+	if r.RegistradoEm.IsZero() || r.RegistradoEm.After(time.Now()) {
+		return ErrHorarioRegistroInvalido
+	}
+
+	return nil
+}
+
+// UltimoRegistro retorna o último registro do tipo informado, ou nil se a
+// viagem não tiver nenhum. Os registros devem estar em ordem cronológica.
+func UltimoRegistro(registros []*RegistroViagem, tipo TipoRegistroViagem) *RegistroViagem {
+	var ultimo *RegistroViagem
+	for _, r := range registros {
+		if r.Tipo == tipo {
+			ultimo = r
+		}
+	}
+	return ultimo
+}
+
+// Erros de domínio
+var (
+	ErrOdometroInvalido         = NewDomainError("leitura do odômetro inválida")
+	ErrNivelCombustivelInvalido = NewDomainError("nível de combustível deve estar entre 0 e 100%")
+	ErrHorarioRegistroInvalido  = NewDomainError("horário do registro não pode estar no futuro")
+	ErrOdometroRegressivo       = NewDomainError("leitura do odômetro menor que a última registrada para o veículo")
+)
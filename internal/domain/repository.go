@@ -14,10 +14,14 @@ type ViagemRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Viagem, error)
 	List(ctx context.Context, offset, limit int) ([]*Viagem, error)
+	Search(ctx context.Context, filtro FiltroViagem) ([]*Viagem, int64, error)
 	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*Viagem, error)
 	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*Viagem, error)
 	GetByCliente(ctx context.Context, clienteID uuid.UUID) ([]*Viagem, error)
-	CheckDisponibilidade(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) (bool, error)
+	CheckDisponibilidade(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time, excluirViagemID uuid.UUID) (bool, error)
+	GetAtivasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*Viagem, error)
+	GetEncerradasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*Viagem, error)
+	GetConcluidasSemLancamentoHoras(ctx context.Context) ([]*Viagem, error)
 }
 
 // VeiculoRepository define as operações do repositório de veículos
@@ -27,10 +31,12 @@ type VeiculoRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Veiculo, error)
 	List(ctx context.Context, offset, limit int) ([]*Veiculo, error)
+	Search(ctx context.Context, filtro FiltroVeiculo) ([]*Veiculo, error)
 	GetByPlaca(ctx context.Context, placa string) (*Veiculo, error)
 	GetByStatus(ctx context.Context, status StatusVeiculo) ([]*Veiculo, error)
 	GetByTipo(ctx context.Context, tipo TipoVeiculo) ([]*Veiculo, error)
-	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*Veiculo, error)
+	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time, comodidades []string) ([]*Veiculo, error)
+	Bloquear(ctx context.Context, id uuid.UUID) error
 	GetVeiculosProximaManutencao(ctx context.Context) ([]*Veiculo, error)
 	GetVeiculosDocumentacaoVencida(ctx context.Context) ([]*Veiculo, error)
 }
@@ -45,7 +51,8 @@ type MotoristaRepository interface {
 	GetByCPF(ctx context.Context, cpf string) (*Motorista, error)
 	GetByCNH(ctx context.Context, cnh string) (*Motorista, error)
 	GetByStatus(ctx context.Context, status StatusMotorista) ([]*Motorista, error)
-	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*Motorista, error)
+	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time, categoriaMinima TipoCNH) ([]*Motorista, error)
+	Bloquear(ctx context.Context, id uuid.UUID) error
 	GetMotoristasCNHVencida(ctx context.Context) ([]*Motorista, error)
 	GetMotoristasProximosVencimentoCNH(ctx context.Context) ([]*Motorista, error)
 	GetMotoristasBancoHorasExcedido(ctx context.Context, limiteHoras int) ([]*Motorista, error)
@@ -66,3 +73,179 @@ type ClienteRepository interface {
 	GetClientesPorTipo(ctx context.Context) (map[TipoCliente]int, error)
 	GetClientesLimiteCreditoExcedido(ctx context.Context, limite float64) ([]*Cliente, error)
 }
+
+// GrupoViagemRepository define as operações do repositório de grupos de viagem
+type GrupoViagemRepository interface {
+	Create(ctx context.Context, grupo *GrupoViagem) error
+	Update(ctx context.Context, grupo *GrupoViagem) error
+	GetByID(ctx context.Context, id uuid.UUID) (*GrupoViagem, error)
+	List(ctx context.Context, offset, limit int) ([]*GrupoViagem, error)
+}
+
+// PoliticaCancelamentoRepository define as operações do repositório de políticas de cancelamento
+type PoliticaCancelamentoRepository interface {
+	Create(ctx context.Context, politica *PoliticaCancelamento) error
+	Update(ctx context.Context, politica *PoliticaCancelamento) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*PoliticaCancelamento, error)
+	List(ctx context.Context) ([]*PoliticaCancelamento, error)
+	GetAplicavel(ctx context.Context, clienteID uuid.UUID) (*PoliticaCancelamento, error)
+}
+
+// TabelaPrecoRepository define as operações do repositório de tabelas de preço
+type TabelaPrecoRepository interface {
+	Create(ctx context.Context, tabela *TabelaPreco) error
+	Update(ctx context.Context, tabela *TabelaPreco) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*TabelaPreco, error)
+	List(ctx context.Context) ([]*TabelaPreco, error)
+	GetAplicavel(ctx context.Context, clienteID uuid.UUID, tipo TipoVeiculo) (*TabelaPreco, error)
+}
+
+// FeriadoRepository define as operações do repositório de feriados
+type FeriadoRepository interface {
+	Create(ctx context.Context, feriado *Feriado) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Feriado, error)
+	List(ctx context.Context) ([]*Feriado, error)
+	GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]Feriado, error)
+}
+
+// CotacaoRepository define as operações do repositório de cotações
+type CotacaoRepository interface {
+	Create(ctx context.Context, cotacao *Cotacao) error
+	Update(ctx context.Context, cotacao *Cotacao) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Cotacao, error)
+	Converter(ctx context.Context, cotacao *Cotacao) error
+}
+
+// PreReservaRepository define as operações do repositório de pré-reservas
+type PreReservaRepository interface {
+	Create(ctx context.Context, reserva *PreReserva) error
+	GetByID(ctx context.Context, id uuid.UUID) (*PreReserva, error)
+	List(ctx context.Context, offset, limit int) ([]*PreReserva, error)
+	Encerrar(ctx context.Context, reserva *PreReserva) error
+	GetVencidas(ctx context.Context, agora time.Time) ([]*PreReserva, error)
+	CheckMotoristaReservado(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) (bool, error)
+	GetAtivasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*PreReserva, error)
+}
+
+// EventoViagemRepository define as operações do repositório de eventos de viagem
+type EventoViagemRepository interface {
+	Create(ctx context.Context, eventos ...*EventoViagem) error
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*EventoViagem, error)
+}
+
+// RegistroViagemRepository define as operações do repositório de registros de viagem
+type RegistroViagemRepository interface {
+	Create(ctx context.Context, registro *RegistroViagem) error
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*RegistroViagem, error)
+}
+
+// DespesaViagemRepository define as operações do repositório de despesas de viagem
+type DespesaViagemRepository interface {
+	Create(ctx context.Context, despesa *DespesaViagem) error
+	Update(ctx context.Context, despesa *DespesaViagem) error
+	GetByID(ctx context.Context, id uuid.UUID) (*DespesaViagem, error)
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*DespesaViagem, error)
+	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*DespesaViagem, error)
+	GetByViagens(ctx context.Context, viagemIDs []uuid.UUID) ([]*DespesaViagem, error)
+	Avaliar(ctx context.Context, despesa *DespesaViagem, statusAnterior StatusDespesa) error
+	ComprovanteEmUso(ctx context.Context, anexoID uuid.UUID) (bool, error)
+}
+
+// OrdemManutencaoRepository define as operações do repositório de ordens de manutenção
+type OrdemManutencaoRepository interface {
+	Create(ctx context.Context, ordem *OrdemManutencao) error
+	Update(ctx context.Context, ordem *OrdemManutencao) error
+	GetByID(ctx context.Context, id uuid.UUID) (*OrdemManutencao, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*OrdemManutencao, error)
+	CountEmAndamento(ctx context.Context, veiculoID uuid.UUID) (int64, error)
+	GetConcluidasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*OrdemManutencao, error)
+}
+
+// PlanoManutencaoRepository define as operações do repositório de planos de manutenção
+type PlanoManutencaoRepository interface {
+	Create(ctx context.Context, plano *PlanoManutencao) error
+	Update(ctx context.Context, plano *PlanoManutencao) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*PlanoManutencao, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*PlanoManutencao, error)
+}
+
+// DocumentoVeiculoRepository define as operações do repositório de documentos de veículos
+type DocumentoVeiculoRepository interface {
+	Create(ctx context.Context, documento *DocumentoVeiculo) error
+	Update(ctx context.Context, documento *DocumentoVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*DocumentoVeiculo, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*DocumentoVeiculo, error)
+}
+
+// IndisponibilidadeVeiculoRepository define as operações do repositório de indisponibilidades de veículos
+type IndisponibilidadeVeiculoRepository interface {
+	Create(ctx context.Context, indisponibilidade *IndisponibilidadeVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*IndisponibilidadeVeiculo, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*IndisponibilidadeVeiculo, error)
+}
+
+// AbastecimentoRepository define as operações do repositório de abastecimentos
+type AbastecimentoRepository interface {
+	Create(ctx context.Context, abastecimento *Abastecimento) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Abastecimento, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*Abastecimento, error)
+	GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*Abastecimento, error)
+	ExisteLancamento(ctx context.Context, veiculoID uuid.UUID, data time.Time, odometro int) (bool, error)
+}
+
+// ComodidadeRepository define as operações do repositório de comodidades
+type ComodidadeRepository interface {
+	Create(ctx context.Context, comodidade *Comodidade) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Comodidade, error)
+	List(ctx context.Context) ([]*Comodidade, error)
+	GetByCodigos(ctx context.Context, codigos []string) ([]*Comodidade, error)
+	EmUso(ctx context.Context, codigo string) (bool, error)
+}
+
+// CustoFixoVeiculoRepository define as operações do repositório de custos fixos de veículos
+type CustoFixoVeiculoRepository interface {
+	Create(ctx context.Context, custo *CustoFixoVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*CustoFixoVeiculo, error)
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*CustoFixoVeiculo, error)
+	GetVigentes(ctx context.Context, dataInicio, dataFim time.Time) ([]*CustoFixoVeiculo, error)
+}
+
+// AnexoRepository define as operações do repositório de anexos
+type AnexoRepository interface {
+	Create(ctx context.Context, anexo *Anexo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Anexo, error)
+	GetByEntidade(ctx context.Context, entidade EntidadeAnexo, entidadeID uuid.UUID) ([]*Anexo, error)
+}
+
+// ModeloChecklistRepository define as operações do repositório de modelos de checklist
+type ModeloChecklistRepository interface {
+	Create(ctx context.Context, modelo *ModeloChecklist) error
+	Update(ctx context.Context, modelo *ModeloChecklist) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*ModeloChecklist, error)
+	GetByTipoVeiculo(ctx context.Context, tipo TipoVeiculo) (*ModeloChecklist, error)
+}
+
+// InspecaoVeiculoRepository define as operações do repositório de inspeções de veículos
+type InspecaoVeiculoRepository interface {
+	Create(ctx context.Context, inspecao *InspecaoVeiculo) error
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*InspecaoVeiculo, error)
+}
+
+// LancamentoHorasRepository define as operações do repositório de lançamentos do banco de horas
+type LancamentoHorasRepository interface {
+	Create(ctx context.Context, lancamento *LancamentoHoras) error
+	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*LancamentoHoras, error)
+	GetViagensByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*LancamentoHoras, error)
+	GetSaldo(ctx context.Context, motoristaID uuid.UUID, ate time.Time) (int, error)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TabelaPreco contém os valores usados no cálculo de cotações para um tipo de
+// veículo. Uma tabela sem cliente é a tabela padrão da agência.
+type TabelaPreco struct {
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	ClienteID   *uuid.UUID  `json:"cliente_id,omitempty" gorm:"type:uuid;uniqueIndex:idx_tabela_preco_cliente_tipo"`
+	TipoVeiculo TipoVeiculo `json:"tipo_veiculo" gorm:"type:varchar(20);not null;uniqueIndex:idx_tabela_preco_cliente_tipo"`
+
+	ValorKm         float64 `json:"valor_km" gorm:"type:decimal(10,2);not null"`
+	ValorHora       float64 `json:"valor_hora" gorm:"type:decimal(10,2);not null"`
+	ValorHoraEspera float64 `json:"valor_hora_espera" gorm:"type:decimal(10,2);not null"`
+	ValorDiaria     float64 `json:"valor_diaria" gorm:"type:decimal(10,2);not null"`
+	ValorMinimo     float64 `json:"valor_minimo" gorm:"type:decimal(10,2);default:0"`
+
+	// Adicionais percentuais sobre o valor da hora
+	PercentualNoturno float64 `json:"percentual_noturno" gorm:"type:decimal(5,2);default:0"`
+	PercentualFeriado float64 `json:"percentual_feriado" gorm:"type:decimal(5,2);default:0"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// NewTabelaPreco cria uma nova instância de TabelaPreco
+func NewTabelaPreco(clienteID *uuid.UUID, tipo TipoVeiculo, valorKm, valorHora,
+	valorHoraEspera, valorDiaria float64) *TabelaPreco {
+	return &TabelaPreco{
+		ID:              uuid.New(),
+		ClienteID:       clienteID,
+		TipoVeiculo:     tipo,
+		ValorKm:         valorKm,
+		ValorHora:       valorHora,
+		ValorHoraEspera: valorHoraEspera,
+		ValorDiaria:     valorDiaria,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+// Validar verifica se a tabela de preços é válida
+func (t *TabelaPreco) Validar() error {
+	if t.TipoVeiculo == "" {
+		return ErrTipoVeiculoObrigatorio
+	}
+
+	if t.ValorKm < 0 || t.ValorHora < 0 || t.ValorHoraEspera < 0 || t.ValorDiaria < 0 || t.ValorMinimo < 0 {
+		return ErrValorTabelaInvalido
+	}
+
+	if t.PercentualNoturno < 0 || t.PercentualFeriado < 0 {
+		return ErrValorTabelaInvalido
+	}
+
+	return nil
+}
+
+// Feriado representa uma data em que se aplica o adicional de feriado
+type Feriado struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	Data      time.Time `json:"data" gorm:"type:date;uniqueIndex;not null"`
+	Descricao string    `json:"descricao" gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+// NewFeriado cria uma nova instância de Feriado
+func NewFeriado(data time.Time, descricao string) *Feriado {
+	return &Feriado{
+		ID:        uuid.New(),
+		Data:      time.Date(data.Year(), data.Month(), data.Day(), 0, 0, 0, 0, time.UTC),
+		Descricao: descricao,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Validar verifica se o feriado é válido
+func (f *Feriado) Validar() error {
+	if f.Data.IsZero() {
+		return ErrDataFeriadoObrigatoria
+	}
+
+	if f.Descricao == "" {
+		return ErrDescricaoFeriadoObrigatoria
+	}
+
+	return nil
+}
+
+// Erros de domínio
+var (
+	ErrTipoVeiculoObrigatorio = NewDomainError("tipo de veículo é obrigatório")
+	ErrValorTabelaInvalido    = NewDomainError("valores da tabela de preços não podem ser negativos")
+
+	ErrDataFeriadoObrigatoria      = NewDomainError("data do feriado é obrigatória")
+	ErrDescricaoFeriadoObrigatoria = NewDomainError("descrição do feriado é obrigatória")
+)
@@ -42,13 +42,20 @@ type Veiculo struct {
 	Cor         string `json:"cor" gorm:"type:varchar(50)"`
 	Observacoes string `json:"observacoes" gorm:"type:text"`
 
-	// Documentação
-	DocumentacaoValida     bool      `json:"documentacao_valida" gorm:"not null;default:true"`
+	// Documentação, derivada dos documentos do veículo a cada alteração
+	DocumentacaoValida     bool      `json:"documentacao_valida" gorm:"not null;default:false"`
 	VencimentoDocumentacao time.Time `json:"vencimento_documentacao" gorm:"not null"`
 
-	// Manutenção
-	UltimaManutencao  time.Time `json:"ultima_manutencao"`
-	ProximaManutencao time.Time `json:"proxima_manutencao"`
+	// Manutenção: próximo vencimento dos planos por tempo e por quilometragem
+	UltimaManutencao    time.Time `json:"ultima_manutencao"`
+	ProximaManutencao   time.Time `json:"proxima_manutencao"`
+	ProximaManutencaoKm int       `json:"proxima_manutencao_km" gorm:"not null;default:0"`
+
+	// Última leitura do odômetro, atualizada nos registros de viagem
+	OdometroAtual int `json:"odometro_atual" gorm:"not null;default:0"`
+
+	// Códigos das comodidades do catálogo oferecidas pelo veículo
+	Comodidades []string `json:"comodidades" gorm:"type:jsonb;serializer:json;not null;default:'[]'"`
 
 	CreatedAt time.Time `json:"created_at" gorm:"not null"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
@@ -58,20 +65,18 @@ type Veiculo struct {
 func NewVeiculo(placa, modelo, marca string, ano int, tipo TipoVeiculo,
 	capacidade int, chassi, renavam string) *Veiculo {
 	return &Veiculo{
-		ID:                     uuid.New(),
-		Placa:                  placa,
-		Modelo:                 modelo,
-		Marca:                  marca,
-		Ano:                    ano,
-		Tipo:                   tipo,
-		Capacidade:             capacidade,
-		Status:                 StatusDisponivel,
-		Chassi:                 chassi,
-		Renavam:                renavam,
-		DocumentacaoValida:     true,
-		VencimentoDocumentacao: time.Now().AddDate(1, 0, 0), // 1 ano
-		CreatedAt:              time.Now(),
-		UpdatedAt:              time.Now(),
+		ID:         uuid.New(),
+		Placa:      NormalizarPlaca(placa),
+		Modelo:     modelo,
+		Marca:      marca,
+		Ano:        ano,
+		Tipo:       tipo,
+		Capacidade: capacidade,
+		Status:     StatusDisponivel,
+		Chassi:     NormalizarChassi(chassi),
+		Renavam:    NormalizarRenavam(renavam),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
 	}
 }
 
@@ -81,11 +86,16 @@ func (v *Veiculo) Validar() error {
 		return ErrPlacaObrigatoria
 	}
 
+	if !PlacaValida(v.Placa) {
+		return ErrPlacaInvalida
+	}
+
 	if v.Modelo == "" || v.Marca == "" {
 		return ErrModeloMarcaObrigatorios
 	}
 
-	if v.Ano < 1900 || v.Ano > time.Now().Year() {
+	// Aceita o ano-modelo, que pode ser o ano seguinte
+	if v.Ano < 1900 || v.Ano > time.Now().Year()+1 {
 		return ErrAnoInvalido
 	}
 
@@ -100,6 +110,28 @@ func (v *Veiculo) Validar() error {
 	return nil
 }
 
+// ValidarIdentificacao confere o dígito verificador do RENAVAM e o chassi.
+// Com anterior, apenas o que mudou é conferido, para que veículos cadastrados
+// antes da validação continuem editáveis.
+func (v *Veiculo) ValidarIdentificacao(anterior *Veiculo) error {
+	if anterior == nil || v.Renavam != anterior.Renavam {
+		if err := ValidarRenavam(v.Renavam); err != nil {
+			return err
+		}
+	}
+
+	// Confere o chassi e se o fabricante e o ano-modelo batem com o cadastro
+	if anterior == nil || v.Chassi != anterior.Chassi || v.Marca != anterior.Marca || v.Ano != anterior.Ano {
+		return ConferirChassi(v.Chassi, v.Marca, v.Ano)
+	}
+	return nil
+}
+
+// ComodidadesFaltantes retorna as comodidades exigidas que o veículo não oferece
+func (v *Veiculo) ComodidadesFaltantes(exigidas []string) []string {
+	return ComodidadesFaltantes(v.Comodidades, exigidas)
+}
+
 // AtualizarStatus atualiza o status do veículo
 func (v *Veiculo) AtualizarStatus(status StatusVeiculo) {
 	v.Status = status
@@ -113,19 +145,78 @@ func (v *Veiculo) AtualizarDocumentacao(valida bool, vencimento time.Time) {
 	v.UpdatedAt = time.Now()
 }
 
-// RegistrarManutencao registra uma manutenção realizada
-func (v *Veiculo) RegistrarManutencao() {
-	v.UltimaManutencao = time.Now()
-	v.ProximaManutencao = time.Now().AddDate(0, 6, 0) // 6 meses
+// DocumentacaoEmDia indica se a documentação, válida na última alteração dos
+// documentos, ainda não chegou ao primeiro vencimento
+func (v *Veiculo) DocumentacaoEmDia(agora time.Time) bool {
+	return v.DocumentacaoValida && !agora.After(v.VencimentoDocumentacao)
+}
+
+// RegistrarManutencao registra uma manutenção realizada e o próximo
+// vencimento calculado pelos planos de manutenção
+func (v *Veiculo) RegistrarManutencao(realizadaEm, proximaData time.Time, proximoOdometro int) {
+	v.UltimaManutencao = realizadaEm
+	v.AgendarManutencao(proximaData, proximoOdometro)
+}
+
+// AgendarManutencao atualiza o próximo vencimento de manutenção
+func (v *Veiculo) AgendarManutencao(proximaData time.Time, proximoOdometro int) {
+	v.ProximaManutencao = proximaData
+	v.ProximaManutencaoKm = proximoOdometro
+	v.UpdatedAt = time.Now()
+}
+
+// LiberarManutencao devolve à operação o veículo que estava em manutenção
+func (v *Veiculo) LiberarManutencao() {
+	if v.Status != StatusManutencao {
+		return
+	}
 	v.Status = StatusDisponivel
 	v.UpdatedAt = time.Now()
 }
 
+// IniciarViagem registra a saída do veículo em viagem
+func (v *Veiculo) IniciarViagem(odometro int) error {
+	if v.Status == StatusManutencao {
+		return ErrVeiculoEmManutencao
+	}
+	if err := v.AtualizarOdometro(odometro); err != nil {
+		return err
+	}
+	v.Status = StatusEmUso
+	return nil
+}
+
+// EncerrarViagem registra a chegada do veículo. Só volta a ficar disponível
+// se ainda estiver em uso, preservando um status de manutenção definido
+// durante a viagem.
+func (v *Veiculo) EncerrarViagem(odometro int) error {
+	if err := v.AtualizarOdometro(odometro); err != nil {
+		return err
+	}
+	if v.Status == StatusEmUso {
+		v.Status = StatusDisponivel
+	}
+	return nil
+}
+
+// AtualizarOdometro registra uma nova leitura, que não pode ser menor que a atual
+func (v *Veiculo) AtualizarOdometro(odometro int) error {
+	if odometro < v.OdometroAtual {
+		return ErrOdometroRegressivo
+	}
+	v.OdometroAtual = odometro
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
 // Erros de domínio
 var (
 	ErrPlacaObrigatoria          = NewDomainError("placa é obrigatória")
+	ErrPlacaInvalida             = NewDomainError("placa deve seguir o padrão antigo (ABC1234) ou Mercosul (ABC1D23)")
+	ErrPlacaAmbigua              = NewDomainError("placa corresponde a mais de um veículo nos padrões antigo e Mercosul")
 	ErrModeloMarcaObrigatorios   = NewDomainError("modelo e marca são obrigatórios")
 	ErrAnoInvalido               = NewDomainError("ano inválido")
 	ErrCapacidadeInvalida        = NewDomainError("capacidade deve ser maior que zero")
 	ErrChassiRenavamObrigatorios = NewDomainError("chassi e renavam são obrigatórios")
+	ErrVeiculoEmManutencao       = NewDomainError("veículo em manutenção")
 )
@@ -16,22 +16,52 @@ const (
 	StatusCancelada  StatusViagem = "CANCELADA"
 )
 
+// LimiteDirecaoSemRevezamentoPadrao é a direção prevista a partir da qual a
+// viagem exige motorista secundário, quando não configurado outro limite
+const LimiteDirecaoSemRevezamentoPadrao = 10 * time.Hour
+
 // Viagem representa uma viagem no sistema
 type Viagem struct {
 	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
 	VeiculoID   uuid.UUID   `json:"veiculo_id" gorm:"type:uuid;not null"`
 	MotoristaID uuid.UUID   `json:"motorista_id" gorm:"type:uuid;not null"`
 	ClienteID   uuid.UUID   `json:"cliente_id" gorm:"type:uuid;not null"`
+	GrupoID     *uuid.UUID  `json:"grupo_id,omitempty" gorm:"type:uuid;index"`
+	CotacaoID   *uuid.UUID  `json:"cotacao_id,omitempty" gorm:"type:uuid"`
 	
 	Origem      string      `json:"origem" gorm:"not null"`
 	Destino     string      `json:"destino" gorm:"not null"`
-	DataInicio  time.Time   `json:"data_inicio" gorm:"not null"`
+	DataInicio  time.Time   `json:"data_inicio" gorm:"not null;index"`
 	DataFim     time.Time   `json:"data_fim" gorm:"not null"`
 	
 	Status      StatusViagem `json:"status" gorm:"type:varchar(20);not null;default:'AGENDADA'"`
 	Valor       float64     `json:"valor" gorm:"type:decimal(10,2);not null"`
+	QuantidadePassageiros int `json:"quantidade_passageiros" gorm:"not null;default:0"`
 	Observacoes string      `json:"observacoes" gorm:"type:text"`
 	
+	// Códigos das comodidades que o veículo da viagem deve oferecer
+	ComodidadesExigidas []string `json:"comodidades_exigidas,omitempty" gorm:"type:jsonb;serializer:json"`
+	
+	// Justificativa do ADMIN para agendar a viagem fora das regras de jornada
+	JustificativaJornada string `json:"justificativa_jornada,omitempty" gorm:"type:text"`
+	
+	// Revezamento: segundo motorista que alterna a direção em viagens longas.
+	// Sem direção prevista informada, considera-se todo o período da viagem.
+	MotoristaSecundarioID  *uuid.UUID `json:"motorista_secundario_id,omitempty" gorm:"type:uuid;index"`
+	DirecaoPrevistaMinutos int        `json:"direcao_prevista_minutos" gorm:"not null;default:0"`
+	
+	// Execução, registrada no check-in e no check-out do motorista
+	InicioReal    *time.Time `json:"inicio_real,omitempty"`
+	FimReal       *time.Time `json:"fim_real,omitempty"`
+	KmPercorridos int        `json:"km_percorridos" gorm:"not null;default:0"`
+	
+	// Cancelamento
+	MotivoCancelamento     string     `json:"motivo_cancelamento,omitempty" gorm:"type:text"`
+	TaxaCancelamento       float64    `json:"taxa_cancelamento" gorm:"type:decimal(10,2);default:0"`
+	ValorReembolso         float64    `json:"valor_reembolso" gorm:"type:decimal(10,2);default:0"`
+	CanceladaEm            *time.Time `json:"cancelada_em,omitempty"`
+	PoliticaCancelamentoID *uuid.UUID `json:"politica_cancelamento_id,omitempty" gorm:"type:uuid"`
+	
 	// Coordenadas da rota
 	CoordenadasOrigem  string `json:"coordenadas_origem" gorm:"type:varchar(100)"`
 	CoordenadasDestino string `json:"coordenadas_destino" gorm:"type:varchar(100)"`
@@ -40,8 +70,12 @@ type Viagem struct {
 	// Relacionamentos
 	Veiculo    *Veiculo    `json:"veiculo,omitempty" gorm:"foreignKey:VeiculoID"`
 	Motorista  *Motorista  `json:"motorista,omitempty" gorm:"foreignKey:MotoristaID"`
+	MotoristaSecundario *Motorista `json:"motorista_secundario,omitempty" gorm:"foreignKey:MotoristaSecundarioID"`
 	Cliente    *Cliente    `json:"cliente,omitempty" gorm:"foreignKey:ClienteID"`
 	
+	// Vencimentos próximos apontados na verificação de conformidade, não persistidos
+	Avisos []PendenciaConformidade `json:"avisos,omitempty" gorm:"-"`
+	
 	CreatedAt  time.Time   `json:"created_at" gorm:"not null"`
 	UpdatedAt  time.Time   `json:"updated_at" gorm:"not null"`
 }
@@ -86,12 +120,155 @@ func (v *Viagem) Validar() error {
 	return nil
 }
 
+// Editada retorna uma cópia da viagem com os dados editáveis da edição: veículo,
+// motoristas, trajeto, período, valor, passageiros, direção prevista,
+// comodidades exigidas, justificativa de jornada e observações. Status,
+// cliente, grupo, cotação, execução e cancelamento permanecem os da viagem.
+func (v *Viagem) Editada(edicao *Viagem) *Viagem {
+	editada := *v
+	editada.VeiculoID = edicao.VeiculoID
+	editada.MotoristaID = edicao.MotoristaID
+	editada.MotoristaSecundarioID = edicao.MotoristaSecundarioID
+	editada.Origem = edicao.Origem
+	editada.Destino = edicao.Destino
+	editada.DataInicio = edicao.DataInicio
+	editada.DataFim = edicao.DataFim
+	editada.Valor = edicao.Valor
+	editada.QuantidadePassageiros = edicao.QuantidadePassageiros
+	editada.DirecaoPrevistaMinutos = edicao.DirecaoPrevistaMinutos
+	editada.ComodidadesExigidas = edicao.ComodidadesExigidas
+	editada.JustificativaJornada = edicao.JustificativaJornada
+	editada.Observacoes = edicao.Observacoes
+	editada.UpdatedAt = time.Now()
+	return &editada
+}
+
 // AtualizarStatus atualiza o status da viagem
 func (v *Viagem) AtualizarStatus(status StatusViagem) {
 	v.Status = status
 	v.UpdatedAt = time.Now()
 }
 
+// Cancelar cancela a viagem registrando o motivo e os valores de taxa e
+// reembolso calculados pela política de cancelamento. Só a viagem agendada
+// pode ser cancelada: a que está em andamento é encerrada pelo check-out,
+// que libera o veículo.
+func (v *Viagem) Cancelar(motivo string, politica *PoliticaCancelamento, dataCancelamento time.Time) error {
+	if motivo == "" {
+		return ErrMotivoCancelamentoObrigatorio
+	}
+	
+	if v.Status != StatusAgendada {
+		return ErrViagemNaoCancelavel
+	}
+	
+	v.TaxaCancelamento, v.ValorReembolso = politica.CalcularCancelamento(v.Valor, v.DataInicio, dataCancelamento)
+	v.MotivoCancelamento = motivo
+	v.CanceladaEm = &dataCancelamento
+	v.AtualizarStatus(StatusCancelada)
+	
+	return nil
+}
+
+// Iniciar coloca a viagem agendada em andamento a partir do check-in
+func (v *Viagem) Iniciar(checkIn *RegistroViagem) error {
+	if v.Status != StatusAgendada {
+		return ErrViagemNaoIniciavel
+	}
+	
+	v.InicioReal = &checkIn.RegistradoEm
+	v.AtualizarStatus(StatusEmAndamento)
+	
+	return nil
+}
+
+// Concluir encerra a viagem em andamento a partir do check-out, calculando a
+// quilometragem percorrida desde o check-in
+func (v *Viagem) Concluir(checkIn, checkOut *RegistroViagem) error {
+	if v.Status != StatusEmAndamento {
+		return ErrViagemNaoEmAndamento
+	}
+	
+	if checkOut.Odometro < checkIn.Odometro {
+		return ErrOdometroRegressivo
+	}
+	
+	if checkOut.RegistradoEm.Before(checkIn.RegistradoEm) {
+		return ErrHorarioRegistroInvalido
+	}
+	
+	v.FimReal = &checkOut.RegistradoEm
+	v.KmPercorridos = checkOut.Odometro - checkIn.Odometro
+	v.AtualizarStatus(StatusConcluida)
+	
+	return nil
+}
+
+// DirecaoPrevista retorna o tempo de direção planejado para a viagem
+func (v *Viagem) DirecaoPrevista() time.Duration {
+	if v.DirecaoPrevistaMinutos > 0 {
+		return time.Duration(v.DirecaoPrevistaMinutos) * time.Minute
+	}
+	return v.DataFim.Sub(v.DataInicio)
+}
+
+// PossuiRevezamento indica se a viagem tem motorista secundário
+func (v *Viagem) PossuiRevezamento() bool {
+	return v.MotoristaSecundarioID != nil
+}
+
+// ValidarRevezamento exige o motorista secundário quando a direção prevista
+// ultrapassa o limite. Limite zero desativa a exigência.
+func (v *Viagem) ValidarRevezamento(limite time.Duration) error {
+	if v.DirecaoPrevistaMinutos < 0 {
+		return ErrDirecaoPrevistaInvalida
+	}
+	
+	if v.PossuiRevezamento() && *v.MotoristaSecundarioID == v.MotoristaID {
+		return ErrMotoristaSecundarioRepetido
+	}
+	
+	if limite > 0 && !v.PossuiRevezamento() && v.DirecaoPrevista() > limite {
+		return ErrRevezamentoObrigatorio
+	}
+	
+	return nil
+}
+
+// Motoristas retorna os motoristas escalados na viagem, o principal primeiro
+func (v *Viagem) Motoristas() []uuid.UUID {
+	motoristas := []uuid.UUID{v.MotoristaID}
+	if v.PossuiRevezamento() {
+		motoristas = append(motoristas, *v.MotoristaSecundarioID)
+	}
+	return motoristas
+}
+
+// EscalaMotorista indica se o motorista conduz a viagem, como principal ou
+// em revezamento
+func (v *Viagem) EscalaMotorista(motoristaID uuid.UUID) bool {
+	for _, id := range v.Motoristas() {
+		if id == motoristaID {
+			return true
+		}
+	}
+	return false
+}
+
+// MinutosPorMotorista retorna o tempo de trabalho creditado a cada motorista
+// pela viagem realizada. No revezamento a direção é dividida igualmente.
+func (v *Viagem) MinutosPorMotorista() int {
+	if v.InicioReal == nil || v.FimReal == nil {
+		return 0
+	}
+	
+	minutos := int(v.FimReal.Sub(*v.InicioReal).Minutes())
+	if v.PossuiRevezamento() {
+		minutos /= 2
+	}
+	return minutos
+}
+
 // AtualizarRota atualiza as informações da rota
 func (v *Viagem) AtualizarRota(coordsOrigem, coordsDestino, rotaCompleta string) {
 	v.CoordenadasOrigem = coordsOrigem
@@ -106,6 +283,14 @@ var (
 	ErrDataInicioPassada        = NewDomainError("data de início não pode ser no passado")
 	ErrValorInvalido            = NewDomainError("valor deve ser maior que zero")
 	ErrOrigemDestinoObrigatorios = NewDomainError("origem e destino são obrigatórios")
+	ErrMotivoCancelamentoObrigatorio = NewDomainError("motivo do cancelamento é obrigatório")
+	ErrViagemNaoCancelavel       = NewDomainError("somente viagens agendadas podem ser canceladas")
+	ErrViagemNaoIniciavel        = NewDomainError("somente viagens agendadas podem ser iniciadas")
+	ErrViagemNaoEmAndamento      = NewDomainError("somente viagens em andamento podem ser concluídas")
+	ErrRevezamentoObrigatorio    = NewDomainError("direção prevista excede o limite sem revezamento; informe o motorista secundário")
+	ErrMotoristaSecundarioRepetido = NewDomainError("motorista secundário deve ser diferente do motorista principal")
+	ErrDirecaoPrevistaInvalida   = NewDomainError("direção prevista não pode ser negativa")
+	ErrStatusViagemNaoEditavel   = NewDomainError("o status da viagem só muda pelo cancelamento, check-in e check-out")
 )
 
 // DomainError representa um erro de domínio
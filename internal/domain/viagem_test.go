@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestViagemEditada(t *testing.T) {
+	inicio := time.Date(2026, 7, 1, 8, 0, 0, 0, time.UTC)
+	grupoID := uuid.New()
+	canceladaEm := inicio.Add(-time.Hour)
+
+	viagem := NewViagem(uuid.New(), uuid.New(), uuid.New(), "São Paulo", "Santos", inicio, inicio.Add(2*time.Hour), 800)
+	viagem.Status = StatusCancelada
+	viagem.GrupoID = &grupoID
+	viagem.TaxaCancelamento = 400
+	viagem.ValorReembolso = 400
+	viagem.CanceladaEm = &canceladaEm
+	viagem.KmPercorridos = 75
+
+	secundario := uuid.New()
+	edicao := &Viagem{
+		VeiculoID:             uuid.New(),
+		MotoristaID:           uuid.New(),
+		MotoristaSecundarioID: &secundario,
+		Origem:                "Campinas",
+		Destino:               "Santos",
+		DataInicio:            inicio.Add(time.Hour),
+		DataFim:               inicio.Add(4 * time.Hour),
+		Valor:                 900,
+		Observacoes:           "Saída pelo portão 2",
+		Status:                StatusConcluida,
+		TaxaCancelamento:      0,
+		ValorReembolso:        900,
+	}
+
+	editada := viagem.Editada(edicao)
+
+	if editada.VeiculoID != edicao.VeiculoID || editada.MotoristaID != edicao.MotoristaID ||
+		editada.MotoristaSecundarioID != edicao.MotoristaSecundarioID || editada.Origem != "Campinas" ||
+		!editada.DataInicio.Equal(edicao.DataInicio) || editada.Valor != 900 || editada.Observacoes != edicao.Observacoes {
+		t.Errorf("dados editáveis não aplicados: %+v", editada)
+	}
+	if editada.ID != viagem.ID || editada.Status != StatusCancelada || editada.GrupoID != &grupoID ||
+		editada.TaxaCancelamento != 400 || editada.ValorReembolso != 400 || editada.CanceladaEm != &canceladaEm ||
+		editada.KmPercorridos != 75 {
+		t.Errorf("dados não editáveis alterados: %+v", editada)
+	}
+	if viagem.Origem != "São Paulo" {
+		t.Error("a viagem original não deve mudar")
+	}
+}
+
+func TestViagemCancelar(t *testing.T) {
+	inicio := time.Date(2026, 7, 1, 8, 0, 0, 0, time.UTC)
+	politica := PoliticaCancelamentoPadrao()
+
+	casos := []struct {
+		status StatusViagem
+		erro   error
+	}{
+		{StatusAgendada, nil},
+		{StatusEmAndamento, ErrViagemNaoCancelavel},
+		{StatusConcluida, ErrViagemNaoCancelavel},
+		{StatusCancelada, ErrViagemNaoCancelavel},
+	}
+
+	for _, c := range casos {
+		t.Run(string(c.status), func(t *testing.T) {
+			viagem := NewViagem(uuid.New(), uuid.New(), uuid.New(), "São Paulo", "Santos", inicio, inicio.Add(2*time.Hour), 800)
+			viagem.Status = c.status
+			if err := viagem.Cancelar("cliente desistiu", politica, inicio.AddDate(0, 0, -10)); !errors.Is(err, c.erro) {
+				t.Errorf("Cancelar() = %v, esperado %v", err, c.erro)
+			}
+		})
+	}
+}
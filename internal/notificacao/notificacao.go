@@ -0,0 +1,62 @@
+package notificacao
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/smtp"
+	"strings"
+)
+
+// Notificador envia mensagens aos usuários do sistema
+type Notificador interface {
+	Notificar(ctx context.Context, destinatario, assunto, mensagem string) error
+}
+
+// logNotificador apenas registra as mensagens no log da aplicação. É usado
+// quando não há servidor SMTP configurado.
+type logNotificador struct{}
+
+// NewLogNotificador cria um notificador que escreve as mensagens no log
+func NewLogNotificador() Notificador {
+	return logNotificador{}
+}
+
+func (logNotificador) Notificar(_ context.Context, destinatario, assunto, mensagem string) error {
+	log.Printf("Notificação para %s: %s - %s", destinatario, assunto, mensagem)
+	return nil
+}
+
+type smtpNotificador struct {
+	endereco  string
+	auth      smtp.Auth
+	remetente string
+}
+
+// NewSMTPNotificador cria um notificador que envia e-mails pelo servidor SMTP informado
+func NewSMTPNotificador(host, porta, usuario, senha, remetente string) Notificador {
+	var auth smtp.Auth
+	if usuario != "" {
+		auth = smtp.PlainAuth("", usuario, senha, host)
+	}
+	return &smtpNotificador{
+		endereco:  fmt.Sprintf("%s:%s", host, porta),
+		auth:      auth,
+		remetente: remetente,
+	}
+}
+
+func (n *smtpNotificador) Notificar(_ context.Context, destinatario, assunto, mensagem string) error {
+	var corpo strings.Builder
+	fmt.Fprintf(&corpo, "From: %s\r\n", n.remetente)
+	fmt.Fprintf(&corpo, "To: %s\r\n", destinatario)
+	fmt.Fprintf(&corpo, "Subject: %s\r\n", assunto)
+	corpo.WriteString("MIME-Version: 1.0\r\n")
+	corpo.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	corpo.WriteString(mensagem)
+
+	if err := smtp.SendMail(n.endereco, n.auth, n.remetente, []string{destinatario}, []byte(corpo.String())); err != nil {
+		return fmt.Errorf("erro ao enviar e-mail para %s: %w", destinatario, err)
+	}
+	return nil
+}
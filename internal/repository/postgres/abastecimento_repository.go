@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type abastecimentoRepository struct {
+	db *gorm.DB
+}
+
+// NewAbastecimentoRepository cria uma nova instância do repositório de abastecimentos
+func NewAbastecimentoRepository(db *gorm.DB) domain.AbastecimentoRepository {
+	return &abastecimentoRepository{db: db}
+}
+
+func (r *abastecimentoRepository) Create(ctx context.Context, abastecimento *domain.Abastecimento) error {
+	return dbFromContext(ctx, r.db).Create(abastecimento).Error
+}
+
+func (r *abastecimentoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.Abastecimento{}, "id = ?", id).Error
+}
+
+func (r *abastecimentoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Abastecimento, error) {
+	var abastecimento domain.Abastecimento
+	err := dbFromContext(ctx, r.db).First(&abastecimento, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &abastecimento, nil
+}
+
+func (r *abastecimentoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID,
+	dataInicio, dataFim time.Time) ([]*domain.Abastecimento, error) {
+	var abastecimentos []*domain.Abastecimento
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ? AND data BETWEEN ? AND ?", veiculoID, dataInicio, dataFim).
+		Order("data ASC").
+		Find(&abastecimentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return abastecimentos, nil
+}
+
+func (r *abastecimentoRepository) GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Abastecimento, error) {
+	var abastecimentos []*domain.Abastecimento
+	err := dbFromContext(ctx, r.db).
+		Where("data BETWEEN ? AND ?", dataInicio, dataFim).
+		Order("veiculo_id, data ASC").
+		Find(&abastecimentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return abastecimentos, nil
+}
+
+// ExisteLancamento indica se já há abastecimento do veículo com a mesma data e
+// odômetro, evitando duplicidade ao importar extratos repetidos
+func (r *abastecimentoRepository) ExisteLancamento(ctx context.Context, veiculoID uuid.UUID,
+	data time.Time, odometro int) (bool, error) {
+	var total int64
+	err := dbFromContext(ctx, r.db).
+		Model(&domain.Abastecimento{}).
+		Where("veiculo_id = ? AND data = ? AND odometro = ?", veiculoID, data, odometro).
+		Count(&total).Error
+	if err != nil {
+		return false, err
+	}
+	return total > 0, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type anexoRepository struct {
+	db *gorm.DB
+}
+
+// NewAnexoRepository cria uma nova instância do repositório de anexos
+func NewAnexoRepository(db *gorm.DB) domain.AnexoRepository {
+	return &anexoRepository{db: db}
+}
+
+func (r *anexoRepository) Create(ctx context.Context, anexo *domain.Anexo) error {
+	return dbFromContext(ctx, r.db).Create(anexo).Error
+}
+
+func (r *anexoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.Anexo{}, "id = ?", id).Error
+}
+
+func (r *anexoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Anexo, error) {
+	var anexo domain.Anexo
+	err := dbFromContext(ctx, r.db).First(&anexo, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &anexo, nil
+}
+
+// GetByEntidade retorna os anexos do cadastro, dos mais recentes para os mais antigos
+func (r *anexoRepository) GetByEntidade(ctx context.Context, entidade domain.EntidadeAnexo, entidadeID uuid.UUID) ([]*domain.Anexo, error) {
+	var anexos []*domain.Anexo
+	err := dbFromContext(ctx, r.db).
+		Where("entidade = ? AND entidade_id = ?", entidade, entidadeID).
+		Order("created_at DESC").
+		Find(&anexos).Error
+	if err != nil {
+		return nil, err
+	}
+	return anexos, nil
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type comodidadeRepository struct {
+	db *gorm.DB
+}
+
+// NewComodidadeRepository cria uma nova instância do repositório de comodidades
+func NewComodidadeRepository(db *gorm.DB) domain.ComodidadeRepository {
+	return &comodidadeRepository{db: db}
+}
+
+func (r *comodidadeRepository) Create(ctx context.Context, comodidade *domain.Comodidade) error {
+	return dbFromContext(ctx, r.db).Create(comodidade).Error
+}
+
+func (r *comodidadeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.Comodidade{}, "id = ?", id).Error
+}
+
+func (r *comodidadeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comodidade, error) {
+	var comodidade domain.Comodidade
+	err := dbFromContext(ctx, r.db).First(&comodidade, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comodidade, nil
+}
+
+func (r *comodidadeRepository) List(ctx context.Context) ([]*domain.Comodidade, error) {
+	var comodidades []*domain.Comodidade
+	err := dbFromContext(ctx, r.db).
+		Order("nome ASC").
+		Find(&comodidades).Error
+	if err != nil {
+		return nil, err
+	}
+	return comodidades, nil
+}
+
+// GetByCodigos retorna as comodidades do catálogo com os códigos informados
+func (r *comodidadeRepository) GetByCodigos(ctx context.Context, codigos []string) ([]*domain.Comodidade, error) {
+	var comodidades []*domain.Comodidade
+	if len(codigos) == 0 {
+		return comodidades, nil
+	}
+	err := dbFromContext(ctx, r.db).
+		Where("codigo IN ?", codigos).
+		Find(&comodidades).Error
+	if err != nil {
+		return nil, err
+	}
+	return comodidades, nil
+}
+
+// EmUso indica se algum veículo, viagem ou grupo referencia a comodidade
+func (r *comodidadeRepository) EmUso(ctx context.Context, codigo string) (bool, error) {
+	contem := jsonComodidades([]string{codigo})
+	var total int64
+	err := dbFromContext(ctx, r.db).Raw(`SELECT
+		(SELECT COUNT(*) FROM veiculos WHERE comodidades @> ?::jsonb) +
+		(SELECT COUNT(*) FROM viagems WHERE comodidades_exigidas @> ?::jsonb) +
+		(SELECT COUNT(*) FROM grupo_viagems WHERE comodidades_exigidas @> ?::jsonb)`,
+		contem, contem, contem).
+		Scan(&total).Error
+	if err != nil {
+		return false, err
+	}
+	return total > 0, nil
+}
+
+// jsonComodidades serializa os códigos para o operador de contenção do jsonb
+func jsonComodidades(codigos []string) string {
+	dados, _ := json.Marshal(codigos)
+	return string(dados)
+}
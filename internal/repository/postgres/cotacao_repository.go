@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type cotacaoRepository struct {
+	db *gorm.DB
+}
+
+// NewCotacaoRepository cria uma nova instância do repositório de cotações
+func NewCotacaoRepository(db *gorm.DB) domain.CotacaoRepository {
+	return &cotacaoRepository{db: db}
+}
+
+func (r *cotacaoRepository) Create(ctx context.Context, cotacao *domain.Cotacao) error {
+	return dbFromContext(ctx, r.db).Create(cotacao).Error
+}
+
+// Update grava apenas os dados da cotação; os itens não mudam após o cálculo
+func (r *cotacaoRepository) Update(ctx context.Context, cotacao *domain.Cotacao) error {
+	return dbFromContext(ctx, r.db).Omit("Itens").Save(cotacao).Error
+}
+
+func (r *cotacaoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Cotacao, error) {
+	var cotacao domain.Cotacao
+	err := dbFromContext(ctx, r.db).
+		Preload("Itens", func(db *gorm.DB) *gorm.DB {
+			return db.Order("ordem ASC")
+		}).
+		First(&cotacao, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cotacao, nil
+}
+
+// Converter grava a conversão da cotação em viagem somente se ela ainda estiver
+// aberta no banco, para que duas viagens não sejam criadas da mesma cotação
+func (r *cotacaoRepository) Converter(ctx context.Context, cotacao *domain.Cotacao) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.Cotacao{}).
+		Where("id = ? AND status = ?", cotacao.ID, domain.StatusCotacaoAberta).
+		Updates(map[string]interface{}{
+			"status":     cotacao.Status,
+			"viagem_id":  cotacao.ViagemID,
+			"updated_at": cotacao.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCotacaoJaConvertida
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type custoFixoVeiculoRepository struct {
+	db *gorm.DB
+}
+
+// NewCustoFixoVeiculoRepository cria uma nova instância do repositório de custos fixos de veículos
+func NewCustoFixoVeiculoRepository(db *gorm.DB) domain.CustoFixoVeiculoRepository {
+	return &custoFixoVeiculoRepository{db: db}
+}
+
+func (r *custoFixoVeiculoRepository) Create(ctx context.Context, custo *domain.CustoFixoVeiculo) error {
+	return dbFromContext(ctx, r.db).Create(custo).Error
+}
+
+func (r *custoFixoVeiculoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.CustoFixoVeiculo{}, "id = ?", id).Error
+}
+
+func (r *custoFixoVeiculoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CustoFixoVeiculo, error) {
+	var custo domain.CustoFixoVeiculo
+	err := dbFromContext(ctx, r.db).First(&custo, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &custo, nil
+}
+
+// GetByVeiculo retorna os custos fixos do veículo, dos mais recentes para os mais antigos
+func (r *custoFixoVeiculoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.CustoFixoVeiculo, error) {
+	var custos []*domain.CustoFixoVeiculo
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ?", veiculoID).
+		Order("vigente_desde DESC").
+		Find(&custos).Error
+	if err != nil {
+		return nil, err
+	}
+	return custos, nil
+}
+
+// GetVigentes retorna os custos fixos de toda a frota vigentes em qualquer
+// parte do período
+func (r *custoFixoVeiculoRepository) GetVigentes(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.CustoFixoVeiculo, error) {
+	var custos []*domain.CustoFixoVeiculo
+	err := dbFromContext(ctx, r.db).
+		Where("vigente_desde < ? AND (vigente_ate IS NULL OR vigente_ate > ?)", dataFim, dataInicio).
+		Find(&custos).Error
+	if err != nil {
+		return nil, err
+	}
+	return custos, nil
+}
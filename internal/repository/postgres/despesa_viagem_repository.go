@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type despesaViagemRepository struct {
+	db *gorm.DB
+}
+
+// NewDespesaViagemRepository cria uma nova instância do repositório de despesas de viagem
+func NewDespesaViagemRepository(db *gorm.DB) domain.DespesaViagemRepository {
+	return &despesaViagemRepository{db: db}
+}
+
+func (r *despesaViagemRepository) Create(ctx context.Context, despesa *domain.DespesaViagem) error {
+	return dbFromContext(ctx, r.db).Create(despesa).Error
+}
+
+func (r *despesaViagemRepository) Update(ctx context.Context, despesa *domain.DespesaViagem) error {
+	return dbFromContext(ctx, r.db).Save(despesa).Error
+}
+
+func (r *despesaViagemRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error) {
+	var despesa domain.DespesaViagem
+	if err := dbFromContext(ctx, r.db).First(&despesa, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &despesa, nil
+}
+
+// GetByViagem retorna as despesas da viagem em ordem cronológica
+func (r *despesaViagemRepository) GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.DespesaViagem, error) {
+	var despesas []*domain.DespesaViagem
+	err := dbFromContext(ctx, r.db).
+		Where("viagem_id = ?", viagemID).
+		Order("realizada_em ASC").
+		Find(&despesas).Error
+	if err != nil {
+		return nil, err
+	}
+	return despesas, nil
+}
+
+// GetByMotorista retorna as despesas registradas pelo motorista realizadas no período
+func (r *despesaViagemRepository) GetByMotorista(ctx context.Context, motoristaID uuid.UUID,
+	dataInicio, dataFim time.Time) ([]*domain.DespesaViagem, error) {
+	var despesas []*domain.DespesaViagem
+	err := dbFromContext(ctx, r.db).
+		Where("motorista_id = ? AND realizada_em BETWEEN ? AND ?", motoristaID, dataInicio, dataFim).
+		Order("realizada_em ASC").
+		Find(&despesas).Error
+	if err != nil {
+		return nil, err
+	}
+	return despesas, nil
+}
+
+// GetByViagens retorna as despesas das viagens informadas
+func (r *despesaViagemRepository) GetByViagens(ctx context.Context, viagemIDs []uuid.UUID) ([]*domain.DespesaViagem, error) {
+	var despesas []*domain.DespesaViagem
+	if len(viagemIDs) == 0 {
+		return despesas, nil
+	}
+	err := dbFromContext(ctx, r.db).
+		Where("viagem_id IN ?", viagemIDs).
+		Order("realizada_em ASC").
+		Find(&despesas).Error
+	if err != nil {
+		return nil, err
+	}
+	return despesas, nil
+}
+
+// Avaliar grava a etapa da aprovação somente se a despesa ainda estiver no
+// status anterior, para que avaliações simultâneas não se sobreponham
+func (r *despesaViagemRepository) Avaliar(ctx context.Context, despesa *domain.DespesaViagem, statusAnterior domain.StatusDespesa) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.DespesaViagem{}).
+		Where("id = ? AND status = ?", despesa.ID, statusAnterior).
+		Updates(map[string]interface{}{
+			"status":          despesa.Status,
+			"avaliador_id":    despesa.AvaliadorID,
+			"avaliador_nome":  despesa.AvaliadorNome,
+			"avaliada_em":     despesa.AvaliadaEm,
+			"motivo_rejeicao": despesa.MotivoRejeicao,
+			"reembolsada_em":  despesa.ReembolsadaEm,
+			"updated_at":      despesa.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		if statusAnterior == domain.StatusDespesaPendente {
+			return domain.ErrDespesaJaAvaliada
+		}
+		return domain.ErrDespesaNaoAprovada
+	}
+	return nil
+}
+
+// ComprovanteEmUso indica se alguma despesa usa o anexo como comprovante
+func (r *despesaViagemRepository) ComprovanteEmUso(ctx context.Context, anexoID uuid.UUID) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).
+		Model(&domain.DespesaViagem{}).
+		Where("comprovante_id = ?", anexoID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type documentoVeiculoRepository struct {
+	db *gorm.DB
+}
+
+// NewDocumentoVeiculoRepository cria uma nova instância do repositório de documentos de veículos
+func NewDocumentoVeiculoRepository(db *gorm.DB) domain.DocumentoVeiculoRepository {
+	return &documentoVeiculoRepository{db: db}
+}
+
+func (r *documentoVeiculoRepository) Create(ctx context.Context, documento *domain.DocumentoVeiculo) error {
+	return dbFromContext(ctx, r.db).Create(documento).Error
+}
+
+func (r *documentoVeiculoRepository) Update(ctx context.Context, documento *domain.DocumentoVeiculo) error {
+	return dbFromContext(ctx, r.db).Save(documento).Error
+}
+
+func (r *documentoVeiculoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.DocumentoVeiculo{}, "id = ?", id).Error
+}
+
+func (r *documentoVeiculoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.DocumentoVeiculo, error) {
+	var documento domain.DocumentoVeiculo
+	err := dbFromContext(ctx, r.db).First(&documento, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &documento, nil
+}
+
+// GetByVeiculo retorna os documentos do veículo
+func (r *documentoVeiculoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.DocumentoVeiculo, error) {
+	var documentos []*domain.DocumentoVeiculo
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ?", veiculoID).
+		Order("tipo ASC, valido_ate DESC").
+		Find(&documentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return documentos, nil
+}
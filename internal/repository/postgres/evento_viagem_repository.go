@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type eventoViagemRepository struct {
+	db *gorm.DB
+}
+
+// NewEventoViagemRepository cria uma nova instância do repositório de eventos de viagem
+func NewEventoViagemRepository(db *gorm.DB) domain.EventoViagemRepository {
+	return &eventoViagemRepository{db: db}
+}
+
+func (r *eventoViagemRepository) Create(ctx context.Context, eventos ...*domain.EventoViagem) error {
+	if len(eventos) == 0 {
+		return nil
+	}
+	return dbFromContext(ctx, r.db).Create(eventos).Error
+}
+
+// GetByViagem retorna o histórico da viagem em ordem cronológica
+func (r *eventoViagemRepository) GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.EventoViagem, error) {
+	var eventos []*domain.EventoViagem
+	err := dbFromContext(ctx, r.db).
+		Where("viagem_id = ?", viagemID).
+		Order("ocorrido_em ASC").
+		Find(&eventos).Error
+	if err != nil {
+		return nil, err
+	}
+	return eventos, nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type feriadoRepository struct {
+	db *gorm.DB
+}
+
+// NewFeriadoRepository cria uma nova instância do repositório de feriados
+func NewFeriadoRepository(db *gorm.DB) domain.FeriadoRepository {
+	return &feriadoRepository{db: db}
+}
+
+func (r *feriadoRepository) Create(ctx context.Context, feriado *domain.Feriado) error {
+	return dbFromContext(ctx, r.db).Create(feriado).Error
+}
+
+func (r *feriadoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.Feriado{}, "id = ?", id).Error
+}
+
+func (r *feriadoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Feriado, error) {
+	var feriado domain.Feriado
+	if err := dbFromContext(ctx, r.db).First(&feriado, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &feriado, nil
+}
+
+func (r *feriadoRepository) List(ctx context.Context) ([]*domain.Feriado, error) {
+	var feriados []*domain.Feriado
+	err := dbFromContext(ctx, r.db).
+		Order("data ASC").
+		Find(&feriados).Error
+	if err != nil {
+		return nil, err
+	}
+	return feriados, nil
+}
+
+// GetByPeriodo retorna os feriados cujas datas caem no período informado
+func (r *feriadoRepository) GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]domain.Feriado, error) {
+	var feriados []domain.Feriado
+	err := dbFromContext(ctx, r.db).
+		Where("data BETWEEN ? AND ?", dataInicio.Format("2006-01-02"), dataFim.Format("2006-01-02")).
+		Order("data ASC").
+		Find(&feriados).Error
+	if err != nil {
+		return nil, err
+	}
+	return feriados, nil
+}
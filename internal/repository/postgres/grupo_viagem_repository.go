@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type grupoViagemRepository struct {
+	db *gorm.DB
+}
+
+// NewGrupoViagemRepository cria uma nova instância do repositório de grupos de viagem
+func NewGrupoViagemRepository(db *gorm.DB) domain.GrupoViagemRepository {
+	return &grupoViagemRepository{db: db}
+}
+
+func (r *grupoViagemRepository) Create(ctx context.Context, grupo *domain.GrupoViagem) error {
+	return dbFromContext(ctx, r.db).Omit("Viagens").Create(grupo).Error
+}
+
+func (r *grupoViagemRepository) Update(ctx context.Context, grupo *domain.GrupoViagem) error {
+	return dbFromContext(ctx, r.db).Omit("Viagens").Save(grupo).Error
+}
+
+func (r *grupoViagemRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GrupoViagem, error) {
+	var grupo domain.GrupoViagem
+	err := dbFromContext(ctx, r.db).
+		Preload("Viagens", func(db *gorm.DB) *gorm.DB {
+			return db.Order("quantidade_passageiros DESC")
+		}).
+		Preload("Viagens.Veiculo").
+		Preload("Viagens.Motorista").
+		Preload("Cliente").
+		First(&grupo, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &grupo, nil
+}
+
+func (r *grupoViagemRepository) List(ctx context.Context, offset, limit int) ([]*domain.GrupoViagem, error) {
+	var grupos []*domain.GrupoViagem
+	err := dbFromContext(ctx, r.db).
+		Preload("Viagens").
+		Preload("Cliente").
+		Offset(offset).
+		Limit(limit).
+		Order("data_inicio DESC").
+		Find(&grupos).Error
+	if err != nil {
+		return nil, err
+	}
+	return grupos, nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type indisponibilidadeVeiculoRepository struct {
+	db *gorm.DB
+}
+
+// NewIndisponibilidadeVeiculoRepository cria uma nova instância do repositório de indisponibilidades de veículos
+func NewIndisponibilidadeVeiculoRepository(db *gorm.DB) domain.IndisponibilidadeVeiculoRepository {
+	return &indisponibilidadeVeiculoRepository{db: db}
+}
+
+func (r *indisponibilidadeVeiculoRepository) Create(ctx context.Context, indisponibilidade *domain.IndisponibilidadeVeiculo) error {
+	return dbFromContext(ctx, r.db).Create(indisponibilidade).Error
+}
+
+func (r *indisponibilidadeVeiculoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.IndisponibilidadeVeiculo{}, "id = ?", id).Error
+}
+
+func (r *indisponibilidadeVeiculoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.IndisponibilidadeVeiculo, error) {
+	var indisponibilidade domain.IndisponibilidadeVeiculo
+	err := dbFromContext(ctx, r.db).First(&indisponibilidade, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &indisponibilidade, nil
+}
+
+// GetByVeiculo retorna as indisponibilidades do veículo que ocupam qualquer
+// parte do período informado
+func (r *indisponibilidadeVeiculoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID,
+	dataInicio, dataFim time.Time) ([]*domain.IndisponibilidadeVeiculo, error) {
+	var indisponibilidades []*domain.IndisponibilidadeVeiculo
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ? AND data_inicio < ? AND data_fim > ?", veiculoID, dataFim, dataInicio).
+		Order("data_inicio ASC").
+		Find(&indisponibilidades).Error
+	if err != nil {
+		return nil, err
+	}
+	return indisponibilidades, nil
+}
+
+// indisponibilidadesNoPeriodo seleciona a coluna informada das
+// indisponibilidades de veículos que se sobrepõem ao período
+func indisponibilidadesNoPeriodo(db *gorm.DB, coluna string, dataInicio, dataFim time.Time) *gorm.DB {
+	return db.Model(&domain.IndisponibilidadeVeiculo{}).
+		Select(coluna).
+		Where("data_inicio < ? AND data_fim > ?", dataFim, dataInicio)
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type inspecaoVeiculoRepository struct {
+	db *gorm.DB
+}
+
+// NewInspecaoVeiculoRepository cria uma nova instância do repositório de inspeções de veículos
+func NewInspecaoVeiculoRepository(db *gorm.DB) domain.InspecaoVeiculoRepository {
+	return &inspecaoVeiculoRepository{db: db}
+}
+
+func (r *inspecaoVeiculoRepository) Create(ctx context.Context, inspecao *domain.InspecaoVeiculo) error {
+	return dbFromContext(ctx, r.db).Create(inspecao).Error
+}
+
+// GetByViagem retorna as inspeções da viagem, das mais recentes para as mais antigas
+func (r *inspecaoVeiculoRepository) GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.InspecaoVeiculo, error) {
+	var inspecoes []*domain.InspecaoVeiculo
+	err := dbFromContext(ctx, r.db).
+		Where("viagem_id = ?", viagemID).
+		Order("created_at DESC").
+		Find(&inspecoes).Error
+	if err != nil {
+		return nil, err
+	}
+	return inspecoes, nil
+}
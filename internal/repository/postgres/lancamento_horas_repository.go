@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type lancamentoHorasRepository struct {
+	db *gorm.DB
+}
+
+// NewLancamentoHorasRepository cria uma nova instância do repositório de lançamentos do banco de horas
+func NewLancamentoHorasRepository(db *gorm.DB) domain.LancamentoHorasRepository {
+	return &lancamentoHorasRepository{db: db}
+}
+
+func (r *lancamentoHorasRepository) Create(ctx context.Context, lancamento *domain.LancamentoHoras) error {
+	return dbFromContext(ctx, r.db).Create(lancamento).Error
+}
+
+// GetByMotorista retorna os lançamentos do motorista com data no período
+// [dataInicio, dataFim), em ordem cronológica
+func (r *lancamentoHorasRepository) GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.LancamentoHoras, error) {
+	var lancamentos []*domain.LancamentoHoras
+	err := dbFromContext(ctx, r.db).
+		Where("motorista_id = ? AND data >= ? AND data < ?", motoristaID, dataInicio, dataFim).
+		Order("data ASC, created_at ASC").
+		Find(&lancamentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return lancamentos, nil
+}
+
+// GetViagensByMotorista retorna apenas os lançamentos de viagem do motorista
+// no período, usados no cálculo das horas extras
+func (r *lancamentoHorasRepository) GetViagensByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.LancamentoHoras, error) {
+	var lancamentos []*domain.LancamentoHoras
+	err := dbFromContext(ctx, r.db).
+		Where("motorista_id = ? AND tipo = ? AND data >= ? AND data < ?",
+			motoristaID, domain.LancamentoViagem, dataInicio, dataFim).
+		Order("data ASC").
+		Find(&lancamentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return lancamentos, nil
+}
+
+// GetSaldo retorna o saldo do banco de horas do motorista, em minutos, somando
+// os lançamentos com data anterior a ate
+func (r *lancamentoHorasRepository) GetSaldo(ctx context.Context, motoristaID uuid.UUID, ate time.Time) (int, error) {
+	var saldo int
+	err := dbFromContext(ctx, r.db).
+		Model(&domain.LancamentoHoras{}).
+		Select("COALESCE(SUM(saldo), 0)").
+		Where("motorista_id = ? AND data < ?", motoristaID, ate).
+		Scan(&saldo).Error
+	if err != nil {
+		return 0, err
+	}
+	return saldo, nil
+}
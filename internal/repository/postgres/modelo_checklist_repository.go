@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type modeloChecklistRepository struct {
+	db *gorm.DB
+}
+
+// NewModeloChecklistRepository cria uma nova instância do repositório de modelos de checklist
+func NewModeloChecklistRepository(db *gorm.DB) domain.ModeloChecklistRepository {
+	return &modeloChecklistRepository{db: db}
+}
+
+func (r *modeloChecklistRepository) Create(ctx context.Context, modelo *domain.ModeloChecklist) error {
+	return dbFromContext(ctx, r.db).Create(modelo).Error
+}
+
+func (r *modeloChecklistRepository) Update(ctx context.Context, modelo *domain.ModeloChecklist) error {
+	return dbFromContext(ctx, r.db).Save(modelo).Error
+}
+
+func (r *modeloChecklistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.ModeloChecklist{}, "id = ?", id).Error
+}
+
+func (r *modeloChecklistRepository) List(ctx context.Context) ([]*domain.ModeloChecklist, error) {
+	var modelos []*domain.ModeloChecklist
+	err := dbFromContext(ctx, r.db).
+		Order("tipo_veiculo ASC").
+		Find(&modelos).Error
+	if err != nil {
+		return nil, err
+	}
+	return modelos, nil
+}
+
+// GetByTipoVeiculo retorna o modelo do tipo de veículo, ou nil se o tipo não
+// tiver modelo configurado
+func (r *modeloChecklistRepository) GetByTipoVeiculo(ctx context.Context, tipo domain.TipoVeiculo) (*domain.ModeloChecklist, error) {
+	var modelos []*domain.ModeloChecklist
+	err := dbFromContext(ctx, r.db).
+		Where("tipo_veiculo = ?", tipo).
+		Limit(1).
+		Find(&modelos).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(modelos) == 0 {
+		return nil, nil
+	}
+	return modelos[0], nil
+}
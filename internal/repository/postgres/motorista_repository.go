@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type motoristaRepository struct {
@@ -20,29 +21,39 @@ func NewMotoristaRepository(db *gorm.DB) domain.MotoristaRepository {
 }
 
 func (r *motoristaRepository) Create(ctx context.Context, motorista *domain.Motorista) error {
-	return r.db.WithContext(ctx).Create(motorista).Error
+	return dbFromContext(ctx, r.db).Create(motorista).Error
 }
 
 func (r *motoristaRepository) Update(ctx context.Context, motorista *domain.Motorista) error {
-	return r.db.WithContext(ctx).Save(motorista).Error
+	return dbFromContext(ctx, r.db).Save(motorista).Error
 }
 
 func (r *motoristaRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.Motorista{}, "id = ?", id).Error
+	return dbFromContext(ctx, r.db).Delete(&domain.Motorista{}, "id = ?", id).Error
 }
 
 func (r *motoristaRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Motorista, error) {
 	var motorista domain.Motorista
-	err := r.db.WithContext(ctx).First(&motorista, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&motorista, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &motorista, nil
 }
 
+// Bloquear trava a linha do motorista (SELECT ... FOR UPDATE) até o fim da
+// transação do contexto, serializando os agendamentos que o disputam
+func (r *motoristaRepository) Bloquear(ctx context.Context, id uuid.UUID) error {
+	var motorista domain.Motorista
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&motorista, "id = ?", id).Error
+}
+
 func (r *motoristaRepository) List(ctx context.Context, offset, limit int) ([]*domain.Motorista, error) {
 	var motoristas []*domain.Motorista
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Offset(offset).
 		Limit(limit).
 		Order("nome ASC").
@@ -55,7 +66,7 @@ func (r *motoristaRepository) List(ctx context.Context, offset, limit int) ([]*d
 
 func (r *motoristaRepository) GetByCPF(ctx context.Context, cpf string) (*domain.Motorista, error) {
 	var motorista domain.Motorista
-	err := r.db.WithContext(ctx).First(&motorista, "cpf = ?", cpf).Error
+	err := dbFromContext(ctx, r.db).First(&motorista, "cpf = ?", cpf).Error
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +75,7 @@ func (r *motoristaRepository) GetByCPF(ctx context.Context, cpf string) (*domain
 
 func (r *motoristaRepository) GetByCNH(ctx context.Context, cnh string) (*domain.Motorista, error) {
 	var motorista domain.Motorista
-	err := r.db.WithContext(ctx).First(&motorista, "cnh = ?", cnh).Error
+	err := dbFromContext(ctx, r.db).First(&motorista, "cnh = ?", cnh).Error
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +84,7 @@ func (r *motoristaRepository) GetByCNH(ctx context.Context, cnh string) (*domain
 
 func (r *motoristaRepository) GetByStatus(ctx context.Context, status domain.StatusMotorista) ([]*domain.Motorista, error) {
 	var motoristas []*domain.Motorista
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("status = ?", status).
 		Order("nome ASC").
 		Find(&motoristas).Error
@@ -83,19 +94,30 @@ func (r *motoristaRepository) GetByStatus(ctx context.Context, status domain.Sta
 	return motoristas, nil
 }
 
-func (r *motoristaRepository) GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Motorista, error) {
+// GetDisponiveis retorna os motoristas livres no período, com CNH válida até o
+// fim do período e, se informada, de categoria que abranja a categoria mínima
+func (r *motoristaRepository) GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time,
+	categoriaMinima domain.TipoCNH) ([]*domain.Motorista, error) {
 	var motoristas []*domain.Motorista
 
-	// Subquery para encontrar motoristas ocupados no período
-	subQuery := r.db.Model(&domain.Viagem{}).
-		Select("motorista_id").
-		Where("status != ? AND ((data_inicio BETWEEN ? AND ?) OR (data_fim BETWEEN ? AND ?))",
-			domain.StatusCancelada, dataInicio, dataFim, dataInicio, dataFim)
+	// Subqueries para encontrar motoristas ocupados no período, como
+	// principal ou em revezamento
+	subQuery := viagensNoPeriodo(r.db, "motorista_id", dataInicio, dataFim)
+	revezamentoQuery := viagensNoPeriodo(r.db, "motorista_secundario_id", dataInicio, dataFim).
+		Where("motorista_secundario_id IS NOT NULL")
 
 	// Query principal para encontrar motoristas disponíveis
-	err := r.db.WithContext(ctx).
-		Where("status = ? AND disponivel = ? AND id NOT IN (?)",
-			domain.StatusDisponivel, true, subQuery).
+	query := dbFromContext(ctx, r.db).
+		Where("status = ? AND disponivel = ? AND id NOT IN (?) AND id NOT IN (?)",
+			domain.StatusDisponivel, true, subQuery, revezamentoQuery).
+		Where("id NOT IN (?)", preReservasAtivas(r.db, "motorista_id", dataInicio, dataFim)).
+		Where("validade_cnh >= ?", dataFim)
+
+	if categoriaMinima != "" {
+		query = query.Where("tipo_cnh IN ?", domain.CategoriasQueAbrangem(categoriaMinima))
+	}
+
+	err := query.
 		Order("nome ASC").
 		Find(&motoristas).Error
 	if err != nil {
@@ -109,7 +131,7 @@ func (r *motoristaRepository) GetDisponiveis(ctx context.Context, dataInicio, da
 // GetMotoristasCNHVencida retorna motoristas com CNH vencida
 func (r *motoristaRepository) GetMotoristasCNHVencida(ctx context.Context) ([]*domain.Motorista, error) {
 	var motoristas []*domain.Motorista
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("validade_cnh <= ?", time.Now()).
 		Order("validade_cnh ASC").
 		Find(&motoristas).Error
@@ -122,7 +144,7 @@ func (r *motoristaRepository) GetMotoristasCNHVencida(ctx context.Context) ([]*d
 // GetMotoristasProximosVencimentoCNH retorna motoristas com CNH próxima do vencimento
 func (r *motoristaRepository) GetMotoristasProximosVencimentoCNH(ctx context.Context) ([]*domain.Motorista, error) {
 	var motoristas []*domain.Motorista
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("validade_cnh BETWEEN ? AND ?",
 			time.Now(), time.Now().AddDate(0, 3, 0)). // Próximos 3 meses
 		Order("validade_cnh ASC").
@@ -133,15 +155,46 @@ func (r *motoristaRepository) GetMotoristasProximosVencimentoCNH(ctx context.Con
 	return motoristas, nil
 }
 
-// GetMotoristasBancoHorasExcedido retorna motoristas com banco de horas excedido
+// GetMotoristasBancoHorasExcedido retorna os motoristas cujo saldo no banco de
+// horas, somado dos lançamentos, passa do limite, do maior saldo para o menor
 func (r *motoristaRepository) GetMotoristasBancoHorasExcedido(ctx context.Context, limiteHoras int) ([]*domain.Motorista, error) {
-	var motoristas []*domain.Motorista
-	err := r.db.WithContext(ctx).
-		Where("banco_horas > ?", limiteHoras*60). // Converte horas para minutos
-		Order("banco_horas DESC").
-		Find(&motoristas).Error
+	var saldos []struct {
+		MotoristaID uuid.UUID
+		Saldo       int
+	}
+	err := dbFromContext(ctx, r.db).
+		Model(&domain.LancamentoHoras{}).
+		Select("motorista_id, SUM(saldo) AS saldo").
+		Group("motorista_id").
+		Having("SUM(saldo) > ?", limiteHoras*60). // Converte horas para minutos
+		Order("saldo DESC").
+		Scan(&saldos).Error
 	if err != nil {
 		return nil, err
 	}
+	if len(saldos) == 0 {
+		return []*domain.Motorista{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(saldos))
+	for i, s := range saldos {
+		ids[i] = s.MotoristaID
+	}
+
+	var encontrados []*domain.Motorista
+	if err := dbFromContext(ctx, r.db).Where("id IN ?", ids).Find(&encontrados).Error; err != nil {
+		return nil, err
+	}
+
+	porID := make(map[uuid.UUID]*domain.Motorista, len(encontrados))
+	for _, m := range encontrados {
+		porID[m.ID] = m
+	}
+	motoristas := make([]*domain.Motorista, 0, len(encontrados))
+	for _, id := range ids {
+		if m, ok := porID[id]; ok {
+			motoristas = append(motoristas, m)
+		}
+	}
 	return motoristas, nil
 }
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ordemManutencaoRepository struct {
+	db *gorm.DB
+}
+
+// NewOrdemManutencaoRepository cria uma nova instância do repositório de ordens de manutenção
+func NewOrdemManutencaoRepository(db *gorm.DB) domain.OrdemManutencaoRepository {
+	return &ordemManutencaoRepository{db: db}
+}
+
+func (r *ordemManutencaoRepository) Create(ctx context.Context, ordem *domain.OrdemManutencao) error {
+	return dbFromContext(ctx, r.db).Create(ordem).Error
+}
+
+func (r *ordemManutencaoRepository) Update(ctx context.Context, ordem *domain.OrdemManutencao) error {
+	return dbFromContext(ctx, r.db).Save(ordem).Error
+}
+
+func (r *ordemManutencaoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error) {
+	var ordem domain.OrdemManutencao
+	err := dbFromContext(ctx, r.db).First(&ordem, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ordem, nil
+}
+
+// GetByVeiculo retorna o histórico de manutenção do veículo, das mais recentes para as mais antigas
+func (r *ordemManutencaoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.OrdemManutencao, error) {
+	var ordens []*domain.OrdemManutencao
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ?", veiculoID).
+		Order("agendada_para DESC").
+		Find(&ordens).Error
+	if err != nil {
+		return nil, err
+	}
+	return ordens, nil
+}
+
+// CountEmAndamento retorna quantas ordens do veículo estão em andamento
+func (r *ordemManutencaoRepository) CountEmAndamento(ctx context.Context, veiculoID uuid.UUID) (int64, error) {
+	var total int64
+	err := dbFromContext(ctx, r.db).
+		Model(&domain.OrdemManutencao{}).
+		Where("veiculo_id = ? AND status = ?", veiculoID, domain.StatusOrdemEmAndamento).
+		Count(&total).Error
+	return total, err
+}
+
+// GetConcluidasPorPeriodo retorna as ordens de toda a frota concluídas no período
+func (r *ordemManutencaoRepository) GetConcluidasPorPeriodo(ctx context.Context,
+	dataInicio, dataFim time.Time) ([]*domain.OrdemManutencao, error) {
+	var ordens []*domain.OrdemManutencao
+	err := dbFromContext(ctx, r.db).
+		Where("status = ? AND concluida_em BETWEEN ? AND ?", domain.StatusOrdemConcluida, dataInicio, dataFim).
+		Order("concluida_em ASC").
+		Find(&ordens).Error
+	if err != nil {
+		return nil, err
+	}
+	return ordens, nil
+}
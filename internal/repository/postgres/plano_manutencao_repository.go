@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type planoManutencaoRepository struct {
+	db *gorm.DB
+}
+
+// NewPlanoManutencaoRepository cria uma nova instância do repositório de planos de manutenção
+func NewPlanoManutencaoRepository(db *gorm.DB) domain.PlanoManutencaoRepository {
+	return &planoManutencaoRepository{db: db}
+}
+
+func (r *planoManutencaoRepository) Create(ctx context.Context, plano *domain.PlanoManutencao) error {
+	return dbFromContext(ctx, r.db).Create(plano).Error
+}
+
+func (r *planoManutencaoRepository) Update(ctx context.Context, plano *domain.PlanoManutencao) error {
+	return dbFromContext(ctx, r.db).Save(plano).Error
+}
+
+func (r *planoManutencaoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.PlanoManutencao{}, "id = ?", id).Error
+}
+
+func (r *planoManutencaoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PlanoManutencao, error) {
+	var plano domain.PlanoManutencao
+	err := dbFromContext(ctx, r.db).First(&plano, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &plano, nil
+}
+
+// GetByVeiculo retorna os planos de manutenção do veículo
+func (r *planoManutencaoRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.PlanoManutencao, error) {
+	var planos []*domain.PlanoManutencao
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ?", veiculoID).
+		Order("descricao ASC").
+		Find(&planos).Error
+	if err != nil {
+		return nil, err
+	}
+	return planos, nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type politicaCancelamentoRepository struct {
+	db *gorm.DB
+}
+
+// NewPoliticaCancelamentoRepository cria uma nova instância do repositório de políticas de cancelamento
+func NewPoliticaCancelamentoRepository(db *gorm.DB) domain.PoliticaCancelamentoRepository {
+	return &politicaCancelamentoRepository{db: db}
+}
+
+func (r *politicaCancelamentoRepository) Create(ctx context.Context, politica *domain.PoliticaCancelamento) error {
+	return dbFromContext(ctx, r.db).Create(politica).Error
+}
+
+// Update grava a política substituindo todas as suas faixas
+func (r *politicaCancelamentoRepository) Update(ctx context.Context, politica *domain.PoliticaCancelamento) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("politica_id = ?", politica.ID).Delete(&domain.FaixaCancelamento{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit("Faixas").Save(politica).Error; err != nil {
+			return err
+		}
+		if len(politica.Faixas) == 0 {
+			return nil
+		}
+		return tx.Create(&politica.Faixas).Error
+	})
+}
+
+func (r *politicaCancelamentoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.PoliticaCancelamento{}, "id = ?", id).Error
+}
+
+func (r *politicaCancelamentoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PoliticaCancelamento, error) {
+	var politica domain.PoliticaCancelamento
+	err := dbFromContext(ctx, r.db).
+		Preload("Faixas", func(db *gorm.DB) *gorm.DB {
+			return db.Order("horas_antecedencia DESC")
+		}).
+		First(&politica, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &politica, nil
+}
+
+func (r *politicaCancelamentoRepository) List(ctx context.Context) ([]*domain.PoliticaCancelamento, error) {
+	var politicas []*domain.PoliticaCancelamento
+	err := dbFromContext(ctx, r.db).
+		Preload("Faixas", func(db *gorm.DB) *gorm.DB {
+			return db.Order("horas_antecedencia DESC")
+		}).
+		Order("cliente_id NULLS FIRST, nome ASC").
+		Find(&politicas).Error
+	if err != nil {
+		return nil, err
+	}
+	return politicas, nil
+}
+
+// GetAplicavel retorna a política do cliente ou, na falta dela, a política
+// padrão cadastrada. Retorna nil quando nenhuma das duas existe.
+func (r *politicaCancelamentoRepository) GetAplicavel(ctx context.Context, clienteID uuid.UUID) (*domain.PoliticaCancelamento, error) {
+	var politicas []*domain.PoliticaCancelamento
+	err := dbFromContext(ctx, r.db).
+		Preload("Faixas").
+		Where("cliente_id = ? OR cliente_id IS NULL", clienteID).
+		Order("cliente_id NULLS LAST").
+		Limit(1).
+		Find(&politicas).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(politicas) == 0 {
+		return nil, nil
+	}
+	return politicas[0], nil
+}
@@ -3,14 +3,18 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"agencia-viagens/internal/config"
 	"agencia-viagens/internal/domain"
 
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
@@ -57,6 +61,28 @@ func autoMigrate(db *gorm.DB) error {
 		&domain.Veiculo{},
 		&domain.Motorista{},
 		&domain.Cliente{},
+		&domain.GrupoViagem{},
+		&domain.PoliticaCancelamento{},
+		&domain.FaixaCancelamento{},
+		&domain.TabelaPreco{},
+		&domain.Feriado{},
+		&domain.Cotacao{},
+		&domain.ItemCotacao{},
+		&domain.PreReserva{},
+		&domain.EventoViagem{},
+		&domain.RegistroViagem{},
+		&domain.DespesaViagem{},
+		&domain.OrdemManutencao{},
+		&domain.PlanoManutencao{},
+		&domain.DocumentoVeiculo{},
+		&domain.IndisponibilidadeVeiculo{},
+		&domain.Abastecimento{},
+		&domain.Comodidade{},
+		&domain.CustoFixoVeiculo{},
+		&domain.Anexo{},
+		&domain.ModeloChecklist{},
+		&domain.InspecaoVeiculo{},
+		&domain.LancamentoHoras{},
 	}
 
 	// Executa as migrações
@@ -64,6 +90,119 @@ func autoMigrate(db *gorm.DB) error {
 		return fmt.Errorf("erro ao executar migrações automáticas: %v", err)
 	}
 
+	if err := migrarBancoHoras(db); err != nil {
+		return fmt.Errorf("erro ao migrar banco de horas: %v", err)
+	}
+
+	if err := sincronizarDocumentacaoVeiculos(db); err != nil {
+		return fmt.Errorf("erro ao sincronizar documentação dos veículos: %v", err)
+	}
+
+	if err := normalizarPlacas(db); err != nil {
+		return fmt.Errorf("erro ao normalizar placas: %v", err)
+	}
+
+	// Catálogo inicial de comodidades; códigos já cadastrados são mantidos
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(domain.ComodidadesPadrao()).Error; err != nil {
+		return fmt.Errorf("erro ao criar catálogo de comodidades: %v", err)
+	}
+
+	return nil
+}
+
+// migrarBancoHoras converte o antigo contador banco_horas dos motoristas, em
+// minutos, em um ajuste de abertura do novo banco de horas e remove a coluna.
+// As viagens concluídas são lançadas depois, a partir do check-out
+// (BancoHorasUseCase.LancarViagensPendentes).
+func migrarBancoHoras(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.Motorista{}, "banco_horas") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var saldos []struct {
+			ID         uuid.UUID
+			BancoHoras int
+		}
+		err := tx.Model(&domain.Motorista{}).
+			Select("id, banco_horas").
+			Where("banco_horas <> 0").
+			Scan(&saldos).Error
+		if err != nil {
+			return err
+		}
+
+		agora := time.Now()
+		for _, s := range saldos {
+			ajuste := domain.NewAjusteHoras(s.ID, agora, s.BancoHoras, "Saldo anterior do banco de horas")
+			if err := tx.Create(ajuste).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropColumn(&domain.Motorista{}, "banco_horas")
+	})
+}
+
+// sincronizarDocumentacaoVeiculos alinha a situação da documentação dos
+// veículos aos documentos cadastrados. Veículos criados antes do cadastro de
+// documentos traziam a documentação válida por padrão, com vencimento em um
+// ano: sem os documentos obrigatórios em dia, passam a ficar pendentes, e sem
+// nenhum documento perdem o vencimento herdado.
+func sincronizarDocumentacaoVeiculos(db *gorm.DB) error {
+	emDia := db.Model(&domain.DocumentoVeiculo{}).
+		Select("veiculo_id").
+		Where("tipo IN ? AND valido_ate >= ?", domain.DocumentosObrigatorios, time.Now()).
+		Group("veiculo_id").
+		Having("COUNT(DISTINCT tipo) = ?", len(domain.DocumentosObrigatorios))
+	err := db.Model(&domain.Veiculo{}).
+		Where("documentacao_valida AND id NOT IN (?)", emDia).
+		UpdateColumn("documentacao_valida", false).Error
+	if err != nil {
+		return err
+	}
+
+	comDocumentos := db.Model(&domain.DocumentoVeiculo{}).Select("veiculo_id")
+	return db.Model(&domain.Veiculo{}).
+		Where("vencimento_documentacao <> ? AND id NOT IN (?)", time.Time{}, comDocumentos).
+		UpdateColumn("vencimento_documentacao", time.Time{}).Error
+}
+
+// normalizarPlacas grava sem hífen e em maiúsculas as placas cadastradas
+// antes da normalização. As que colidiriam com a de outro veículo (ABC-1234 e
+// abc1234) são mantidas como estão, e esses veículos, assim como os cadastrados
+// com a mesma placa nos padrões antigo e Mercosul (ABC1234 e ABC1C34), são
+// relatados no log para correção manual.
+func normalizarPlacas(db *gorm.DB) error {
+	err := db.Exec(`UPDATE veiculos SET placa = UPPER(REPLACE(placa, '-', ''))
+		WHERE placa <> UPPER(REPLACE(placa, '-', ''))
+		AND NOT EXISTS (SELECT 1 FROM veiculos v WHERE v.id <> veiculos.id
+			AND UPPER(REPLACE(v.placa, '-', '')) = UPPER(REPLACE(veiculos.placa, '-', '')))`).Error
+	if err != nil {
+		return err
+	}
+
+	var veiculos []struct {
+		ID    uuid.UUID
+		Placa string
+	}
+	if err := db.Model(&domain.Veiculo{}).Select("id, placa").Order("placa").Scan(&veiculos).Error; err != nil {
+		return err
+	}
+
+	grupos := make(map[string][]string)
+	var placas []string
+	for _, v := range veiculos {
+		placa := domain.PlacaMercosul(v.Placa)
+		if len(grupos[placa]) == 1 {
+			placas = append(placas, placa)
+		}
+		grupos[placa] = append(grupos[placa], fmt.Sprintf("%s (%s)", v.Placa, v.ID))
+	}
+	for _, placa := range placas {
+		log.Printf("AVISO: veículos com a mesma placa %s precisam ser corrigidos: %s",
+			placa, strings.Join(grupos[placa], ", "))
+	}
 	return nil
 }
 
@@ -109,3 +248,11 @@ func GetTxFromContext(ctx context.Context) *gorm.DB {
 	}
 	return nil
 }
+
+// dbFromContext retorna a transação do contexto, se houver, ou a conexão padrão
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx := GetTxFromContext(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
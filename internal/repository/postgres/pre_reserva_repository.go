@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type preReservaRepository struct {
+	db *gorm.DB
+}
+
+// NewPreReservaRepository cria uma nova instância do repositório de pré-reservas
+func NewPreReservaRepository(db *gorm.DB) domain.PreReservaRepository {
+	return &preReservaRepository{db: db}
+}
+
+func (r *preReservaRepository) Create(ctx context.Context, reserva *domain.PreReserva) error {
+	return dbFromContext(ctx, r.db).Create(reserva).Error
+}
+
+// Encerrar grava a confirmação, liberação ou expiração da pré-reserva. A
+// gravação só ocorre se ela ainda estiver ativa no banco; caso outra operação
+// a tenha encerrado antes, retorna ErrPreReservaInativa.
+func (r *preReservaRepository) Encerrar(ctx context.Context, reserva *domain.PreReserva) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.PreReserva{}).
+		Where("id = ? AND status = ?", reserva.ID, domain.StatusPreReservaAtiva).
+		Updates(map[string]interface{}{
+			"status":     reserva.Status,
+			"viagem_id":  reserva.ViagemID,
+			"updated_at": reserva.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrPreReservaInativa
+	}
+	return nil
+}
+
+func (r *preReservaRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PreReserva, error) {
+	var reserva domain.PreReserva
+	err := dbFromContext(ctx, r.db).First(&reserva, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reserva, nil
+}
+
+func (r *preReservaRepository) List(ctx context.Context, offset, limit int) ([]*domain.PreReserva, error) {
+	var reservas []*domain.PreReserva
+	err := dbFromContext(ctx, r.db).
+		Offset(offset).
+		Limit(limit).
+		Order("expira_em DESC").
+		Find(&reservas).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservas, nil
+}
+
+// GetVencidas retorna as pré-reservas ainda ativas cujo prazo já passou
+func (r *preReservaRepository) GetVencidas(ctx context.Context, agora time.Time) ([]*domain.PreReserva, error) {
+	var reservas []*domain.PreReserva
+	err := dbFromContext(ctx, r.db).
+		Where("status = ? AND expira_em <= ?", domain.StatusPreReservaAtiva, agora).
+		Order("expira_em ASC").
+		Find(&reservas).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservas, nil
+}
+
+// CheckMotoristaReservado verifica se o motorista está bloqueado por alguma
+// pré-reserva ativa no período
+func (r *preReservaRepository) CheckMotoristaReservado(ctx context.Context, motoristaID uuid.UUID,
+	dataInicio, dataFim time.Time) (bool, error) {
+	var count int64
+	err := preReservasAtivas(dbFromContext(ctx, r.db), "id", dataInicio, dataFim).
+		Where("motorista_id = ?", motoristaID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetAtivasPorPeriodo retorna as pré-reservas que ainda bloqueiam recursos no período
+func (r *preReservaRepository) GetAtivasPorPeriodo(ctx context.Context,
+	dataInicio, dataFim time.Time) ([]*domain.PreReserva, error) {
+	var reservas []*domain.PreReserva
+	err := preReservasAtivas(dbFromContext(ctx, r.db), "*", dataInicio, dataFim).
+		Order("data_inicio ASC").
+		Find(&reservas).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservas, nil
+}
+
+// preReservasAtivas monta a subconsulta que seleciona a coluna informada das
+// pré-reservas que ainda bloqueiam recursos no período
+func preReservasAtivas(db *gorm.DB, coluna string, dataInicio, dataFim time.Time) *gorm.DB {
+	return db.Model(&domain.PreReserva{}).
+		Select(coluna).
+		Where("status = ? AND expira_em > ? AND data_inicio < ? AND data_fim > ?",
+			domain.StatusPreReservaAtiva, time.Now(), dataFim, dataInicio)
+}
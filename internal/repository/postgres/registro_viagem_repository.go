@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type registroViagemRepository struct {
+	db *gorm.DB
+}
+
+// NewRegistroViagemRepository cria uma nova instância do repositório de registros de viagem
+func NewRegistroViagemRepository(db *gorm.DB) domain.RegistroViagemRepository {
+	return &registroViagemRepository{db: db}
+}
+
+func (r *registroViagemRepository) Create(ctx context.Context, registro *domain.RegistroViagem) error {
+	return dbFromContext(ctx, r.db).Create(registro).Error
+}
+
+// GetByViagem retorna os registros de check-in e check-out da viagem
+func (r *registroViagemRepository) GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.RegistroViagem, error) {
+	var registros []*domain.RegistroViagem
+	err := dbFromContext(ctx, r.db).
+		Where("viagem_id = ?", viagemID).
+		Order("registrado_em ASC").
+		Find(&registros).Error
+	if err != nil {
+		return nil, err
+	}
+	return registros, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+
+	"agencia-viagens/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type tabelaPrecoRepository struct {
+	db *gorm.DB
+}
+
+// NewTabelaPrecoRepository cria uma nova instância do repositório de tabelas de preço
+func NewTabelaPrecoRepository(db *gorm.DB) domain.TabelaPrecoRepository {
+	return &tabelaPrecoRepository{db: db}
+}
+
+func (r *tabelaPrecoRepository) Create(ctx context.Context, tabela *domain.TabelaPreco) error {
+	return dbFromContext(ctx, r.db).Create(tabela).Error
+}
+
+func (r *tabelaPrecoRepository) Update(ctx context.Context, tabela *domain.TabelaPreco) error {
+	return dbFromContext(ctx, r.db).Save(tabela).Error
+}
+
+func (r *tabelaPrecoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&domain.TabelaPreco{}, "id = ?", id).Error
+}
+
+func (r *tabelaPrecoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.TabelaPreco, error) {
+	var tabela domain.TabelaPreco
+	err := dbFromContext(ctx, r.db).First(&tabela, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tabela, nil
+}
+
+func (r *tabelaPrecoRepository) List(ctx context.Context) ([]*domain.TabelaPreco, error) {
+	var tabelas []*domain.TabelaPreco
+	err := dbFromContext(ctx, r.db).
+		Order("cliente_id NULLS FIRST, tipo_veiculo ASC").
+		Find(&tabelas).Error
+	if err != nil {
+		return nil, err
+	}
+	return tabelas, nil
+}
+
+// GetAplicavel retorna a tabela do cliente para o tipo de veículo ou, na falta
+// dela, a tabela padrão do tipo. Retorna nil quando nenhuma das duas existe.
+func (r *tabelaPrecoRepository) GetAplicavel(ctx context.Context, clienteID uuid.UUID,
+	tipo domain.TipoVeiculo) (*domain.TabelaPreco, error) {
+	var tabelas []*domain.TabelaPreco
+	err := dbFromContext(ctx, r.db).
+		Where("tipo_veiculo = ? AND (cliente_id = ? OR cliente_id IS NULL)", tipo, clienteID).
+		Order("cliente_id NULLS LAST").
+		Limit(1).
+		Find(&tabelas).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(tabelas) == 0 {
+		return nil, nil
+	}
+	return tabelas[0], nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type veiculoRepository struct {
@@ -20,29 +21,39 @@ func NewVeiculoRepository(db *gorm.DB) domain.VeiculoRepository {
 }
 
 func (r *veiculoRepository) Create(ctx context.Context, veiculo *domain.Veiculo) error {
-	return r.db.WithContext(ctx).Create(veiculo).Error
+	return dbFromContext(ctx, r.db).Create(veiculo).Error
 }
 
 func (r *veiculoRepository) Update(ctx context.Context, veiculo *domain.Veiculo) error {
-	return r.db.WithContext(ctx).Save(veiculo).Error
+	return dbFromContext(ctx, r.db).Save(veiculo).Error
 }
 
 func (r *veiculoRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.Veiculo{}, "id = ?", id).Error
+	return dbFromContext(ctx, r.db).Delete(&domain.Veiculo{}, "id = ?", id).Error
 }
 
 func (r *veiculoRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Veiculo, error) {
 	var veiculo domain.Veiculo
-	err := r.db.WithContext(ctx).First(&veiculo, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&veiculo, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &veiculo, nil
 }
 
+// Bloquear trava a linha do veículo (SELECT ... FOR UPDATE) até o fim da
+// transação do contexto, serializando os agendamentos que o disputam
+func (r *veiculoRepository) Bloquear(ctx context.Context, id uuid.UUID) error {
+	var veiculo domain.Veiculo
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&veiculo, "id = ?", id).Error
+}
+
 func (r *veiculoRepository) List(ctx context.Context, offset, limit int) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Offset(offset).
 		Limit(limit).
 		Order("placa ASC").
@@ -53,18 +64,59 @@ func (r *veiculoRepository) List(ctx context.Context, offset, limit int) ([]*dom
 	return veiculos, nil
 }
 
+// GetByPlaca busca o veículo pela placa em qualquer dos padrões, antigo ou
+// Mercosul, com ou sem hífen. A comparação normaliza também a placa gravada,
+// para encontrar as que normalizarPlacas manteve na grafia original. Se a
+// placa corresponde a mais de um veículo, cadastrados antes da normalização,
+// retorna domain.ErrPlacaAmbigua.
 func (r *veiculoRepository) GetByPlaca(ctx context.Context, placa string) (*domain.Veiculo, error) {
-	var veiculo domain.Veiculo
-	err := r.db.WithContext(ctx).First(&veiculo, "placa = ?", placa).Error
+	var veiculos []*domain.Veiculo
+	err := dbFromContext(ctx, r.db).
+		Where("UPPER(REPLACE(placa, '-', '')) IN ?", domain.FormasPlaca(placa)).
+		Limit(2).
+		Find(&veiculos).Error
 	if err != nil {
 		return nil, err
 	}
-	return &veiculo, nil
+
+	switch len(veiculos) {
+	case 0:
+		return nil, gorm.ErrRecordNotFound
+	case 1:
+		return veiculos[0], nil
+	default:
+		return nil, domain.ErrPlacaAmbigua
+	}
+}
+
+// Search retorna a página de veículos que atende ao filtro
+func (r *veiculoRepository) Search(ctx context.Context, filtro domain.FiltroVeiculo) ([]*domain.Veiculo, error) {
+	query := dbFromContext(ctx, r.db)
+	if filtro.Status != "" {
+		query = query.Where("status = ?", filtro.Status)
+	}
+	if filtro.Tipo != "" {
+		query = query.Where("tipo = ?", filtro.Tipo)
+	}
+	if len(filtro.Comodidades) > 0 {
+		query = query.Where("comodidades @> ?::jsonb", jsonComodidades(filtro.Comodidades))
+	}
+
+	var veiculos []*domain.Veiculo
+	err := query.
+		Offset(filtro.Offset).
+		Limit(filtro.Limit).
+		Order("placa ASC").
+		Find(&veiculos).Error
+	if err != nil {
+		return nil, err
+	}
+	return veiculos, nil
 }
 
 func (r *veiculoRepository) GetByStatus(ctx context.Context, status domain.StatusVeiculo) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("status = ?", status).
 		Order("placa ASC").
 		Find(&veiculos).Error
@@ -76,7 +128,7 @@ func (r *veiculoRepository) GetByStatus(ctx context.Context, status domain.Statu
 
 func (r *veiculoRepository) GetByTipo(ctx context.Context, tipo domain.TipoVeiculo) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("tipo = ?", tipo).
 		Order("placa ASC").
 		Find(&veiculos).Error
@@ -86,20 +138,27 @@ func (r *veiculoRepository) GetByTipo(ctx context.Context, tipo domain.TipoVeicu
 	return veiculos, nil
 }
 
-func (r *veiculoRepository) GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Veiculo, error) {
+// GetDisponiveis retorna os veículos livres no período que oferecem todas as
+// comodidades informadas. Só os veículos em manutenção ou inativos ficam de
+// fora pelo status; o veículo em uso agora pode estar livre no período.
+func (r *veiculoRepository) GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time,
+	comodidades []string) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
 
 	// Subquery para encontrar veículos ocupados no período
-	subQuery := r.db.Model(&domain.Viagem{}).
-		Select("veiculo_id").
-		Where("status != ? AND ((data_inicio BETWEEN ? AND ?) OR (data_fim BETWEEN ? AND ?))",
-			domain.StatusCancelada, dataInicio, dataFim, dataInicio, dataFim)
+	subQuery := viagensNoPeriodo(r.db, "veiculo_id", dataInicio, dataFim)
 
 	// Query principal para encontrar veículos disponíveis
-	err := r.db.WithContext(ctx).
-		Where("status = ? AND id NOT IN (?)", domain.StatusDisponivel, subQuery).
-		Order("placa ASC").
-		Find(&veiculos).Error
+	query := dbFromContext(ctx, r.db).
+		Where("status NOT IN ? AND id NOT IN (?)",
+			[]string{string(domain.StatusManutencao), string(domain.StatusInativo)}, subQuery).
+		Where("id NOT IN (?)", preReservasAtivas(r.db, "veiculo_id", dataInicio, dataFim)).
+		Where("id NOT IN (?)", indisponibilidadesNoPeriodo(r.db, "veiculo_id", dataInicio, dataFim))
+	if len(comodidades) > 0 {
+		query = query.Where("comodidades @> ?::jsonb", jsonComodidades(comodidades))
+	}
+
+	err := query.Order("placa ASC").Find(&veiculos).Error
 	if err != nil {
 		return nil, err
 	}
@@ -109,10 +168,12 @@ func (r *veiculoRepository) GetDisponiveis(ctx context.Context, dataInicio, data
 // Métodos auxiliares para manutenção
 
 // GetVeiculosProximaManutencao retorna veículos que precisam de manutenção
+// no próximo mês ou que estão perto da quilometragem de manutenção
 func (r *veiculoRepository) GetVeiculosProximaManutencao(ctx context.Context) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
-	err := r.db.WithContext(ctx).
-		Where("proxima_manutencao <= ?", time.Now().AddDate(0, 1, 0)). // Próximo mês
+	err := dbFromContext(ctx, r.db).
+		Where("proxima_manutencao <= ? OR (proxima_manutencao_km > 0 AND odometro_atual >= proxima_manutencao_km - ?)",
+			time.Now().AddDate(0, 1, 0), domain.MargemAvisoManutencaoKm).
 		Order("proxima_manutencao ASC").
 		Find(&veiculos).Error
 	if err != nil {
@@ -121,11 +182,20 @@ func (r *veiculoRepository) GetVeiculosProximaManutencao(ctx context.Context) ([
 	return veiculos, nil
 }
 
-// GetVeiculosDocumentacaoVencida retorna veículos com documentação vencida
+// GetVeiculosDocumentacaoVencida retorna veículos a que falta algum documento
+// obrigatório dentro da validade
 func (r *veiculoRepository) GetVeiculosDocumentacaoVencida(ctx context.Context) ([]*domain.Veiculo, error) {
 	var veiculos []*domain.Veiculo
-	err := r.db.WithContext(ctx).
-		Where("documentacao_valida = ? AND vencimento_documentacao <= ?", true, time.Now()).
+
+	// Subquery para encontrar veículos com todos os documentos obrigatórios vigentes
+	emDia := r.db.Model(&domain.DocumentoVeiculo{}).
+		Select("veiculo_id").
+		Where("tipo IN ? AND valido_ate >= ?", domain.DocumentosObrigatorios, time.Now()).
+		Group("veiculo_id").
+		Having("COUNT(DISTINCT tipo) = ?", len(domain.DocumentosObrigatorios))
+
+	err := dbFromContext(ctx, r.db).
+		Where("id NOT IN (?)", emDia).
 		Order("vencimento_documentacao ASC").
 		Find(&veiculos).Error
 	if err != nil {
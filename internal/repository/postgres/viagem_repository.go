@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"agencia-viagens/internal/domain"
@@ -20,22 +22,23 @@ func NewViagemRepository(db *gorm.DB) domain.ViagemRepository {
 }
 
 func (r *viagemRepository) Create(ctx context.Context, viagem *domain.Viagem) error {
-	return r.db.WithContext(ctx).Create(viagem).Error
+	return dbFromContext(ctx, r.db).Create(viagem).Error
 }
 
 func (r *viagemRepository) Update(ctx context.Context, viagem *domain.Viagem) error {
-	return r.db.WithContext(ctx).Save(viagem).Error
+	return dbFromContext(ctx, r.db).Save(viagem).Error
 }
 
 func (r *viagemRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.Viagem{}, "id = ?", id).Error
+	return dbFromContext(ctx, r.db).Delete(&domain.Viagem{}, "id = ?", id).Error
 }
 
 func (r *viagemRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Viagem, error) {
 	var viagem domain.Viagem
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Veiculo").
 		Preload("Motorista").
+		Preload("MotoristaSecundario").
 		Preload("Cliente").
 		First(&viagem, "id = ?", id).Error
 	if err != nil {
@@ -46,9 +49,10 @@ func (r *viagemRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.V
 
 func (r *viagemRepository) List(ctx context.Context, offset, limit int) ([]*domain.Viagem, error) {
 	var viagens []*domain.Viagem
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Veiculo").
 		Preload("Motorista").
+		Preload("MotoristaSecundario").
 		Preload("Cliente").
 		Offset(offset).
 		Limit(limit).
@@ -60,14 +64,96 @@ func (r *viagemRepository) List(ctx context.Context, offset, limit int) ([]*doma
 	return viagens, nil
 }
 
+// Search retorna a página de viagens que atende ao filtro e o total de
+// viagens encontradas sem paginação
+func (r *viagemRepository) Search(ctx context.Context, filtro domain.FiltroViagem) ([]*domain.Viagem, int64, error) {
+	var total int64
+	err := filtrarViagens(dbFromContext(ctx, r.db), filtro).
+		Model(&domain.Viagem{}).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var viagens []*domain.Viagem
+	err = filtrarViagens(dbFromContext(ctx, r.db), filtro).
+		Preload("Veiculo").
+		Preload("Motorista").
+		Preload("MotoristaSecundario").
+		Preload("Cliente").
+		Order(ordenacaoViagem(filtro)).
+		Offset(filtro.Offset).
+		Limit(filtro.Limit).
+		Find(&viagens).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return viagens, total, nil
+}
+
+// filtrarViagens aplica à consulta os critérios preenchidos do filtro
+func filtrarViagens(query *gorm.DB, filtro domain.FiltroViagem) *gorm.DB {
+	if filtro.Status != "" {
+		query = query.Where("status = ?", filtro.Status)
+	}
+	if !filtro.DataInicio.IsZero() {
+		query = query.Where("data_fim >= ?", filtro.DataInicio)
+	}
+	if !filtro.DataFim.IsZero() {
+		query = query.Where("data_inicio <= ?", filtro.DataFim)
+	}
+	if filtro.VeiculoID != nil {
+		query = query.Where("veiculo_id = ?", *filtro.VeiculoID)
+	}
+	if filtro.MotoristaID != nil {
+		query = query.Where("(motorista_id = ? OR motorista_secundario_id = ?)", *filtro.MotoristaID, *filtro.MotoristaID)
+	}
+	if filtro.ClienteID != nil {
+		query = query.Where("cliente_id = ?", *filtro.ClienteID)
+	}
+	if filtro.Origem != "" {
+		query = query.Where("origem ILIKE ?", "%"+escaparLike(filtro.Origem)+"%")
+	}
+	if filtro.Destino != "" {
+		query = query.Where("destino ILIKE ?", "%"+escaparLike(filtro.Destino)+"%")
+	}
+	return query
+}
+
+// escaparLike escapa os curingas do LIKE para que o texto buscado seja
+// comparado literalmente. A barra invertida é o escape padrão do PostgreSQL.
+func escaparLike(texto string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(texto)
+}
+
+// ordenacaoViagem monta a cláusula de ordenação da busca. Campos fora da lista
+// permitida são substituídos pela data de início.
+func ordenacaoViagem(filtro domain.FiltroViagem) string {
+	campo := "data_inicio"
+	for _, permitido := range domain.CamposOrdenacaoViagem {
+		if filtro.OrdenarPor == permitido {
+			campo = permitido
+			break
+		}
+	}
+
+	direcao := "ASC"
+	if filtro.Decrescente {
+		direcao = "DESC"
+	}
+
+	// O ID desempata para que a paginação seja estável
+	return fmt.Sprintf("%s %s, id ASC", campo, direcao)
+}
+
 func (r *viagemRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID,
 	dataInicio, dataFim time.Time) ([]*domain.Viagem, error) {
 	var viagens []*domain.Viagem
-	err := r.db.WithContext(ctx).
-		Where("veiculo_id = ? AND ((data_inicio BETWEEN ? AND ?) OR (data_fim BETWEEN ? AND ?))",
-			veiculoID, dataInicio, dataFim, dataInicio, dataFim).
+	err := dbFromContext(ctx, r.db).
+		Where("veiculo_id = ? AND data_inicio < ? AND data_fim > ?", veiculoID, dataFim, dataInicio).
 		Preload("Veiculo").
 		Preload("Motorista").
+		Preload("MotoristaSecundario").
 		Preload("Cliente").
 		Order("data_inicio ASC").
 		Find(&viagens).Error
@@ -80,11 +166,12 @@ func (r *viagemRepository) GetByVeiculo(ctx context.Context, veiculoID uuid.UUID
 func (r *viagemRepository) GetByMotorista(ctx context.Context, motoristaID uuid.UUID,
 	dataInicio, dataFim time.Time) ([]*domain.Viagem, error) {
 	var viagens []*domain.Viagem
-	err := r.db.WithContext(ctx).
-		Where("motorista_id = ? AND ((data_inicio BETWEEN ? AND ?) OR (data_fim BETWEEN ? AND ?))",
-			motoristaID, dataInicio, dataFim, dataInicio, dataFim).
+	err := dbFromContext(ctx, r.db).
+		Where("(motorista_id = ? OR motorista_secundario_id = ?) AND data_inicio < ? AND data_fim > ?",
+			motoristaID, motoristaID, dataFim, dataInicio).
 		Preload("Veiculo").
 		Preload("Motorista").
+		Preload("MotoristaSecundario").
 		Preload("Cliente").
 		Order("data_inicio ASC").
 		Find(&viagens).Error
@@ -96,10 +183,11 @@ func (r *viagemRepository) GetByMotorista(ctx context.Context, motoristaID uuid.
 
 func (r *viagemRepository) GetByCliente(ctx context.Context, clienteID uuid.UUID) ([]*domain.Viagem, error) {
 	var viagens []*domain.Viagem
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("cliente_id = ?", clienteID).
 		Preload("Veiculo").
 		Preload("Motorista").
+		Preload("MotoristaSecundario").
 		Preload("Cliente").
 		Order("data_inicio DESC").
 		Find(&viagens).Error
@@ -109,16 +197,100 @@ func (r *viagemRepository) GetByCliente(ctx context.Context, clienteID uuid.UUID
 	return viagens, nil
 }
 
+// CheckDisponibilidade informa se o veículo está livre no período. A viagem
+// excluirViagemID, quando diferente de uuid.Nil, não conta como conflito, o
+// que permite reagendar uma viagem sem que ela bloqueie a si mesma.
 func (r *viagemRepository) CheckDisponibilidade(ctx context.Context, veiculoID uuid.UUID,
-	dataInicio, dataFim time.Time) (bool, error) {
+	dataInicio, dataFim time.Time, excluirViagemID uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
-		Model(&domain.Viagem{}).
-		Where("veiculo_id = ? AND status != ? AND ((data_inicio BETWEEN ? AND ?) OR (data_fim BETWEEN ? AND ?))",
-			veiculoID, domain.StatusCancelada, dataInicio, dataFim, dataInicio, dataFim).
+	err := viagensNoPeriodo(dbFromContext(ctx, r.db), "id", dataInicio, dataFim).
+		Where("veiculo_id = ? AND id != ?", veiculoID, excluirViagemID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	// Pré-reservas ativas também bloqueiam o veículo
+	err = preReservasAtivas(dbFromContext(ctx, r.db), "id", dataInicio, dataFim).
+		Where("veiculo_id = ?", veiculoID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	// Assim como as indisponibilidades programadas
+	err = indisponibilidadesNoPeriodo(dbFromContext(ctx, r.db), "id", dataInicio, dataFim).
+		Where("veiculo_id = ?", veiculoID).
 		Count(&count).Error
 	if err != nil {
 		return false, err
 	}
 	return count == 0, nil
 }
+
+// GetEncerradasPorPeriodo retorna as viagens concluídas ou canceladas cujo
+// início está no período informado
+func (r *viagemRepository) GetEncerradasPorPeriodo(ctx context.Context,
+	dataInicio, dataFim time.Time) ([]*domain.Viagem, error) {
+	var viagens []*domain.Viagem
+	err := dbFromContext(ctx, r.db).
+		Where("status IN ? AND data_inicio BETWEEN ? AND ?",
+			[]domain.StatusViagem{domain.StatusConcluida, domain.StatusCancelada}, dataInicio, dataFim).
+		Order("data_inicio ASC").
+		Find(&viagens).Error
+	if err != nil {
+		return nil, err
+	}
+	return viagens, nil
+}
+
+// GetConcluidasSemLancamentoHoras retorna as viagens concluídas com check-out
+// registrado que ainda não foram lançadas no banco de horas
+func (r *viagemRepository) GetConcluidasSemLancamentoHoras(ctx context.Context) ([]*domain.Viagem, error) {
+	comCheckOut := dbFromContext(ctx, r.db).Model(&domain.RegistroViagem{}).
+		Select("viagem_id").
+		Where("tipo = ?", domain.RegistroCheckOut)
+	lancadas := dbFromContext(ctx, r.db).Model(&domain.LancamentoHoras{}).
+		Select("viagem_id").
+		Where("viagem_id IS NOT NULL")
+
+	var viagens []*domain.Viagem
+	err := dbFromContext(ctx, r.db).
+		Where("status = ? AND id IN (?) AND id NOT IN (?)", domain.StatusConcluida, comCheckOut, lancadas).
+		Order("data_inicio ASC").
+		Find(&viagens).Error
+	if err != nil {
+		return nil, err
+	}
+	return viagens, nil
+}
+
+// GetAtivasPorPeriodo retorna as viagens não canceladas que ocupam qualquer
+// parte do período informado
+func (r *viagemRepository) GetAtivasPorPeriodo(ctx context.Context,
+	dataInicio, dataFim time.Time) ([]*domain.Viagem, error) {
+	var viagens []*domain.Viagem
+	err := dbFromContext(ctx, r.db).
+		Where("status != ? AND data_inicio < ? AND data_fim > ?",
+			domain.StatusCancelada, dataFim, dataInicio).
+		Order("data_inicio ASC").
+		Find(&viagens).Error
+	if err != nil {
+		return nil, err
+	}
+	return viagens, nil
+}
+
+// viagensNoPeriodo monta a subconsulta que seleciona a coluna informada das
+// viagens não canceladas que se sobrepõem ao período
+func viagensNoPeriodo(db *gorm.DB, coluna string, dataInicio, dataFim time.Time) *gorm.DB {
+	return db.Model(&domain.Viagem{}).
+		Select(coluna).
+		Where("status != ? AND data_inicio < ? AND data_fim > ?", domain.StatusCancelada, dataFim, dataInicio)
+}
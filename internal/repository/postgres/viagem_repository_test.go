@@ -0,0 +1,18 @@
+package postgres
+
+import "testing"
+
+func TestEscaparLike(t *testing.T) {
+	casos := map[string]string{
+		"São Paulo":  "São Paulo",
+		"100%":       `100\%`,
+		"rio_claro":  `rio\_claro`,
+		`C:\viagens`: `C:\\viagens`,
+		`%_\`:        `\%\_\\`,
+	}
+	for texto, esperado := range casos {
+		if escapado := escaparLike(texto); escapado != esperado {
+			t.Errorf("escaparLike(%q) = %q, esperado %q", texto, escapado, esperado)
+		}
+	}
+}
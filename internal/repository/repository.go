@@ -19,12 +19,16 @@ type ViagemRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Viagem, error)
 	List(ctx context.Context, offset, limit int) ([]*domain.Viagem, error)
+	Search(ctx context.Context, filtro domain.FiltroViagem) ([]*domain.Viagem, int64, error)
 
 	// Métodos específicos
 	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.Viagem, error)
 	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.Viagem, error)
 	GetByCliente(ctx context.Context, clienteID uuid.UUID) ([]*domain.Viagem, error)
-	CheckDisponibilidade(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) (bool, error)
+	CheckDisponibilidade(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time, excluirViagemID uuid.UUID) (bool, error)
+	GetAtivasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Viagem, error)
+	GetEncerradasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Viagem, error)
+	GetConcluidasSemLancamentoHoras(ctx context.Context) ([]*domain.Viagem, error)
 }
 
 // VeiculoRepository define as operações do repositório de veículos
@@ -36,10 +40,13 @@ type VeiculoRepository interface {
 	List(ctx context.Context, offset, limit int) ([]*domain.Veiculo, error)
 
 	// Métodos específicos
+	Search(ctx context.Context, filtro domain.FiltroVeiculo) ([]*domain.Veiculo, error)
 	GetByPlaca(ctx context.Context, placa string) (*domain.Veiculo, error)
 	GetByStatus(ctx context.Context, status domain.StatusVeiculo) ([]*domain.Veiculo, error)
 	GetByTipo(ctx context.Context, tipo domain.TipoVeiculo) ([]*domain.Veiculo, error)
-	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Veiculo, error)
+	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time, comodidades []string) ([]*domain.Veiculo, error)
+	Bloquear(ctx context.Context, id uuid.UUID) error
+	GetVeiculosDocumentacaoVencida(ctx context.Context) ([]*domain.Veiculo, error)
 }
 
 // MotoristaRepository define as operações do repositório de motoristas
@@ -54,7 +61,9 @@ type MotoristaRepository interface {
 	GetByCPF(ctx context.Context, cpf string) (*domain.Motorista, error)
 	GetByCNH(ctx context.Context, cnh string) (*domain.Motorista, error)
 	GetByStatus(ctx context.Context, status domain.StatusMotorista) ([]*domain.Motorista, error)
-	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Motorista, error)
+	GetDisponiveis(ctx context.Context, dataInicio, dataFim time.Time, categoriaMinima domain.TipoCNH) ([]*domain.Motorista, error)
+	Bloquear(ctx context.Context, id uuid.UUID) error
+	GetMotoristasBancoHorasExcedido(ctx context.Context, limiteHoras int) ([]*domain.Motorista, error)
 }
 
 // ClienteRepository define as operações do repositório de clientes
@@ -71,6 +80,220 @@ type ClienteRepository interface {
 	GetAtivos(ctx context.Context) ([]*domain.Cliente, error)
 }
 
+// GrupoViagemRepository define as operações do repositório de grupos de viagem
+type GrupoViagemRepository interface {
+	Create(ctx context.Context, grupo *domain.GrupoViagem) error
+	Update(ctx context.Context, grupo *domain.GrupoViagem) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GrupoViagem, error)
+	List(ctx context.Context, offset, limit int) ([]*domain.GrupoViagem, error)
+}
+
+// PoliticaCancelamentoRepository define as operações do repositório de políticas de cancelamento
+type PoliticaCancelamentoRepository interface {
+	Create(ctx context.Context, politica *domain.PoliticaCancelamento) error
+	Update(ctx context.Context, politica *domain.PoliticaCancelamento) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.PoliticaCancelamento, error)
+	List(ctx context.Context) ([]*domain.PoliticaCancelamento, error)
+
+	// Métodos específicos
+	GetAplicavel(ctx context.Context, clienteID uuid.UUID) (*domain.PoliticaCancelamento, error)
+}
+
+// TabelaPrecoRepository define as operações do repositório de tabelas de preço
+type TabelaPrecoRepository interface {
+	Create(ctx context.Context, tabela *domain.TabelaPreco) error
+	Update(ctx context.Context, tabela *domain.TabelaPreco) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.TabelaPreco, error)
+	List(ctx context.Context) ([]*domain.TabelaPreco, error)
+
+	// Métodos específicos
+	GetAplicavel(ctx context.Context, clienteID uuid.UUID, tipo domain.TipoVeiculo) (*domain.TabelaPreco, error)
+}
+
+// FeriadoRepository define as operações do repositório de feriados
+type FeriadoRepository interface {
+	Create(ctx context.Context, feriado *domain.Feriado) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Feriado, error)
+	List(ctx context.Context) ([]*domain.Feriado, error)
+
+	// Métodos específicos
+	GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]domain.Feriado, error)
+}
+
+// CotacaoRepository define as operações do repositório de cotações
+type CotacaoRepository interface {
+	Create(ctx context.Context, cotacao *domain.Cotacao) error
+	Update(ctx context.Context, cotacao *domain.Cotacao) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Cotacao, error)
+
+	// Métodos específicos
+	Converter(ctx context.Context, cotacao *domain.Cotacao) error
+}
+
+// PreReservaRepository define as operações do repositório de pré-reservas
+type PreReservaRepository interface {
+	Create(ctx context.Context, reserva *domain.PreReserva) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.PreReserva, error)
+	List(ctx context.Context, offset, limit int) ([]*domain.PreReserva, error)
+
+	// Métodos específicos
+	Encerrar(ctx context.Context, reserva *domain.PreReserva) error
+	GetVencidas(ctx context.Context, agora time.Time) ([]*domain.PreReserva, error)
+	CheckMotoristaReservado(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) (bool, error)
+	GetAtivasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.PreReserva, error)
+}
+
+// EventoViagemRepository define as operações do repositório de eventos de viagem
+type EventoViagemRepository interface {
+	Create(ctx context.Context, eventos ...*domain.EventoViagem) error
+
+	// Métodos específicos
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.EventoViagem, error)
+}
+
+// RegistroViagemRepository define as operações do repositório de registros de viagem
+type RegistroViagemRepository interface {
+	Create(ctx context.Context, registro *domain.RegistroViagem) error
+
+	// Métodos específicos
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.RegistroViagem, error)
+}
+
+// DespesaViagemRepository define as operações do repositório de despesas de viagem
+type DespesaViagemRepository interface {
+	Create(ctx context.Context, despesa *domain.DespesaViagem) error
+	Update(ctx context.Context, despesa *domain.DespesaViagem) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error)
+
+	// Métodos específicos
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.DespesaViagem, error)
+	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.DespesaViagem, error)
+	GetByViagens(ctx context.Context, viagemIDs []uuid.UUID) ([]*domain.DespesaViagem, error)
+	Avaliar(ctx context.Context, despesa *domain.DespesaViagem, statusAnterior domain.StatusDespesa) error
+	ComprovanteEmUso(ctx context.Context, anexoID uuid.UUID) (bool, error)
+}
+
+// OrdemManutencaoRepository define as operações do repositório de ordens de manutenção
+type OrdemManutencaoRepository interface {
+	Create(ctx context.Context, ordem *domain.OrdemManutencao) error
+	Update(ctx context.Context, ordem *domain.OrdemManutencao) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.OrdemManutencao, error)
+	CountEmAndamento(ctx context.Context, veiculoID uuid.UUID) (int64, error)
+	GetConcluidasPorPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.OrdemManutencao, error)
+}
+
+// PlanoManutencaoRepository define as operações do repositório de planos de manutenção
+type PlanoManutencaoRepository interface {
+	Create(ctx context.Context, plano *domain.PlanoManutencao) error
+	Update(ctx context.Context, plano *domain.PlanoManutencao) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.PlanoManutencao, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.PlanoManutencao, error)
+}
+
+// DocumentoVeiculoRepository define as operações do repositório de documentos de veículos
+type DocumentoVeiculoRepository interface {
+	Create(ctx context.Context, documento *domain.DocumentoVeiculo) error
+	Update(ctx context.Context, documento *domain.DocumentoVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.DocumentoVeiculo, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.DocumentoVeiculo, error)
+}
+
+// IndisponibilidadeVeiculoRepository define as operações do repositório de indisponibilidades de veículos
+type IndisponibilidadeVeiculoRepository interface {
+	Create(ctx context.Context, indisponibilidade *domain.IndisponibilidadeVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.IndisponibilidadeVeiculo, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.IndisponibilidadeVeiculo, error)
+}
+
+// AbastecimentoRepository define as operações do repositório de abastecimentos
+type AbastecimentoRepository interface {
+	Create(ctx context.Context, abastecimento *domain.Abastecimento) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Abastecimento, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.Abastecimento, error)
+	GetByPeriodo(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.Abastecimento, error)
+	ExisteLancamento(ctx context.Context, veiculoID uuid.UUID, data time.Time, odometro int) (bool, error)
+}
+
+// ComodidadeRepository define as operações do repositório de comodidades
+type ComodidadeRepository interface {
+	Create(ctx context.Context, comodidade *domain.Comodidade) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Comodidade, error)
+
+	// Métodos específicos
+	List(ctx context.Context) ([]*domain.Comodidade, error)
+	GetByCodigos(ctx context.Context, codigos []string) ([]*domain.Comodidade, error)
+	EmUso(ctx context.Context, codigo string) (bool, error)
+}
+
+// CustoFixoVeiculoRepository define as operações do repositório de custos fixos de veículos
+type CustoFixoVeiculoRepository interface {
+	Create(ctx context.Context, custo *domain.CustoFixoVeiculo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.CustoFixoVeiculo, error)
+
+	// Métodos específicos
+	GetByVeiculo(ctx context.Context, veiculoID uuid.UUID) ([]*domain.CustoFixoVeiculo, error)
+	GetVigentes(ctx context.Context, dataInicio, dataFim time.Time) ([]*domain.CustoFixoVeiculo, error)
+}
+
+// AnexoRepository define as operações do repositório de anexos
+type AnexoRepository interface {
+	Create(ctx context.Context, anexo *domain.Anexo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Anexo, error)
+
+	// Métodos específicos
+	GetByEntidade(ctx context.Context, entidade domain.EntidadeAnexo, entidadeID uuid.UUID) ([]*domain.Anexo, error)
+}
+
+// ModeloChecklistRepository define as operações do repositório de modelos de checklist
+type ModeloChecklistRepository interface {
+	Create(ctx context.Context, modelo *domain.ModeloChecklist) error
+	Update(ctx context.Context, modelo *domain.ModeloChecklist) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*domain.ModeloChecklist, error)
+
+	// Métodos específicos
+	GetByTipoVeiculo(ctx context.Context, tipo domain.TipoVeiculo) (*domain.ModeloChecklist, error)
+}
+
+// InspecaoVeiculoRepository define as operações do repositório de inspeções de veículos
+type InspecaoVeiculoRepository interface {
+	Create(ctx context.Context, inspecao *domain.InspecaoVeiculo) error
+
+	// Métodos específicos
+	GetByViagem(ctx context.Context, viagemID uuid.UUID) ([]*domain.InspecaoVeiculo, error)
+}
+
+// LancamentoHorasRepository define as operações do repositório de lançamentos do banco de horas
+type LancamentoHorasRepository interface {
+	Create(ctx context.Context, lancamento *domain.LancamentoHoras) error
+
+	// Métodos específicos
+	GetByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.LancamentoHoras, error)
+	GetViagensByMotorista(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time) ([]*domain.LancamentoHoras, error)
+	GetSaldo(ctx context.Context, motoristaID uuid.UUID, ate time.Time) (int, error)
+}
+
 // TransactionManager define a interface para gerenciamento de transações
 type TransactionManager interface {
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
@@ -101,6 +324,106 @@ func NewClienteRepository(db *gorm.DB) domain.ClienteRepository {
 	return postgres.NewClienteRepository(db)
 }
 
+// NewGrupoViagemRepository cria uma nova instância do repositório de grupos de viagem
+func NewGrupoViagemRepository(db *gorm.DB) domain.GrupoViagemRepository {
+	return postgres.NewGrupoViagemRepository(db)
+}
+
+// NewPoliticaCancelamentoRepository cria uma nova instância do repositório de políticas de cancelamento
+func NewPoliticaCancelamentoRepository(db *gorm.DB) domain.PoliticaCancelamentoRepository {
+	return postgres.NewPoliticaCancelamentoRepository(db)
+}
+
+// NewTabelaPrecoRepository cria uma nova instância do repositório de tabelas de preço
+func NewTabelaPrecoRepository(db *gorm.DB) domain.TabelaPrecoRepository {
+	return postgres.NewTabelaPrecoRepository(db)
+}
+
+// NewFeriadoRepository cria uma nova instância do repositório de feriados
+func NewFeriadoRepository(db *gorm.DB) domain.FeriadoRepository {
+	return postgres.NewFeriadoRepository(db)
+}
+
+// NewCotacaoRepository cria uma nova instância do repositório de cotações
+func NewCotacaoRepository(db *gorm.DB) domain.CotacaoRepository {
+	return postgres.NewCotacaoRepository(db)
+}
+
+// NewPreReservaRepository cria uma nova instância do repositório de pré-reservas
+func NewPreReservaRepository(db *gorm.DB) domain.PreReservaRepository {
+	return postgres.NewPreReservaRepository(db)
+}
+
+// NewEventoViagemRepository cria uma nova instância do repositório de eventos de viagem
+func NewEventoViagemRepository(db *gorm.DB) domain.EventoViagemRepository {
+	return postgres.NewEventoViagemRepository(db)
+}
+
+// NewRegistroViagemRepository cria uma nova instância do repositório de registros de viagem
+func NewRegistroViagemRepository(db *gorm.DB) domain.RegistroViagemRepository {
+	return postgres.NewRegistroViagemRepository(db)
+}
+
+// NewDespesaViagemRepository cria uma nova instância do repositório de despesas de viagem
+func NewDespesaViagemRepository(db *gorm.DB) domain.DespesaViagemRepository {
+	return postgres.NewDespesaViagemRepository(db)
+}
+
+// NewOrdemManutencaoRepository cria uma nova instância do repositório de ordens de manutenção
+func NewOrdemManutencaoRepository(db *gorm.DB) domain.OrdemManutencaoRepository {
+	return postgres.NewOrdemManutencaoRepository(db)
+}
+
+// NewPlanoManutencaoRepository cria uma nova instância do repositório de planos de manutenção
+func NewPlanoManutencaoRepository(db *gorm.DB) domain.PlanoManutencaoRepository {
+	return postgres.NewPlanoManutencaoRepository(db)
+}
+
+// NewDocumentoVeiculoRepository cria uma nova instância do repositório de documentos de veículos
+func NewDocumentoVeiculoRepository(db *gorm.DB) domain.DocumentoVeiculoRepository {
+	return postgres.NewDocumentoVeiculoRepository(db)
+}
+
+// NewIndisponibilidadeVeiculoRepository cria uma nova instância do repositório de indisponibilidades de veículos
+func NewIndisponibilidadeVeiculoRepository(db *gorm.DB) domain.IndisponibilidadeVeiculoRepository {
+	return postgres.NewIndisponibilidadeVeiculoRepository(db)
+}
+
+// NewAbastecimentoRepository cria uma nova instância do repositório de abastecimentos
+func NewAbastecimentoRepository(db *gorm.DB) domain.AbastecimentoRepository {
+	return postgres.NewAbastecimentoRepository(db)
+}
+
+// NewComodidadeRepository cria uma nova instância do repositório de comodidades
+func NewComodidadeRepository(db *gorm.DB) domain.ComodidadeRepository {
+	return postgres.NewComodidadeRepository(db)
+}
+
+// NewCustoFixoVeiculoRepository cria uma nova instância do repositório de custos fixos de veículos
+func NewCustoFixoVeiculoRepository(db *gorm.DB) domain.CustoFixoVeiculoRepository {
+	return postgres.NewCustoFixoVeiculoRepository(db)
+}
+
+// NewAnexoRepository cria uma nova instância do repositório de anexos
+func NewAnexoRepository(db *gorm.DB) domain.AnexoRepository {
+	return postgres.NewAnexoRepository(db)
+}
+
+// NewModeloChecklistRepository cria uma nova instância do repositório de modelos de checklist
+func NewModeloChecklistRepository(db *gorm.DB) domain.ModeloChecklistRepository {
+	return postgres.NewModeloChecklistRepository(db)
+}
+
+// NewInspecaoVeiculoRepository cria uma nova instância do repositório de inspeções de veículos
+func NewInspecaoVeiculoRepository(db *gorm.DB) domain.InspecaoVeiculoRepository {
+	return postgres.NewInspecaoVeiculoRepository(db)
+}
+
+// NewLancamentoHorasRepository cria uma nova instância do repositório de lançamentos do banco de horas
+func NewLancamentoHorasRepository(db *gorm.DB) domain.LancamentoHorasRepository {
+	return postgres.NewLancamentoHorasRepository(db)
+}
+
 // NewTransactionManager cria uma nova instância do gerenciador de transações
 func NewTransactionManager(db *gorm.DB) TransactionManager {
 	return postgres.NewTransactionManager(db)
@@ -0,0 +1,457 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAbastecimentoNaoEncontrado = errors.New("abastecimento não encontrado")
+	ErrExtratoInvalido            = errors.New("extrato do cartão combustível inválido")
+	ErrExtratoMuitoGrande         = errors.New("extrato do cartão combustível excede 5 MB")
+)
+
+// TamanhoMaximoExtrato limita o extrato lido na importação
+const TamanhoMaximoExtrato int64 = 5 << 20
+
+// AbastecimentoUseCase registra os abastecimentos dos veículos, importa os
+// extratos de cartão combustível e calcula o consumo da frota
+type AbastecimentoUseCase struct {
+	abastecimentoRepo repository.AbastecimentoRepository
+	veiculoRepo       repository.VeiculoRepository
+	motoristaRepo     repository.MotoristaRepository
+	viagemRepo        repository.ViagemRepository
+
+	// Queda do km/l em relação à mediana do veículo que caracteriza consumo anômalo
+	toleranciaConsumo float64
+}
+
+func NewAbastecimentoUseCase(
+	abastecimentoRepo repository.AbastecimentoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository,
+	viagemRepo repository.ViagemRepository,
+	toleranciaConsumo float64,
+) *AbastecimentoUseCase {
+	return &AbastecimentoUseCase{
+		abastecimentoRepo: abastecimentoRepo,
+		veiculoRepo:       veiculoRepo,
+		motoristaRepo:     motoristaRepo,
+		viagemRepo:        viagemRepo,
+		toleranciaConsumo: toleranciaConsumo,
+	}
+}
+
+// Registrar lança o abastecimento do veículo. Sem viagem informada, o
+// abastecimento é vinculado à viagem do veículo em curso na data; sem
+// motorista, ao motorista principal dessa viagem.
+func (uc *AbastecimentoUseCase) Registrar(ctx context.Context, abastecimento *domain.Abastecimento) error {
+	if _, err := uc.veiculoRepo.GetByID(ctx, abastecimento.VeiculoID); err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if err := abastecimento.Validar(); err != nil {
+		return err
+	}
+
+	return uc.registrar(ctx, abastecimento)
+}
+
+func (uc *AbastecimentoUseCase) registrar(ctx context.Context, abastecimento *domain.Abastecimento) error {
+	var viagem *domain.Viagem
+	if abastecimento.ViagemID != nil {
+		v, err := uc.viagemRepo.GetByID(ctx, *abastecimento.ViagemID)
+		if err != nil {
+			return ErrViagemNaoEncontrada
+		}
+		if v.VeiculoID != abastecimento.VeiculoID {
+			return domain.ErrViagemAbastecimentoInvalida
+		}
+		viagem = v
+	} else {
+		viagens, err := uc.viagemRepo.GetAtivasPorPeriodo(ctx, abastecimento.Data, abastecimento.Data)
+		if err != nil {
+			return err
+		}
+		for _, v := range viagens {
+			if v.VeiculoID == abastecimento.VeiculoID {
+				viagem = v
+				abastecimento.ViagemID = &v.ID
+				break
+			}
+		}
+	}
+
+	if abastecimento.MotoristaID != nil {
+		if _, err := uc.motoristaRepo.GetByID(ctx, *abastecimento.MotoristaID); err != nil {
+			return ErrMotoristaNaoEncontrado
+		}
+	} else if viagem != nil {
+		motoristaID := viagem.MotoristaID
+		abastecimento.MotoristaID = &motoristaID
+	}
+
+	return uc.abastecimentoRepo.Create(ctx, abastecimento)
+}
+
+// Listar retorna os abastecimentos do veículo no período
+func (uc *AbastecimentoUseCase) Listar(ctx context.Context, veiculoID uuid.UUID,
+	dataInicio, dataFim time.Time) ([]*domain.Abastecimento, error) {
+	if _, err := uc.veiculoRepo.GetByID(ctx, veiculoID); err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	return uc.abastecimentoRepo.GetByVeiculo(ctx, veiculoID, dataInicio, dataFim)
+}
+
+// Remover exclui um abastecimento lançado incorretamente
+func (uc *AbastecimentoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.abastecimentoRepo.GetByID(ctx, id); err != nil {
+		return ErrAbastecimentoNaoEncontrado
+	}
+
+	return uc.abastecimentoRepo.Delete(ctx, id)
+}
+
+// RelatorioConsumo calcula o km/l do período por veículo, motorista e rota e
+// aponta os trechos com consumo anômalo. Com veículo informado, considera
+// apenas os abastecimentos dele.
+func (uc *AbastecimentoUseCase) RelatorioConsumo(ctx context.Context, veiculoID *uuid.UUID,
+	dataInicio, dataFim time.Time) (*domain.RelatorioConsumo, error) {
+	if dataInicio.After(dataFim) {
+		return nil, ErrDataInvalida
+	}
+
+	var abastecimentos []*domain.Abastecimento
+	var err error
+	if veiculoID != nil {
+		abastecimentos, err = uc.Listar(ctx, *veiculoID, dataInicio, dataFim)
+	} else {
+		abastecimentos, err = uc.abastecimentoRepo.GetByPeriodo(ctx, dataInicio, dataFim)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	porVeiculo := make(map[uuid.UUID][]*domain.Abastecimento)
+	for _, a := range abastecimentos {
+		porVeiculo[a.VeiculoID] = append(porVeiculo[a.VeiculoID], a)
+	}
+
+	var trechos []*domain.TrechoConsumo
+	for _, doVeiculo := range porVeiculo {
+		trechos = append(trechos, domain.CalcularTrechosConsumo(doVeiculo)...)
+	}
+	domain.MarcarConsumoAnomalo(trechos, uc.toleranciaConsumo)
+
+	rotas := make(map[uuid.UUID]string)
+	for _, t := range trechos {
+		if t.ViagemID == nil {
+			continue
+		}
+		if _, ok := rotas[*t.ViagemID]; ok {
+			continue
+		}
+		viagem, err := uc.viagemRepo.GetByID(ctx, *t.ViagemID)
+		if err != nil {
+			continue
+		}
+		rotas[viagem.ID] = viagem.Origem + " → " + viagem.Destino
+	}
+
+	return domain.ResumirConsumo(dataInicio, dataFim, trechos, rotas), nil
+}
+
+// Importar lança os abastecimentos de um extrato de cartão combustível em CSV.
+// Linhas com erro são relatadas sem interromper a importação e lançamentos já
+// existentes (mesmo veículo, data e odômetro) são ignorados.
+func (uc *AbastecimentoUseCase) Importar(ctx context.Context, extrato io.Reader) (*domain.ResultadoImportacao, error) {
+	linhas, err := lerExtratoCartao(extrato)
+	if err != nil {
+		return nil, err
+	}
+
+	resultado := &domain.ResultadoImportacao{Erros: []domain.ErroLinhaImportacao{}}
+	veiculos := make(map[string]*domain.Veiculo)
+	motoristas := make(map[string]*domain.Motorista)
+	for _, linha := range linhas {
+		importado, err := uc.importarLinha(ctx, linha, veiculos, motoristas)
+		switch {
+		case err != nil:
+			resultado.Erros = append(resultado.Erros, domain.ErroLinhaImportacao{Linha: linha.numero, Erro: err.Error()})
+		case importado:
+			resultado.Importados++
+		default:
+			resultado.Ignorados++
+		}
+	}
+
+	return resultado, nil
+}
+
+// importarLinha lança o abastecimento da linha, retornando false quando ele já
+// havia sido lançado
+func (uc *AbastecimentoUseCase) importarLinha(ctx context.Context, linha linhaExtrato,
+	veiculos map[string]*domain.Veiculo, motoristas map[string]*domain.Motorista) (bool, error) {
+	abastecimento, err := uc.abastecimentoDoExtrato(ctx, linha, veiculos, motoristas)
+	if err != nil {
+		return false, err
+	}
+
+	existe, err := uc.abastecimentoRepo.ExisteLancamento(ctx, abastecimento.VeiculoID,
+		abastecimento.Data, abastecimento.Odometro)
+	if err != nil || existe {
+		return false, err
+	}
+
+	if err := abastecimento.Validar(); err != nil {
+		return false, err
+	}
+
+	if err := uc.registrar(ctx, abastecimento); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *AbastecimentoUseCase) abastecimentoDoExtrato(ctx context.Context, linha linhaExtrato,
+	veiculos map[string]*domain.Veiculo, motoristas map[string]*domain.Motorista) (*domain.Abastecimento, error) {
+	placa := domain.NormalizarPlaca(linha.campo("placa"))
+	veiculo, ok := veiculos[placa]
+	if !ok {
+		v, err := uc.veiculoRepo.GetByPlaca(ctx, placa)
+		if errors.Is(err, domain.ErrPlacaAmbigua) {
+			return nil, fmt.Errorf("placa %q: %w", placa, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("veículo de placa %q não encontrado", placa)
+		}
+		veiculo = v
+		veiculos[placa] = v
+	}
+
+	data, err := lerDataExtrato(linha.campo("data"))
+	if err != nil {
+		return nil, err
+	}
+
+	litros, err := lerDecimalExtrato(linha.campo("litros"))
+	if err != nil {
+		return nil, fmt.Errorf("litros inválidos: %w", err)
+	}
+
+	odometro, err := strconv.Atoi(strings.NewReplacer(".", "", " ", "").Replace(linha.campo("odometro")))
+	if err != nil {
+		return nil, fmt.Errorf("odômetro inválido: %q", linha.campo("odometro"))
+	}
+
+	// O extrato pode trazer o preço por litro, o valor total ou ambos
+	var preco float64
+	if valor := linha.campo("preco_litro"); valor != "" {
+		if preco, err = lerDecimalExtrato(valor); err != nil {
+			return nil, fmt.Errorf("preço por litro inválido: %w", err)
+		}
+	} else if valor := linha.campo("valor_total"); valor != "" && litros > 0 {
+		total, err := lerDecimalExtrato(valor)
+		if err != nil {
+			return nil, fmt.Errorf("valor total inválido: %w", err)
+		}
+		preco = total / litros
+	}
+
+	tanqueCheio := true
+	if valor := linha.campo("tanque_cheio"); valor != "" {
+		switch strings.ToUpper(strings.TrimSpace(valor)) {
+		case "S", "SIM", "X", "1", "TRUE":
+		default:
+			tanqueCheio = false
+		}
+	}
+
+	abastecimento := domain.NewAbastecimento(veiculo.ID, data, litros, preco, odometro,
+		strings.TrimSpace(linha.campo("posto")), tanqueCheio)
+	abastecimento.Origem = domain.AbastecimentoCartao
+	if valor := linha.campo("valor_total"); valor != "" {
+		if total, err := lerDecimalExtrato(valor); err == nil {
+			abastecimento.ValorTotal = total
+		}
+	}
+
+	if cpf := strings.TrimSpace(linha.campo("cpf_motorista")); cpf != "" {
+		motorista, ok := motoristas[cpf]
+		if !ok {
+			m, err := uc.motoristaRepo.GetByCPF(ctx, cpf)
+			if err != nil {
+				return nil, fmt.Errorf("motorista de CPF %q não encontrado", cpf)
+			}
+			motorista = m
+			motoristas[cpf] = m
+		}
+		abastecimento.MotoristaID = &motorista.ID
+	}
+
+	return abastecimento, nil
+}
+
+// linhaExtrato é uma linha do extrato com os campos indexados pelo nome da coluna
+type linhaExtrato struct {
+	numero int
+	campos map[string]string
+}
+
+func (l linhaExtrato) campo(nome string) string {
+	return l.campos[nome]
+}
+
+// colunasExtrato associa os cabeçalhos aceitos nos extratos às colunas
+// reconhecidas na importação
+var colunasExtrato = map[string]string{
+	"data":            "data",
+	"data_hora":       "data",
+	"placa":           "placa",
+	"litros":          "litros",
+	"quantidade":      "litros",
+	"preco_litro":     "preco_litro",
+	"preco":           "preco_litro",
+	"valor_unitario":  "preco_litro",
+	"valor_total":     "valor_total",
+	"valor":           "valor_total",
+	"odometro":        "odometro",
+	"hodometro":       "odometro",
+	"km":              "odometro",
+	"posto":           "posto",
+	"estabelecimento": "posto",
+	"cpf_motorista":   "cpf_motorista",
+	"cpf":             "cpf_motorista",
+	"tanque_cheio":    "tanque_cheio",
+}
+
+var colunasObrigatoriasExtrato = []string{"data", "placa", "litros", "odometro"}
+
+// lerExtratoCartao interpreta o CSV do extrato. O separador (vírgula ou ponto
+// e vírgula) é identificado pelo cabeçalho, que deve trazer ao menos data,
+// placa, litros e odômetro.
+func lerExtratoCartao(extrato io.Reader) ([]linhaExtrato, error) {
+	// Lê um byte além do limite para distinguir o extrato no limite do maior
+	conteudo, err := io.ReadAll(io.LimitReader(extrato, TamanhoMaximoExtrato+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(conteudo)) > TamanhoMaximoExtrato {
+		return nil, ErrExtratoMuitoGrande
+	}
+
+	cabecalho := conteudo
+	if fim := bytes.IndexByte(conteudo, '\n'); fim >= 0 {
+		cabecalho = conteudo[:fim]
+	}
+
+	leitor := csv.NewReader(bytes.NewReader(conteudo))
+	leitor.FieldsPerRecord = -1
+	leitor.TrimLeadingSpace = true
+	if bytes.Count(cabecalho, []byte(";")) > bytes.Count(cabecalho, []byte(",")) {
+		leitor.Comma = ';'
+	}
+
+	registros, err := leitor.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExtratoInvalido, err)
+	}
+	if len(registros) == 0 {
+		return nil, fmt.Errorf("%w: arquivo vazio", ErrExtratoInvalido)
+	}
+
+	colunas := make(map[int]string)
+	presentes := make(map[string]bool)
+	for i, nome := range registros[0] {
+		if coluna, ok := colunasExtrato[normalizarColunaExtrato(nome)]; ok {
+			colunas[i] = coluna
+			presentes[coluna] = true
+		}
+	}
+	for _, coluna := range colunasObrigatoriasExtrato {
+		if !presentes[coluna] {
+			return nil, fmt.Errorf("%w: coluna %s ausente", ErrExtratoInvalido, coluna)
+		}
+	}
+	if !presentes["preco_litro"] && !presentes["valor_total"] {
+		return nil, fmt.Errorf("%w: informe o preço por litro ou o valor total", ErrExtratoInvalido)
+	}
+
+	linhas := make([]linhaExtrato, 0, len(registros)-1)
+	for i, registro := range registros[1:] {
+		linha := linhaExtrato{numero: i + 2, campos: make(map[string]string)}
+		vazia := true
+		for j, valor := range registro {
+			if coluna, ok := colunas[j]; ok {
+				linha.campos[coluna] = valor
+			}
+			if strings.TrimSpace(valor) != "" {
+				vazia = false
+			}
+		}
+		if !vazia {
+			linhas = append(linhas, linha)
+		}
+	}
+
+	return linhas, nil
+}
+
+func normalizarColunaExtrato(nome string) string {
+	nome = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(nome, "\ufeff")))
+	nome = strings.NewReplacer(
+		"á", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i",
+		"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c",
+		" ", "_", "/", "_", "-", "_",
+	).Replace(nome)
+	return nome
+}
+
+// layoutsDataExtrato são os formatos de data aceitos nos extratos
+var layoutsDataExtrato = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"02/01/2006",
+}
+
+func lerDataExtrato(valor string) (time.Time, error) {
+	valor = strings.TrimSpace(valor)
+	for _, layout := range layoutsDataExtrato {
+		if data, err := time.ParseInLocation(layout, valor, time.Local); err == nil {
+			return data, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("data inválida: %q", valor)
+}
+
+// lerDecimalExtrato aceita números no formato brasileiro (1.234,56) ou com
+// ponto decimal (1234.56)
+func lerDecimalExtrato(valor string) (float64, error) {
+	valor = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(valor), "R$"))
+	if strings.Contains(valor, ",") {
+		valor = strings.ReplaceAll(valor, ".", "")
+		valor = strings.ReplaceAll(valor, ",", ".")
+	}
+	numero, err := strconv.ParseFloat(valor, 64)
+	if err != nil {
+		return 0, fmt.Errorf("número inválido: %q", valor)
+	}
+	return numero, nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"agencia-viagens/internal/armazenamento"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAnexoNaoEncontrado         = errors.New("anexo não encontrado")
+	ErrEntidadeAnexoNaoEncontrada = errors.New("cadastro do anexo não encontrado")
+	ErrAnexoMuitoGrande           = errors.New("arquivo excede o tamanho máximo permitido")
+	ErrAnexoVazio                 = errors.New("arquivo vazio")
+	ErrLinkAnexoInvalido          = errors.New("link de download inválido")
+	ErrLinkAnexoExpirado          = errors.New("link de download expirado")
+	ErrArquivoAnexoIndisponivel   = errors.New("conteúdo do anexo não encontrado no armazenamento")
+	ErrAnexoEmUso                 = errors.New("anexo é comprovante de uma despesa de viagem")
+)
+
+// LinkAnexo autoriza o download do anexo sem autenticação até a expiração
+type LinkAnexo struct {
+	AnexoID    uuid.UUID `json:"anexo_id"`
+	Assinatura string    `json:"assinatura"`
+	ExpiraEm   time.Time `json:"expira_em"`
+}
+
+// AnexoUseCase recebe os arquivos anexados aos cadastros, guarda o conteúdo no
+// armazenamento configurado e libera o download por links assinados
+type AnexoUseCase struct {
+	anexoRepo     repository.AnexoRepository
+	veiculoRepo   repository.VeiculoRepository
+	motoristaRepo repository.MotoristaRepository
+	viagemRepo    repository.ViagemRepository
+	clienteRepo   repository.ClienteRepository
+	despesaRepo   repository.DespesaViagemRepository
+	armazenamento armazenamento.Armazenamento
+	tamanhoMaximo int64
+	chaveLink     []byte
+	validadeLink  time.Duration
+}
+
+func NewAnexoUseCase(
+	anexoRepo repository.AnexoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository,
+	viagemRepo repository.ViagemRepository,
+	clienteRepo repository.ClienteRepository,
+	despesaRepo repository.DespesaViagemRepository,
+	armazenamento armazenamento.Armazenamento,
+	tamanhoMaximo int64,
+	chaveLink []byte,
+	validadeLink time.Duration,
+) *AnexoUseCase {
+	return &AnexoUseCase{
+		anexoRepo:     anexoRepo,
+		veiculoRepo:   veiculoRepo,
+		motoristaRepo: motoristaRepo,
+		viagemRepo:    viagemRepo,
+		clienteRepo:   clienteRepo,
+		despesaRepo:   despesaRepo,
+		armazenamento: armazenamento,
+		tamanhoMaximo: tamanhoMaximo,
+		chaveLink:     chaveLink,
+		validadeLink:  validadeLink,
+	}
+}
+
+// TamanhoMaximo é o limite de tamanho de cada arquivo enviado
+func (uc *AnexoUseCase) TamanhoMaximo() int64 {
+	return uc.tamanhoMaximo
+}
+
+// Enviar grava o arquivo e registra o anexo no cadastro. O formato é
+// identificado pelo conteúdo, não pela extensão ou pelo cabeçalho enviado.
+func (uc *AnexoUseCase) Enviar(ctx context.Context, anexo *domain.Anexo, conteudo io.Reader) error {
+	if err := uc.verificarEntidade(ctx, anexo.Entidade, anexo.EntidadeID); err != nil {
+		return err
+	}
+
+	// Lê um byte além do limite para distinguir o arquivo no limite do maior
+	dados, err := io.ReadAll(io.LimitReader(conteudo, uc.tamanhoMaximo+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(dados)) > uc.tamanhoMaximo {
+		return ErrAnexoMuitoGrande
+	}
+	if len(dados) == 0 {
+		return ErrAnexoVazio
+	}
+
+	soma := sha256.Sum256(dados)
+	anexo.Tamanho = int64(len(dados))
+	anexo.Checksum = hex.EncodeToString(soma[:])
+	anexo.ContentType = http.DetectContentType(dados)
+
+	ator := atorDoContexto(ctx)
+	anexo.EnviadoPorID = ator.ID
+	anexo.EnviadoPorNome = ator.Nome
+
+	if err := anexo.Validar(); err != nil {
+		return err
+	}
+
+	if err := uc.armazenamento.Salvar(ctx, anexo.Chave, dados, anexo.ContentType); err != nil {
+		return err
+	}
+
+	if err := uc.anexoRepo.Create(ctx, anexo); err != nil {
+		// Sem o registro o arquivo ficaria órfão no armazenamento
+		if errRemocao := uc.armazenamento.Remover(ctx, anexo.Chave); errRemocao != nil {
+			log.Printf("erro ao remover arquivo órfão %s: %v", anexo.Chave, errRemocao)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Listar retorna os anexos do cadastro
+func (uc *AnexoUseCase) Listar(ctx context.Context, entidade domain.EntidadeAnexo, entidadeID uuid.UUID) ([]*domain.Anexo, error) {
+	if err := uc.verificarEntidade(ctx, entidade, entidadeID); err != nil {
+		return nil, err
+	}
+	return uc.anexoRepo.GetByEntidade(ctx, entidade, entidadeID)
+}
+
+// GerarLink assina um link de download do anexo válido pelo prazo configurado
+func (uc *AnexoUseCase) GerarLink(ctx context.Context, id uuid.UUID) (*LinkAnexo, error) {
+	if _, err := uc.anexoRepo.GetByID(ctx, id); err != nil {
+		return nil, ErrAnexoNaoEncontrado
+	}
+
+	// Precisão de segundos, a mesma do parâmetro expira do link
+	expiraEm := time.Now().Add(uc.validadeLink).Truncate(time.Second)
+	return &LinkAnexo{
+		AnexoID:    id,
+		Assinatura: uc.assinarLink(id, expiraEm.Unix()),
+		ExpiraEm:   expiraEm,
+	}, nil
+}
+
+// Baixar confere a assinatura e a validade do link e abre o conteúdo do
+// anexo. Quem chama deve fechar o conteúdo.
+func (uc *AnexoUseCase) Baixar(ctx context.Context, id uuid.UUID, expira int64, assinatura string) (*domain.Anexo, io.ReadCloser, error) {
+	esperada := uc.assinarLink(id, expira)
+	if !hmac.Equal([]byte(esperada), []byte(assinatura)) {
+		return nil, nil, ErrLinkAnexoInvalido
+	}
+	if time.Now().Unix() > expira {
+		return nil, nil, ErrLinkAnexoExpirado
+	}
+
+	anexo, err := uc.anexoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, ErrAnexoNaoEncontrado
+	}
+
+	conteudo, err := uc.armazenamento.Abrir(ctx, anexo.Chave)
+	if errors.Is(err, armazenamento.ErrArquivoNaoEncontrado) {
+		return nil, nil, ErrArquivoAnexoIndisponivel
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return anexo, conteudo, nil
+}
+
+// Remover exclui o anexo e o arquivo. O registro sai primeiro: se a remoção
+// do arquivo falhar, sobra um arquivo inacessível, não um anexo sem conteúdo.
+// O comprovante de uma despesa não pode ser removido.
+func (uc *AnexoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	anexo, err := uc.anexoRepo.GetByID(ctx, id)
+	if err != nil {
+		return ErrAnexoNaoEncontrado
+	}
+
+	emUso, err := uc.despesaRepo.ComprovanteEmUso(ctx, id)
+	if err != nil {
+		return err
+	}
+	if emUso {
+		return ErrAnexoEmUso
+	}
+
+	if err := uc.anexoRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.armazenamento.Remover(ctx, anexo.Chave); err != nil {
+		log.Printf("erro ao remover arquivo do anexo %s: %v", anexo.ID, err)
+	}
+	return nil
+}
+
+// verificarEntidade confirma que o cadastro dono do anexo existe
+func (uc *AnexoUseCase) verificarEntidade(ctx context.Context, entidade domain.EntidadeAnexo, id uuid.UUID) error {
+	var err error
+	switch entidade {
+	case domain.AnexoDeVeiculo:
+		_, err = uc.veiculoRepo.GetByID(ctx, id)
+	case domain.AnexoDeMotorista:
+		_, err = uc.motoristaRepo.GetByID(ctx, id)
+	case domain.AnexoDeViagem:
+		_, err = uc.viagemRepo.GetByID(ctx, id)
+	case domain.AnexoDeCliente:
+		_, err = uc.clienteRepo.GetByID(ctx, id)
+	default:
+		return domain.ErrEntidadeAnexoInvalida
+	}
+
+	if err != nil {
+		return ErrEntidadeAnexoNaoEncontrada
+	}
+	return nil
+}
+
+// assinarLink calcula o HMAC-SHA256 do anexo e do instante de expiração
+func (uc *AnexoUseCase) assinarLink(id uuid.UUID, expira int64) string {
+	mac := hmac.New(sha256.New, uc.chaveLink)
+	fmt.Fprintf(mac, "%s:%d", id, expira)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// LimiteSugestoesPadrao é a quantidade de sugestões retornada quando nenhum limite é informado
+const LimiteSugestoesPadrao = 10
+
+var ErrNenhumaAtribuicaoPossivel = errors.New("nenhum par de veículo e motorista disponível atende a viagem")
+
+type AtribuicaoUseCase struct {
+	viagemRepo    repository.ViagemRepository
+	veiculoRepo   repository.VeiculoRepository
+	motoristaRepo repository.MotoristaRepository
+
+	// Direção prevista acima da qual a viagem exige motorista secundário
+	limiteRevezamento time.Duration
+}
+
+func NewAtribuicaoUseCase(
+	viagemRepo repository.ViagemRepository,
+	veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository,
+	limiteRevezamento time.Duration,
+) *AtribuicaoUseCase {
+	return &AtribuicaoUseCase{
+		viagemRepo:    viagemRepo,
+		veiculoRepo:   veiculoRepo,
+		motoristaRepo: motoristaRepo,
+
+		limiteRevezamento: limiteRevezamento,
+	}
+}
+
+// Sugerir retorna os pares veículo/motorista livres no período, ordenados da
+// maior para a menor pontuação. Se a direção prevista exigir revezamento, cada
+// sugestão traz também o motorista secundário.
+func (uc *AtribuicaoUseCase) Sugerir(ctx context.Context, solicitacao domain.SolicitacaoAtribuicao,
+	limite int) ([]*domain.SugestaoAtribuicao, error) {
+	if solicitacao.DataInicio.After(solicitacao.DataFim) {
+		return nil, ErrDataInvalida
+	}
+	if limite <= 0 {
+		limite = LimiteSugestoesPadrao
+	}
+
+	candidata := &domain.Viagem{
+		DataInicio:             solicitacao.DataInicio,
+		DataFim:                solicitacao.DataFim,
+		DirecaoPrevistaMinutos: solicitacao.DirecaoPrevistaMinutos,
+	}
+	if err := candidata.ValidarRevezamento(uc.limiteRevezamento); errors.Is(err, domain.ErrRevezamentoObrigatorio) {
+		solicitacao.Revezamento = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	veiculos, err := uc.veiculoRepo.GetDisponiveis(ctx, solicitacao.DataInicio, solicitacao.DataFim,
+		domain.NormalizarComodidades(solicitacao.ComodidadesExigidas))
+	if err != nil {
+		return nil, err
+	}
+
+	motoristas, err := uc.motoristaRepo.GetDisponiveis(ctx, solicitacao.DataInicio, solicitacao.DataFim, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Uma única consulta traz as viagens da semana anterior à seguinte,
+	// usadas para montar a agenda de cada recurso e aplicar as regras de jornada
+	viagens, err := uc.viagemRepo.GetAtivasPorPeriodo(ctx,
+		solicitacao.DataInicio.Add(-domain.PeriodoDescansoSemanal), solicitacao.DataFim.Add(domain.PeriodoDescansoSemanal))
+	if err != nil {
+		return nil, err
+	}
+
+	porVeiculo := make(map[uuid.UUID][]*domain.Viagem)
+	porMotorista := make(map[uuid.UUID][]*domain.Viagem)
+	for _, v := range viagens {
+		porVeiculo[v.VeiculoID] = append(porVeiculo[v.VeiculoID], v)
+		for _, motoristaID := range v.Motoristas() {
+			porMotorista[motoristaID] = append(porMotorista[motoristaID], v)
+		}
+	}
+
+	agendasMotoristas := make([]domain.AgendaRecurso, len(motoristas))
+	for i, m := range motoristas {
+		agendasMotoristas[i] = domain.MontarAgenda(porMotorista[m.ID], solicitacao.DataInicio, solicitacao.DataFim)
+	}
+
+	var sugestoes []*domain.SugestaoAtribuicao
+	for _, veiculo := range veiculos {
+		agendaVeiculo := domain.MontarAgenda(porVeiculo[veiculo.ID], solicitacao.DataInicio, solicitacao.DataFim)
+		var doVeiculo []*domain.SugestaoAtribuicao
+		for i, motorista := range motoristas {
+			sugestao, ok := domain.PontuarAtribuicao(solicitacao, veiculo, agendaVeiculo, motorista, agendasMotoristas[i])
+			if ok {
+				doVeiculo = append(doVeiculo, sugestao)
+			}
+		}
+		if solicitacao.Revezamento {
+			doVeiculo = domain.ComporRevezamento(doVeiculo)
+		}
+		sugestoes = append(sugestoes, doVeiculo...)
+	}
+
+	sort.SliceStable(sugestoes, func(a, b int) bool {
+		return sugestoes[a].Pontuacao > sugestoes[b].Pontuacao
+	})
+
+	if len(sugestoes) > limite {
+		sugestoes = sugestoes[:limite]
+	}
+	return sugestoes, nil
+}
+
+// AtribuirMelhor preenche o veículo e os motoristas da viagem com a sugestão
+// de maior pontuação. O motorista secundário é atribuído quando a viagem exige
+// revezamento ou já previa um.
+func (uc *AtribuicaoUseCase) AtribuirMelhor(ctx context.Context, viagem *domain.Viagem) error {
+	sugestoes, err := uc.Sugerir(ctx, domain.SolicitacaoAtribuicao{
+		DataInicio:             viagem.DataInicio,
+		DataFim:                viagem.DataFim,
+		QuantidadePassageiros:  viagem.QuantidadePassageiros,
+		Origem:                 viagem.Origem,
+		CoordenadasOrigem:      viagem.CoordenadasOrigem,
+		ComodidadesExigidas:    viagem.ComodidadesExigidas,
+		DirecaoPrevistaMinutos: viagem.DirecaoPrevistaMinutos,
+		Revezamento:            viagem.PossuiRevezamento(),
+	}, 1)
+	if err != nil {
+		return err
+	}
+	if len(sugestoes) == 0 {
+		return ErrNenhumaAtribuicaoPossivel
+	}
+
+	viagem.VeiculoID = sugestoes[0].Veiculo.ID
+	viagem.MotoristaID = sugestoes[0].Motorista.ID
+	viagem.MotoristaSecundarioID = nil
+	if secundario := sugestoes[0].MotoristaSecundario; secundario != nil {
+		viagem.MotoristaSecundarioID = &secundario.ID
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"agencia-viagens/internal/auth"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAjusteBancoHorasNaoPermitido = errors.New("somente ADMIN pode ajustar o banco de horas")
+	ErrLimiteBancoHorasInvalido     = errors.New("limite do banco de horas deve ser maior que zero")
+)
+
+// BancoHorasUseCase mantém o banco de horas dos motoristas: os lançamentos das
+// viagens concluídas, os ajustes manuais e as folgas compensatórias
+type BancoHorasUseCase struct {
+	lancamentoRepo repository.LancamentoHorasRepository
+	motoristaRepo  repository.MotoristaRepository
+	viagemRepo     repository.ViagemRepository
+	registroRepo   repository.RegistroViagemRepository
+	jornada        domain.JornadaTrabalho
+}
+
+func NewBancoHorasUseCase(
+	lancamentoRepo repository.LancamentoHorasRepository,
+	motoristaRepo repository.MotoristaRepository,
+	viagemRepo repository.ViagemRepository,
+	registroRepo repository.RegistroViagemRepository,
+	jornada domain.JornadaTrabalho,
+) *BancoHorasUseCase {
+	return &BancoHorasUseCase{
+		lancamentoRepo: lancamentoRepo,
+		motoristaRepo:  motoristaRepo,
+		viagemRepo:     viagemRepo,
+		registroRepo:   registroRepo,
+		jornada:        jornada,
+	}
+}
+
+// LancarViagem lança para cada motorista as horas da viagem concluída, entre
+// o check-in e o check-out. As horas extras consideram as viagens já lançadas
+// nas semanas da viagem.
+func (uc *BancoHorasUseCase) LancarViagem(ctx context.Context, viagem *domain.Viagem, checkIn, checkOut *domain.RegistroViagem) error {
+	semanas := domain.SemanasDoPeriodo(checkIn.RegistradoEm, checkOut.RegistradoEm)
+	for _, motoristaID := range viagem.Motoristas() {
+		anteriores, err := uc.lancamentoRepo.GetViagensByMotorista(ctx, motoristaID, semanas.DataInicio, semanas.DataFim)
+		if err != nil {
+			return err
+		}
+
+		lancamento := domain.NewLancamentoViagem(viagem, checkIn, checkOut, motoristaID, uc.jornada, anteriores)
+		lancamento.RegistradoPor = atorDoContexto(ctx)
+		if err := uc.lancamentoRepo.Create(ctx, lancamento); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LancarViagensPendentes lança as viagens concluídas no check-out que ainda
+// não estão no banco de horas, como as encerradas antes do extrato existir, e
+// retorna quantas foram lançadas. As horas vêm só dos registros de check-in e
+// check-out, nunca do período gravado na viagem. As viagens seguem a ordem do
+// check-in, para que as horas extras de cada uma considerem as anteriores da
+// semana.
+func (uc *BancoHorasUseCase) LancarViagensPendentes(ctx context.Context) (int, error) {
+	viagens, err := uc.viagemRepo.GetConcluidasSemLancamentoHoras(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	type execucao struct {
+		viagem            *domain.Viagem
+		checkIn, checkOut *domain.RegistroViagem
+	}
+	var pendentes []execucao
+	for _, viagem := range viagens {
+		registros, err := uc.registroRepo.GetByViagem(ctx, viagem.ID)
+		if err != nil {
+			return 0, err
+		}
+		checkIn := domain.UltimoRegistro(registros, domain.RegistroCheckIn)
+		checkOut := domain.UltimoRegistro(registros, domain.RegistroCheckOut)
+		if checkIn == nil || checkOut == nil || checkOut.RegistradoEm.Before(checkIn.RegistradoEm) {
+			continue
+		}
+		pendentes = append(pendentes, execucao{viagem, checkIn, checkOut})
+	}
+	sort.SliceStable(pendentes, func(i, j int) bool {
+		return pendentes[i].checkIn.RegistradoEm.Before(pendentes[j].checkIn.RegistradoEm)
+	})
+
+	for i, p := range pendentes {
+		if err := uc.LancarViagem(ctx, p.viagem, p.checkIn, p.checkOut); err != nil {
+			return i, err
+		}
+	}
+	return len(pendentes), nil
+}
+
+// LancarAjuste credita ou debita o banco de horas do motorista. Apenas ADMIN
+// pode ajustar, e o ajuste exige justificativa.
+func (uc *BancoHorasUseCase) LancarAjuste(ctx context.Context, motoristaID uuid.UUID, data time.Time,
+	minutos int, descricao string) (*domain.LancamentoHoras, error) {
+	ator := atorDoContexto(ctx)
+	if ator.Perfil != auth.ProfileAdmin {
+		return nil, ErrAjusteBancoHorasNaoPermitido
+	}
+
+	if _, err := uc.motoristaRepo.GetByID(ctx, motoristaID); err != nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+
+	ajuste := domain.NewAjusteHoras(motoristaID, data, minutos, descricao)
+	if err := ajuste.Validar(); err != nil {
+		return nil, err
+	}
+	ajuste.RegistradoPor = ator
+
+	if err := uc.lancamentoRepo.Create(ctx, ajuste); err != nil {
+		return nil, err
+	}
+	return ajuste, nil
+}
+
+// LancarCompensacao registra a folga compensatória do motorista, que não pode
+// passar do saldo acumulado até o dia da folga
+func (uc *BancoHorasUseCase) LancarCompensacao(ctx context.Context, motoristaID uuid.UUID, data time.Time,
+	minutos int, descricao string) (*domain.LancamentoHoras, error) {
+	if _, err := uc.motoristaRepo.GetByID(ctx, motoristaID); err != nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+
+	compensacao := domain.NewCompensacaoHoras(motoristaID, data, minutos, descricao)
+	if err := compensacao.Validar(); err != nil {
+		return nil, err
+	}
+
+	saldo, err := uc.lancamentoRepo.GetSaldo(ctx, motoristaID, data.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	if minutos > saldo {
+		return nil, domain.ErrSaldoBancoHorasInsuficiente
+	}
+	compensacao.RegistradoPor = atorDoContexto(ctx)
+
+	if err := uc.lancamentoRepo.Create(ctx, compensacao); err != nil {
+		return nil, err
+	}
+	return compensacao, nil
+}
+
+// Extrato retorna os lançamentos do motorista no período, resumidos por dia,
+// semana ou mês, a partir do saldo acumulado antes do período
+func (uc *BancoHorasUseCase) Extrato(ctx context.Context, motoristaID uuid.UUID, dataInicio, dataFim time.Time,
+	agrupamento domain.AgrupamentoHoras) (*domain.ExtratoBancoHoras, error) {
+	if err := agrupamento.Validar(); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.motoristaRepo.GetByID(ctx, motoristaID); err != nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+
+	saldoAnterior, err := uc.lancamentoRepo.GetSaldo(ctx, motoristaID, dataInicio)
+	if err != nil {
+		return nil, err
+	}
+
+	lancamentos, err := uc.lancamentoRepo.GetByMotorista(ctx, motoristaID, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.CalcularExtratoBancoHoras(motoristaID, dataInicio, dataFim, agrupamento, saldoAnterior, lancamentos), nil
+}
+
+// MotoristasExcedidos retorna os motoristas com saldo acima do limite, em horas
+func (uc *BancoHorasUseCase) MotoristasExcedidos(ctx context.Context, limiteHoras int) ([]*domain.Motorista, error) {
+	if limiteHoras <= 0 {
+		return nil, ErrLimiteBancoHorasInvalido
+	}
+	return uc.motoristaRepo.GetMotoristasBancoHorasExcedido(ctx, limiteHoras)
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrModeloChecklistNaoEncontrado = errors.New("tipo de veículo sem modelo de checklist configurado")
+
+// ChecklistUseCase mantém os modelos de checklist por tipo de veículo e
+// registra as inspeções de saída das viagens
+type ChecklistUseCase struct {
+	modeloRepo        repository.ModeloChecklistRepository
+	inspecaoRepo      repository.InspecaoVeiculoRepository
+	viagemRepo        repository.ViagemRepository
+	veiculoRepo       repository.VeiculoRepository
+	manutencaoUseCase *ManutencaoUseCase
+	txManager         repository.TransactionManager
+}
+
+func NewChecklistUseCase(
+	modeloRepo repository.ModeloChecklistRepository,
+	inspecaoRepo repository.InspecaoVeiculoRepository,
+	viagemRepo repository.ViagemRepository,
+	veiculoRepo repository.VeiculoRepository,
+	manutencaoUseCase *ManutencaoUseCase,
+	txManager repository.TransactionManager,
+) *ChecklistUseCase {
+	return &ChecklistUseCase{
+		modeloRepo:        modeloRepo,
+		inspecaoRepo:      inspecaoRepo,
+		viagemRepo:        viagemRepo,
+		veiculoRepo:       veiculoRepo,
+		manutencaoUseCase: manutencaoUseCase,
+		txManager:         txManager,
+	}
+}
+
+// DefinirModelo cria ou substitui o modelo de checklist do tipo de veículo.
+// As inspeções já registradas guardam os itens da época e não mudam.
+func (uc *ChecklistUseCase) DefinirModelo(ctx context.Context, modelo *domain.ModeloChecklist) error {
+	if err := modelo.Validar(); err != nil {
+		return err
+	}
+
+	existente, err := uc.modeloRepo.GetByTipoVeiculo(ctx, modelo.TipoVeiculo)
+	if err != nil {
+		return err
+	}
+	if existente == nil {
+		return uc.modeloRepo.Create(ctx, modelo)
+	}
+
+	modelo.ID = existente.ID
+	modelo.CreatedAt = existente.CreatedAt
+	modelo.UpdatedAt = time.Now()
+	return uc.modeloRepo.Update(ctx, modelo)
+}
+
+// BuscarModelo retorna o modelo do tipo de veículo ou, sem modelo
+// configurado, o checklist padrão
+func (uc *ChecklistUseCase) BuscarModelo(ctx context.Context, tipo domain.TipoVeiculo) (*domain.ModeloChecklist, error) {
+	modelo, err := uc.modeloRepo.GetByTipoVeiculo(ctx, tipo)
+	if err != nil {
+		return nil, err
+	}
+	if modelo == nil {
+		return domain.ModeloChecklistPadrao(tipo), nil
+	}
+	return modelo, nil
+}
+
+// ListarModelos retorna os modelos configurados
+func (uc *ChecklistUseCase) ListarModelos(ctx context.Context) ([]*domain.ModeloChecklist, error) {
+	return uc.modeloRepo.List(ctx)
+}
+
+// RemoverModelo exclui o modelo do tipo de veículo, que volta ao checklist padrão
+func (uc *ChecklistUseCase) RemoverModelo(ctx context.Context, tipo domain.TipoVeiculo) error {
+	modelo, err := uc.modeloRepo.GetByTipoVeiculo(ctx, tipo)
+	if err != nil {
+		return err
+	}
+	if modelo == nil {
+		return ErrModeloChecklistNaoEncontrado
+	}
+	return uc.modeloRepo.Delete(ctx, modelo.ID)
+}
+
+// RegistrarInspecao confere as respostas do motorista contra o checklist do
+// tipo do veículo da viagem. Se houver itens reprovados e o modelo pedir, abre
+// uma ordem de manutenção corretiva para o veículo, reaproveitando a aberta por
+// inspeção anterior da mesma viagem que ainda não foi concluída.
+func (uc *ChecklistUseCase) RegistrarInspecao(ctx context.Context, viagemID uuid.UUID,
+	respostas []domain.RespostaItemChecklist, observacoes string) (*domain.InspecaoVeiculo, error) {
+	viagem, err := uc.viagemRepo.GetByID(ctx, viagemID)
+	if err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	if viagem.Status != domain.StatusAgendada {
+		return nil, domain.ErrViagemNaoInspecionavel
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
+	if err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	modelo, err := uc.BuscarModelo(ctx, veiculo.Tipo)
+	if err != nil {
+		return nil, err
+	}
+
+	inspecao, err := domain.NewInspecaoVeiculo(viagem, modelo, respostas)
+	if err != nil {
+		return nil, err
+	}
+	inspecao.Observacoes = observacoes
+	inspecao.RealizadaPor = atorDoContexto(ctx)
+
+	abrirManutencao := modelo.AbrirManutencao && len(inspecao.Reprovados()) > 0
+	if abrirManutencao {
+		inspecao.OrdemManutencaoID, err = uc.ordemEmAberto(ctx, viagem)
+		if err != nil {
+			return nil, err
+		}
+		abrirManutencao = inspecao.OrdemManutencaoID == nil
+	}
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if abrirManutencao {
+			ordem := domain.NewOrdemManutencao(veiculo.ID, domain.ManutencaoCorretiva,
+				inspecao.DescricaoManutencao(), inspecao.CreatedAt)
+			if err := uc.manutencaoUseCase.AbrirOrdem(ctx, ordem); err != nil {
+				return err
+			}
+			inspecao.OrdemManutencaoID = &ordem.ID
+		}
+		return uc.inspecaoRepo.Create(ctx, inspecao)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inspecao, nil
+}
+
+// ListarInspecoes retorna as inspeções da viagem
+func (uc *ChecklistUseCase) ListarInspecoes(ctx context.Context, viagemID uuid.UUID) ([]*domain.InspecaoVeiculo, error) {
+	if _, err := uc.viagemRepo.GetByID(ctx, viagemID); err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+	return uc.inspecaoRepo.GetByViagem(ctx, viagemID)
+}
+
+// ordemEmAberto retorna a ordem de manutenção aberta por inspeção anterior do
+// mesmo veículo na viagem, se ainda estiver agendada ou em andamento
+func (uc *ChecklistUseCase) ordemEmAberto(ctx context.Context, viagem *domain.Viagem) (*uuid.UUID, error) {
+	inspecoes, err := uc.inspecaoRepo.GetByViagem(ctx, viagem.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, anterior := range inspecoes {
+		if anterior.VeiculoID != viagem.VeiculoID || anterior.OrdemManutencaoID == nil {
+			continue
+		}
+		ordem, err := uc.manutencaoUseCase.BuscarOrdem(ctx, *anterior.OrdemManutencaoID)
+		if err != nil {
+			continue
+		}
+		if ordem.Status == domain.StatusOrdemAgendada || ordem.Status == domain.StatusOrdemEmAndamento {
+			return &ordem.ID, nil
+		}
+	}
+	return nil, nil
+}
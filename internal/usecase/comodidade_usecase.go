@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrComodidadeNaoEncontrada = errors.New("comodidade não encontrada")
+	ErrComodidadeJaCadastrada  = errors.New("já existe comodidade com este código")
+	ErrComodidadeEmUso         = errors.New("comodidade oferecida por veículos ou exigida em viagens")
+)
+
+// ComodidadeUseCase mantém o catálogo de comodidades e recursos de
+// acessibilidade dos veículos
+type ComodidadeUseCase struct {
+	comodidadeRepo repository.ComodidadeRepository
+}
+
+func NewComodidadeUseCase(comodidadeRepo repository.ComodidadeRepository) *ComodidadeUseCase {
+	return &ComodidadeUseCase{
+		comodidadeRepo: comodidadeRepo,
+	}
+}
+
+// Criar inclui uma comodidade no catálogo
+func (uc *ComodidadeUseCase) Criar(ctx context.Context, comodidade *domain.Comodidade) error {
+	if err := comodidade.Validar(); err != nil {
+		return err
+	}
+
+	existentes, err := uc.comodidadeRepo.GetByCodigos(ctx, []string{comodidade.Codigo})
+	if err != nil {
+		return err
+	}
+	if len(existentes) > 0 {
+		return ErrComodidadeJaCadastrada
+	}
+
+	return uc.comodidadeRepo.Create(ctx, comodidade)
+}
+
+// Listar retorna o catálogo completo
+func (uc *ComodidadeUseCase) Listar(ctx context.Context) ([]*domain.Comodidade, error) {
+	return uc.comodidadeRepo.List(ctx)
+}
+
+// Remover exclui a comodidade do catálogo, desde que nenhum veículo a ofereça
+// e nenhuma viagem a exija
+func (uc *ComodidadeUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	comodidade, err := uc.comodidadeRepo.GetByID(ctx, id)
+	if err != nil {
+		return ErrComodidadeNaoEncontrada
+	}
+
+	emUso, err := uc.comodidadeRepo.EmUso(ctx, comodidade.Codigo)
+	if err != nil {
+		return err
+	}
+	if emUso {
+		return ErrComodidadeEmUso
+	}
+
+	return uc.comodidadeRepo.Delete(ctx, id)
+}
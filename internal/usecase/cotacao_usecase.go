@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ValidadeCotacao é o prazo durante o qual uma cotação pode ser convertida em viagem
+const ValidadeCotacao = 7 * 24 * time.Hour
+
+var (
+	ErrCotacaoNaoEncontrada     = errors.New("cotação não encontrada")
+	ErrTabelaPrecoNaoEncontrada = errors.New("tabela de preços não encontrada")
+	ErrTabelaPrecoDuplicada     = errors.New("já existe uma tabela de preços para este cliente e tipo de veículo")
+	ErrFeriadoNaoEncontrado     = errors.New("feriado não encontrado")
+)
+
+type CotacaoUseCase struct {
+	cotacaoRepo repository.CotacaoRepository
+	tabelaRepo  repository.TabelaPrecoRepository
+	feriadoRepo repository.FeriadoRepository
+}
+
+func NewCotacaoUseCase(
+	cotacaoRepo repository.CotacaoRepository,
+	tabelaRepo repository.TabelaPrecoRepository,
+	feriadoRepo repository.FeriadoRepository,
+) *CotacaoUseCase {
+	return &CotacaoUseCase{
+		cotacaoRepo: cotacaoRepo,
+		tabelaRepo:  tabelaRepo,
+		feriadoRepo: feriadoRepo,
+	}
+}
+
+// Cotar calcula o preço da viagem com a tabela aplicável ao cliente e grava a
+// cotação com validade de ValidadeCotacao
+func (uc *CotacaoUseCase) Cotar(ctx context.Context, cotacao *domain.Cotacao) error {
+	if err := cotacao.Validar(); err != nil {
+		return err
+	}
+
+	tabela, err := uc.tabelaRepo.GetAplicavel(ctx, cotacao.ClienteID, cotacao.TipoVeiculo)
+	if err != nil {
+		return err
+	}
+	if tabela == nil {
+		return ErrTabelaPrecoNaoEncontrada
+	}
+
+	feriados, err := uc.feriadoRepo.GetByPeriodo(ctx, cotacao.DataInicio, cotacao.DataFim)
+	if err != nil {
+		return err
+	}
+
+	cotacao.Calcular(tabela, feriados)
+	cotacao.ValidaAte = time.Now().Add(ValidadeCotacao)
+
+	return uc.cotacaoRepo.Create(ctx, cotacao)
+}
+
+func (uc *CotacaoUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.Cotacao, error) {
+	cotacao, err := uc.cotacaoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrCotacaoNaoEncontrada
+	}
+	return cotacao, nil
+}
+
+func (uc *CotacaoUseCase) CriarTabela(ctx context.Context, tabela *domain.TabelaPreco) error {
+	if err := tabela.Validar(); err != nil {
+		return err
+	}
+
+	// Cada cliente tem no máximo uma tabela por tipo de veículo, e existe uma
+	// única tabela padrão por tipo
+	clienteID := uuid.Nil
+	if tabela.ClienteID != nil {
+		clienteID = *tabela.ClienteID
+	}
+	existente, err := uc.tabelaRepo.GetAplicavel(ctx, clienteID, tabela.TipoVeiculo)
+	if err != nil {
+		return err
+	}
+	if existente != nil && uuidPtrIgual(existente.ClienteID, tabela.ClienteID) {
+		return ErrTabelaPrecoDuplicada
+	}
+
+	return uc.tabelaRepo.Create(ctx, tabela)
+}
+
+func (uc *CotacaoUseCase) ListarTabelas(ctx context.Context) ([]domain.TabelaPreco, error) {
+	tabelas, err := uc.tabelaRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.TabelaPreco, len(tabelas))
+	for i, t := range tabelas {
+		result[i] = *t
+	}
+	return result, nil
+}
+
+func (uc *CotacaoUseCase) BuscarTabela(ctx context.Context, id uuid.UUID) (*domain.TabelaPreco, error) {
+	tabela, err := uc.tabelaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrTabelaPrecoNaoEncontrada
+	}
+	return tabela, nil
+}
+
+// AtualizarTabela substitui os valores de uma tabela existente. O cliente e o
+// tipo de veículo da tabela não mudam.
+func (uc *CotacaoUseCase) AtualizarTabela(ctx context.Context, tabela *domain.TabelaPreco) error {
+	existente, err := uc.tabelaRepo.GetByID(ctx, tabela.ID)
+	if err != nil {
+		return ErrTabelaPrecoNaoEncontrada
+	}
+
+	tabela.ClienteID = existente.ClienteID
+	tabela.TipoVeiculo = existente.TipoVeiculo
+	tabela.CreatedAt = existente.CreatedAt
+	tabela.UpdatedAt = time.Now()
+	if err := tabela.Validar(); err != nil {
+		return err
+	}
+
+	return uc.tabelaRepo.Update(ctx, tabela)
+}
+
+func (uc *CotacaoUseCase) RemoverTabela(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.tabelaRepo.GetByID(ctx, id); err != nil {
+		return ErrTabelaPrecoNaoEncontrada
+	}
+
+	return uc.tabelaRepo.Delete(ctx, id)
+}
+
+func (uc *CotacaoUseCase) CriarFeriado(ctx context.Context, feriado *domain.Feriado) error {
+	if err := feriado.Validar(); err != nil {
+		return err
+	}
+
+	return uc.feriadoRepo.Create(ctx, feriado)
+}
+
+func (uc *CotacaoUseCase) ListarFeriados(ctx context.Context) ([]domain.Feriado, error) {
+	feriados, err := uc.feriadoRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Feriado, len(feriados))
+	for i, f := range feriados {
+		result[i] = *f
+	}
+	return result, nil
+}
+
+func (uc *CotacaoUseCase) RemoverFeriado(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.feriadoRepo.GetByID(ctx, id); err != nil {
+		return ErrFeriadoNaoEncontrado
+	}
+
+	return uc.feriadoRepo.Delete(ctx, id)
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrCustoFixoNaoEncontrado = errors.New("custo fixo do veículo não encontrado")
+
+// CustoVeiculoUseCase mantém os custos fixos dos veículos e apura a
+// rentabilidade da frota
+type CustoVeiculoUseCase struct {
+	custoFixoRepo     repository.CustoFixoVeiculoRepository
+	veiculoRepo       repository.VeiculoRepository
+	viagemRepo        repository.ViagemRepository
+	despesaRepo       repository.DespesaViagemRepository
+	abastecimentoRepo repository.AbastecimentoRepository
+	ordemRepo         repository.OrdemManutencaoRepository
+}
+
+func NewCustoVeiculoUseCase(
+	custoFixoRepo repository.CustoFixoVeiculoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	viagemRepo repository.ViagemRepository,
+	despesaRepo repository.DespesaViagemRepository,
+	abastecimentoRepo repository.AbastecimentoRepository,
+	ordemRepo repository.OrdemManutencaoRepository,
+) *CustoVeiculoUseCase {
+	return &CustoVeiculoUseCase{
+		custoFixoRepo:     custoFixoRepo,
+		veiculoRepo:       veiculoRepo,
+		viagemRepo:        viagemRepo,
+		despesaRepo:       despesaRepo,
+		abastecimentoRepo: abastecimentoRepo,
+		ordemRepo:         ordemRepo,
+	}
+}
+
+// RegistrarCustoFixo registra um custo fixo mensal do veículo
+func (uc *CustoVeiculoUseCase) RegistrarCustoFixo(ctx context.Context, custo *domain.CustoFixoVeiculo) error {
+	if _, err := uc.veiculoRepo.GetByID(ctx, custo.VeiculoID); err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if err := custo.Validar(); err != nil {
+		return err
+	}
+
+	return uc.custoFixoRepo.Create(ctx, custo)
+}
+
+// ListarCustosFixos retorna os custos fixos do veículo
+func (uc *CustoVeiculoUseCase) ListarCustosFixos(ctx context.Context, veiculoID uuid.UUID) ([]*domain.CustoFixoVeiculo, error) {
+	if _, err := uc.veiculoRepo.GetByID(ctx, veiculoID); err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+	return uc.custoFixoRepo.GetByVeiculo(ctx, veiculoID)
+}
+
+// RemoverCustoFixo exclui o custo fixo
+func (uc *CustoVeiculoUseCase) RemoverCustoFixo(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.custoFixoRepo.GetByID(ctx, id); err != nil {
+		return ErrCustoFixoNaoEncontrado
+	}
+	return uc.custoFixoRepo.Delete(ctx, id)
+}
+
+// RelatorioRentabilidade apura receita, custos, custo por km e utilização de
+// cada veículo da frota, ou só dos veículos do tipo informado
+func (uc *CustoVeiculoUseCase) RelatorioRentabilidade(ctx context.Context, tipo domain.TipoVeiculo,
+	dataInicio, dataFim time.Time) (*domain.RelatorioRentabilidadeFrota, error) {
+	if !dataInicio.Before(dataFim) {
+		return nil, ErrDataInvalida
+	}
+
+	// Limite negativo traz a frota inteira, sem paginação
+	veiculos, err := uc.veiculoRepo.Search(ctx, domain.FiltroVeiculo{Tipo: tipo, Limit: -1})
+	if err != nil {
+		return nil, err
+	}
+
+	var movimento domain.MovimentoFrota
+	movimento.Viagens, err = uc.viagemRepo.GetEncerradasPorPeriodo(ctx, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	viagemIDs := make([]uuid.UUID, len(movimento.Viagens))
+	for i, v := range movimento.Viagens {
+		viagemIDs[i] = v.ID
+	}
+	movimento.Despesas, err = uc.despesaRepo.GetByViagens(ctx, viagemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	movimento.Abastecimentos, err = uc.abastecimentoRepo.GetByPeriodo(ctx, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	movimento.Manutencoes, err = uc.ordemRepo.GetConcluidasPorPeriodo(ctx, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	movimento.CustosFixos, err = uc.custoFixoRepo.GetVigentes(ctx, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.CalcularRentabilidadeFrota(dataInicio, dataFim, veiculos, movimento), nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/auth"
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDespesaNaoEncontrada         = errors.New("despesa não encontrada")
+	ErrDespesaViagemCancelada       = errors.New("viagem cancelada não aceita despesas")
+	ErrAvaliacaoDespesaNaoPermitida = errors.New("somente ADMIN pode aprovar, rejeitar ou reembolsar despesas")
+)
+
+// DespesaViagemUseCase registra os gastos das viagens, conduz a aprovação e o
+// reembolso aos motoristas e calcula a rentabilidade das viagens
+type DespesaViagemUseCase struct {
+	despesaRepo   repository.DespesaViagemRepository
+	viagemRepo    repository.ViagemRepository
+	motoristaRepo repository.MotoristaRepository
+	anexoRepo     repository.AnexoRepository
+}
+
+func NewDespesaViagemUseCase(
+	despesaRepo repository.DespesaViagemRepository,
+	viagemRepo repository.ViagemRepository,
+	motoristaRepo repository.MotoristaRepository,
+	anexoRepo repository.AnexoRepository,
+) *DespesaViagemUseCase {
+	return &DespesaViagemUseCase{
+		despesaRepo:   despesaRepo,
+		viagemRepo:    viagemRepo,
+		motoristaRepo: motoristaRepo,
+		anexoRepo:     anexoRepo,
+	}
+}
+
+// Registrar lança a despesa na viagem. Sem motorista informado, a despesa é
+// atribuída ao motorista principal.
+func (uc *DespesaViagemUseCase) Registrar(ctx context.Context, despesa *domain.DespesaViagem) error {
+	viagem, err := uc.viagemRepo.GetByID(ctx, despesa.ViagemID)
+	if err != nil {
+		return ErrViagemNaoEncontrada
+	}
+
+	if viagem.Status == domain.StatusCancelada {
+		return ErrDespesaViagemCancelada
+	}
+
+	if despesa.MotoristaID == uuid.Nil {
+		despesa.MotoristaID = viagem.MotoristaID
+	} else if !viagem.EscalaMotorista(despesa.MotoristaID) {
+		return domain.ErrMotoristaDespesaInvalido
+	}
+
+	if err := despesa.Validar(); err != nil {
+		return err
+	}
+
+	// O comprovante é um anexo já enviado para a viagem
+	if despesa.ComprovanteID != nil {
+		anexo, err := uc.anexoRepo.GetByID(ctx, *despesa.ComprovanteID)
+		if err != nil || anexo.Tipo != domain.AnexoComprovante ||
+			anexo.Entidade != domain.AnexoDeViagem || anexo.EntidadeID != viagem.ID {
+			return domain.ErrComprovanteInvalido
+		}
+	}
+
+	return uc.despesaRepo.Create(ctx, despesa)
+}
+
+// Listar retorna as despesas da viagem
+func (uc *DespesaViagemUseCase) Listar(ctx context.Context, viagemID uuid.UUID) ([]*domain.DespesaViagem, error) {
+	if _, err := uc.viagemRepo.GetByID(ctx, viagemID); err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	return uc.despesaRepo.GetByViagem(ctx, viagemID)
+}
+
+// Aprovar aceita a despesa pendente
+func (uc *DespesaViagemUseCase) Aprovar(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error) {
+	return uc.avaliar(ctx, id, func(despesa *domain.DespesaViagem, ator domain.Ator) error {
+		return despesa.Aprovar(ator, time.Now())
+	})
+}
+
+// Rejeitar recusa a despesa pendente com o motivo informado
+func (uc *DespesaViagemUseCase) Rejeitar(ctx context.Context, id uuid.UUID, motivo string) (*domain.DespesaViagem, error) {
+	return uc.avaliar(ctx, id, func(despesa *domain.DespesaViagem, ator domain.Ator) error {
+		return despesa.Rejeitar(ator, motivo, time.Now())
+	})
+}
+
+// Reembolsar registra a devolução ao motorista de uma despesa aprovada
+func (uc *DespesaViagemUseCase) Reembolsar(ctx context.Context, id uuid.UUID) (*domain.DespesaViagem, error) {
+	return uc.avaliar(ctx, id, func(despesa *domain.DespesaViagem, _ domain.Ator) error {
+		return despesa.Reembolsar(time.Now())
+	})
+}
+
+// ResumoReembolso totaliza as despesas pagas pelo motorista no período
+func (uc *DespesaViagemUseCase) ResumoReembolso(ctx context.Context, motoristaID uuid.UUID,
+	dataInicio, dataFim time.Time) (*domain.ResumoReembolso, error) {
+	if dataInicio.After(dataFim) {
+		return nil, ErrDataInvalida
+	}
+
+	if _, err := uc.motoristaRepo.GetByID(ctx, motoristaID); err != nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+
+	despesas, err := uc.despesaRepo.GetByMotorista(ctx, motoristaID, dataInicio, dataFim)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ResumirReembolsos(motoristaID, dataInicio, dataFim, despesas), nil
+}
+
+// Rentabilidade compara o valor da viagem com as despesas lançadas nela
+func (uc *DespesaViagemUseCase) Rentabilidade(ctx context.Context, viagemID uuid.UUID) (*domain.Rentabilidade, error) {
+	viagem, err := uc.viagemRepo.GetByID(ctx, viagemID)
+	if err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	despesas, err := uc.despesaRepo.GetByViagem(ctx, viagemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.CalcularRentabilidade(viagem, despesas), nil
+}
+
+// avaliar aplica à despesa uma etapa da aprovação, restrita a ADMIN
+func (uc *DespesaViagemUseCase) avaliar(ctx context.Context, id uuid.UUID,
+	etapa func(despesa *domain.DespesaViagem, ator domain.Ator) error) (*domain.DespesaViagem, error) {
+	ator := atorDoContexto(ctx)
+	if ator.Perfil != auth.ProfileAdmin {
+		return nil, ErrAvaliacaoDespesaNaoPermitida
+	}
+
+	despesa, err := uc.despesaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrDespesaNaoEncontrada
+	}
+
+	statusAnterior := despesa.Status
+	if err := etapa(despesa, ator); err != nil {
+		return nil, err
+	}
+
+	if err := uc.despesaRepo.Avaliar(ctx, despesa, statusAnterior); err != nil {
+		return nil, err
+	}
+	return despesa, nil
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDocumentoVeiculoNaoEncontrado = errors.New("documento do veículo não encontrado")
+)
+
+// DocumentoVeiculoUseCase mantém os documentos dos veículos e a situação
+// geral da documentação derivada deles
+type DocumentoVeiculoUseCase struct {
+	documentoRepo repository.DocumentoVeiculoRepository
+	veiculoRepo   repository.VeiculoRepository
+	txManager     repository.TransactionManager
+}
+
+func NewDocumentoVeiculoUseCase(
+	documentoRepo repository.DocumentoVeiculoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	txManager repository.TransactionManager,
+) *DocumentoVeiculoUseCase {
+	return &DocumentoVeiculoUseCase{
+		documentoRepo: documentoRepo,
+		veiculoRepo:   veiculoRepo,
+		txManager:     txManager,
+	}
+}
+
+// Registrar cadastra um documento do veículo. Documentos renovados são
+// registrados como novos; em cada tipo vale o de maior validade.
+func (uc *DocumentoVeiculoUseCase) Registrar(ctx context.Context, documento *domain.DocumentoVeiculo) error {
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, documento.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if err := documento.Validar(); err != nil {
+		return err
+	}
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.documentoRepo.Create(ctx, documento); err != nil {
+			return err
+		}
+		return uc.atualizarSituacao(ctx, veiculo)
+	})
+}
+
+// Listar retorna os documentos do veículo
+func (uc *DocumentoVeiculoUseCase) Listar(ctx context.Context, veiculoID uuid.UUID) ([]*domain.DocumentoVeiculo, error) {
+	if _, err := uc.veiculoRepo.GetByID(ctx, veiculoID); err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	return uc.documentoRepo.GetByVeiculo(ctx, veiculoID)
+}
+
+func (uc *DocumentoVeiculoUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.DocumentoVeiculo, error) {
+	documento, err := uc.documentoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrDocumentoVeiculoNaoEncontrado
+	}
+	return documento, nil
+}
+
+// Atualizar corrige os dados de um documento, mantendo o veículo e o tipo
+func (uc *DocumentoVeiculoUseCase) Atualizar(ctx context.Context, documento *domain.DocumentoVeiculo) error {
+	atual, err := uc.documentoRepo.GetByID(ctx, documento.ID)
+	if err != nil {
+		return ErrDocumentoVeiculoNaoEncontrado
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, atual.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	documento.VeiculoID = atual.VeiculoID
+	documento.Tipo = atual.Tipo
+	documento.CreatedAt = atual.CreatedAt
+	documento.UpdatedAt = time.Now()
+	if err := documento.Validar(); err != nil {
+		return err
+	}
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.documentoRepo.Update(ctx, documento); err != nil {
+			return err
+		}
+		return uc.atualizarSituacao(ctx, veiculo)
+	})
+}
+
+// Remover exclui um documento cadastrado por engano
+func (uc *DocumentoVeiculoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	documento, err := uc.documentoRepo.GetByID(ctx, id)
+	if err != nil {
+		return ErrDocumentoVeiculoNaoEncontrado
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, documento.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.documentoRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.atualizarSituacao(ctx, veiculo)
+	})
+}
+
+// ListarVeiculosDocumentacaoVencida retorna os veículos a que falta algum
+// documento obrigatório dentro da validade
+func (uc *DocumentoVeiculoUseCase) ListarVeiculosDocumentacaoVencida(ctx context.Context) ([]*domain.Veiculo, error) {
+	return uc.veiculoRepo.GetVeiculosDocumentacaoVencida(ctx)
+}
+
+// atualizarSituacao recalcula a situação da documentação do veículo a partir
+// dos documentos gravados. Deve ser chamado dentro da transação da alteração.
+func (uc *DocumentoVeiculoUseCase) atualizarSituacao(ctx context.Context, veiculo *domain.Veiculo) error {
+	documentos, err := uc.documentoRepo.GetByVeiculo(ctx, veiculo.ID)
+	if err != nil {
+		return err
+	}
+
+	veiculo.AtualizarDocumentacao(domain.SituacaoDocumentacao(documentos, time.Now()))
+	return uc.veiculoRepo.Update(ctx, veiculo)
+}
@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// LimiteListagemGrupos é o tamanho máximo de uma página de grupos
+const LimiteListagemGrupos = 100
+
+var (
+	ErrGrupoNaoEncontrado           = errors.New("grupo de viagem não encontrado")
+	ErrMotoristasInsuficientesGrupo = errors.New("não há motoristas disponíveis suficientes para o grupo")
+	ErrGrupoCancelado               = errors.New("grupo de viagem já cancelado")
+)
+
+type GrupoViagemUseCase struct {
+	grupoRepo     repository.GrupoViagemRepository
+	viagemRepo    repository.ViagemRepository
+	veiculoRepo   repository.VeiculoRepository
+	motoristaRepo repository.MotoristaRepository
+	politicaRepo  repository.PoliticaCancelamentoRepository
+	eventoRepo    repository.EventoViagemRepository
+	txManager     repository.TransactionManager
+	viagemUseCase *ViagemUseCase
+}
+
+func NewGrupoViagemUseCase(
+	grupoRepo repository.GrupoViagemRepository,
+	viagemRepo repository.ViagemRepository,
+	veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository,
+	politicaRepo repository.PoliticaCancelamentoRepository,
+	eventoRepo repository.EventoViagemRepository,
+	txManager repository.TransactionManager,
+	viagemUseCase *ViagemUseCase,
+) *GrupoViagemUseCase {
+	return &GrupoViagemUseCase{
+		grupoRepo:     grupoRepo,
+		viagemRepo:    viagemRepo,
+		veiculoRepo:   veiculoRepo,
+		motoristaRepo: motoristaRepo,
+		politicaRepo:  politicaRepo,
+		eventoRepo:    eventoRepo,
+		txManager:     txManager,
+		viagemUseCase: viagemUseCase,
+	}
+}
+
+// Criar reserva os pares veículo/motorista necessários para o grupo e cria
+// uma viagem para cada par. Todas as viagens são gravadas na mesma transação.
+func (uc *GrupoViagemUseCase) Criar(ctx context.Context, grupo *domain.GrupoViagem) error {
+	if err := grupo.Validar(); err != nil {
+		return err
+	}
+
+	grupo.ComodidadesExigidas = domain.NormalizarComodidades(grupo.ComodidadesExigidas)
+	veiculos, err := uc.veiculoRepo.GetDisponiveis(ctx, grupo.DataInicio, grupo.DataFim, grupo.ComodidadesExigidas)
+	if err != nil {
+		return err
+	}
+
+	selecionados, err := domain.SelecionarVeiculosGrupo(veiculos, grupo.QuantidadePassageiros)
+	if err != nil {
+		return err
+	}
+
+	motoristas, err := uc.motoristaRepo.GetDisponiveis(ctx, grupo.DataInicio, grupo.DataFim, "")
+	if err != nil {
+		return err
+	}
+
+	pares, err := parearMotoristas(selecionados, motoristas)
+	if err != nil {
+		return err
+	}
+
+	distribuicao := domain.DistribuirPassageiros(selecionados, grupo.QuantidadePassageiros)
+	valores := domain.RatearValor(grupo.Valor, distribuicao)
+
+	grupo.Status = domain.StatusAgendada
+	grupo.Viagens = make([]*domain.Viagem, len(selecionados))
+	for i, veiculo := range selecionados {
+		viagem := domain.NewViagem(veiculo.ID, pares[i].ID, grupo.ClienteID,
+			grupo.Origem, grupo.Destino, grupo.DataInicio, grupo.DataFim, valores[i])
+		viagem.GrupoID = &grupo.ID
+		viagem.QuantidadePassageiros = distribuicao[i]
+		viagem.Observacoes = grupo.Observacoes
+		viagem.ComodidadesExigidas = grupo.ComodidadesExigidas
+		grupo.Viagens[i] = viagem
+	}
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bloquearRecursos(ctx, grupo); err != nil {
+			return err
+		}
+		if err := uc.grupoRepo.Create(ctx, grupo); err != nil {
+			return err
+		}
+
+		for _, viagem := range grupo.Viagens {
+			// Aplica as regras de agendamento dentro da transação, o que também
+			// evita que outra reserva tenha ocupado o veículo ou o motorista
+			// desde a consulta inicial
+			if _, err := uc.viagemUseCase.verificarAgendamento(ctx, viagem); err != nil {
+				return err
+			}
+
+			if err := uc.viagemRepo.Create(ctx, viagem); err != nil {
+				return err
+			}
+
+			evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemCriada, atorDoContexto(ctx))
+			evento.Descricao = fmt.Sprintf("Reserva do grupo %s", grupo.ID)
+			if err := uc.eventoRepo.Create(ctx, evento); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Listar retorna uma página de grupos, dos mais recentes para os mais antigos
+func (uc *GrupoViagemUseCase) Listar(ctx context.Context, offset, limit int) ([]domain.GrupoViagem, error) {
+	if limit <= 0 || limit > LimiteListagemGrupos {
+		limit = LimiteListagemGrupos
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	grupos, err := uc.grupoRepo.List(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.GrupoViagem, len(grupos))
+	for i, g := range grupos {
+		result[i] = *g
+	}
+	return result, nil
+}
+
+func (uc *GrupoViagemUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.GrupoViagem, error) {
+	grupo, err := uc.grupoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrGrupoNaoEncontrado
+	}
+	return grupo, nil
+}
+
+// Reagendar move todas as viagens ativas do grupo para o novo período,
+// mantendo os mesmos veículos e motoristas. Falha sem alterar nada se alguma
+// viagem já tiver começado ou deixar de cumprir as regras de agendamento no
+// novo período.
+func (uc *GrupoViagemUseCase) Reagendar(ctx context.Context, id uuid.UUID, dataInicio, dataFim time.Time) (*domain.GrupoViagem, error) {
+	grupo, err := uc.grupoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrGrupoNaoEncontrado
+	}
+
+	if grupo.Status == domain.StatusCancelada {
+		return nil, ErrGrupoCancelado
+	}
+
+	if dataInicio.After(dataFim) {
+		return nil, ErrDataInvalida
+	}
+
+	// Guarda o período anterior de cada viagem para o histórico
+	anteriores := make(map[uuid.UUID]domain.Viagem, len(grupo.Viagens))
+	for _, viagem := range grupo.Viagens {
+		anteriores[viagem.ID] = *viagem
+	}
+
+	if err := grupo.Reagendar(dataInicio, dataFim); err != nil {
+		return nil, err
+	}
+
+	ator := atorDoContexto(ctx)
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bloquearRecursos(ctx, grupo); err != nil {
+			return err
+		}
+		if err := uc.grupoRepo.Update(ctx, grupo); err != nil {
+			return err
+		}
+		for _, viagem := range grupo.Viagens {
+			if viagem.Status != domain.StatusCancelada {
+				if _, err := uc.viagemUseCase.verificarAgendamento(ctx, viagem); err != nil {
+					return err
+				}
+			}
+			if err := uc.viagemRepo.Update(ctx, viagem); err != nil {
+				return err
+			}
+			anterior := anteriores[viagem.ID]
+			if err := uc.eventoRepo.Create(ctx, domain.EventosAlteracaoViagem(&anterior, viagem, ator)...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grupo, nil
+}
+
+// Cancelar cancela o grupo e todas as suas viagens ainda não concluídas,
+// aplicando a política de cancelamento do cliente a cada viagem. Falha sem
+// alterar nada se alguma viagem do grupo já estiver em andamento.
+func (uc *GrupoViagemUseCase) Cancelar(ctx context.Context, id uuid.UUID, motivo string) (*domain.GrupoViagem, error) {
+	grupo, err := uc.grupoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrGrupoNaoEncontrado
+	}
+
+	if grupo.Status == domain.StatusCancelada {
+		return nil, ErrGrupoCancelado
+	}
+
+	if motivo == "" {
+		return nil, domain.ErrMotivoCancelamentoObrigatorio
+	}
+
+	politica, gravada, err := resolverPoliticaCancelamento(ctx, uc.politicaRepo, grupo.ClienteID)
+	if err != nil {
+		return nil, err
+	}
+
+	agora := time.Now()
+	ator := atorDoContexto(ctx)
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, viagem := range grupo.Viagens {
+			if viagem.Status == domain.StatusCancelada || viagem.Status == domain.StatusConcluida {
+				continue
+			}
+			statusAnterior := viagem.Status
+			if err := viagem.Cancelar(motivo, politica, agora); err != nil {
+				return err
+			}
+			if gravada {
+				viagem.PoliticaCancelamentoID = &politica.ID
+			}
+			if err := uc.viagemRepo.Update(ctx, viagem); err != nil {
+				return err
+			}
+			if err := uc.eventoRepo.Create(ctx, eventoCancelamento(viagem, statusAnterior, ator)); err != nil {
+				return err
+			}
+		}
+
+		grupo.Status = domain.StatusCancelada
+		grupo.UpdatedAt = agora
+		return uc.grupoRepo.Update(ctx, grupo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grupo, nil
+}
+
+// bloquearRecursos trava de uma vez os veículos e motoristas de todas as
+// viagens ativas do grupo, na mesma ordem usada pelos demais agendamentos
+func (uc *GrupoViagemUseCase) bloquearRecursos(ctx context.Context, grupo *domain.GrupoViagem) error {
+	var veiculoIDs, motoristaIDs []uuid.UUID
+	for _, viagem := range grupo.Viagens {
+		if viagem.Status == domain.StatusCancelada {
+			continue
+		}
+		veiculoIDs = append(veiculoIDs, viagem.VeiculoID)
+		motoristaIDs = append(motoristaIDs, viagem.Motoristas()...)
+	}
+	return bloquearRecursos(ctx, uc.veiculoRepo, uc.motoristaRepo, veiculoIDs, motoristaIDs)
+}
+
+// parearMotoristas atribui a cada veículo um motorista distinto habilitado a
+// conduzi-lo. Os veículos que exigem categorias mais altas são atendidos
+// primeiro e, para cada um, usa-se o motorista de menor categoria compatível.
+func parearMotoristas(veiculos []*domain.Veiculo, motoristas []*domain.Motorista) ([]*domain.Motorista, error) {
+	ordem := make([]int, len(veiculos))
+	for i := range ordem {
+		ordem[i] = i
+	}
+	sort.SliceStable(ordem, func(a, b int) bool {
+		va, vb := veiculos[ordem[a]], veiculos[ordem[b]]
+		return domain.CategoriaMinimaCNH(va.Tipo, va.Capacidade) > domain.CategoriaMinimaCNH(vb.Tipo, vb.Capacidade)
+	})
+
+	candidatos := make([]*domain.Motorista, len(motoristas))
+	copy(candidatos, motoristas)
+	sort.SliceStable(candidatos, func(a, b int) bool {
+		return candidatos[a].TipoCNH < candidatos[b].TipoCNH
+	})
+
+	pares := make([]*domain.Motorista, len(veiculos))
+	usados := make(map[uuid.UUID]bool)
+	for _, i := range ordem {
+		for _, m := range candidatos {
+			if usados[m.ID] || m.PodeConduzir(veiculos[i]) != nil {
+				continue
+			}
+			pares[i] = m
+			usados[m.ID] = true
+			break
+		}
+		if pares[i] == nil {
+			return nil, ErrMotoristasInsuficientesGrupo
+		}
+	}
+
+	return pares, nil
+}
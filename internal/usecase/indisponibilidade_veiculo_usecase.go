@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIndisponibilidadeNaoEncontrada  = errors.New("indisponibilidade do veículo não encontrada")
+	ErrIndisponibilidadeConflitaViagem = errors.New("veículo possui viagem agendada no período; reagende a viagem antes")
+)
+
+// IndisponibilidadeVeiculoUseCase programa os períodos em que o veículo não
+// pode ser reservado
+type IndisponibilidadeVeiculoUseCase struct {
+	indisponibilidadeRepo repository.IndisponibilidadeVeiculoRepository
+	veiculoRepo           repository.VeiculoRepository
+	viagemRepo            repository.ViagemRepository
+}
+
+func NewIndisponibilidadeVeiculoUseCase(
+	indisponibilidadeRepo repository.IndisponibilidadeVeiculoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	viagemRepo repository.ViagemRepository,
+) *IndisponibilidadeVeiculoUseCase {
+	return &IndisponibilidadeVeiculoUseCase{
+		indisponibilidadeRepo: indisponibilidadeRepo,
+		veiculoRepo:           veiculoRepo,
+		viagemRepo:            viagemRepo,
+	}
+}
+
+// Programar registra uma indisponibilidade do veículo. O período não pode
+// coincidir com viagens já agendadas para o veículo.
+func (uc *IndisponibilidadeVeiculoUseCase) Programar(ctx context.Context, indisponibilidade *domain.IndisponibilidadeVeiculo) error {
+	if _, err := uc.veiculoRepo.GetByID(ctx, indisponibilidade.VeiculoID); err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if err := indisponibilidade.Validar(); err != nil {
+		return err
+	}
+
+	viagens, err := uc.viagemRepo.GetAtivasPorPeriodo(ctx, indisponibilidade.DataInicio, indisponibilidade.DataFim)
+	if err != nil {
+		return err
+	}
+	for _, v := range viagens {
+		if v.VeiculoID == indisponibilidade.VeiculoID {
+			return ErrIndisponibilidadeConflitaViagem
+		}
+	}
+
+	ator := atorDoContexto(ctx)
+	indisponibilidade.ResponsavelID = ator.ID
+	indisponibilidade.ResponsavelNome = ator.Nome
+
+	return uc.indisponibilidadeRepo.Create(ctx, indisponibilidade)
+}
+
+// Listar retorna as indisponibilidades do veículo que ocupam o período
+func (uc *IndisponibilidadeVeiculoUseCase) Listar(ctx context.Context, veiculoID uuid.UUID,
+	dataInicio, dataFim time.Time) ([]*domain.IndisponibilidadeVeiculo, error) {
+	if _, err := uc.veiculoRepo.GetByID(ctx, veiculoID); err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	return uc.indisponibilidadeRepo.GetByVeiculo(ctx, veiculoID, dataInicio, dataFim)
+}
+
+// Remover cancela uma indisponibilidade, liberando o veículo no período
+func (uc *IndisponibilidadeVeiculoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.indisponibilidadeRepo.GetByID(ctx, id); err != nil {
+		return ErrIndisponibilidadeNaoEncontrada
+	}
+
+	return uc.indisponibilidadeRepo.Delete(ctx, id)
+}
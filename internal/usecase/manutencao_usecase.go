@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrdemManutencaoNaoEncontrada = errors.New("ordem de manutenção não encontrada")
+	ErrPlanoManutencaoNaoEncontrado = errors.New("plano de manutenção não encontrado")
+)
+
+// ManutencaoUseCase conduz as ordens de manutenção dos veículos e mantém o
+// próximo vencimento de cada veículo de acordo com seus planos
+type ManutencaoUseCase struct {
+	ordemRepo   repository.OrdemManutencaoRepository
+	planoRepo   repository.PlanoManutencaoRepository
+	veiculoRepo repository.VeiculoRepository
+	txManager   repository.TransactionManager
+}
+
+func NewManutencaoUseCase(
+	ordemRepo repository.OrdemManutencaoRepository,
+	planoRepo repository.PlanoManutencaoRepository,
+	veiculoRepo repository.VeiculoRepository,
+	txManager repository.TransactionManager,
+) *ManutencaoUseCase {
+	return &ManutencaoUseCase{
+		ordemRepo:   ordemRepo,
+		planoRepo:   planoRepo,
+		veiculoRepo: veiculoRepo,
+		txManager:   txManager,
+	}
+}
+
+// AbrirOrdem agenda uma ordem de manutenção para o veículo. Sem data
+// informada, a ordem é agendada para o momento da abertura.
+func (uc *ManutencaoUseCase) AbrirOrdem(ctx context.Context, ordem *domain.OrdemManutencao) error {
+	if _, err := uc.veiculoRepo.GetByID(ctx, ordem.VeiculoID); err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if ordem.PlanoID != nil {
+		plano, err := uc.planoRepo.GetByID(ctx, *ordem.PlanoID)
+		if err != nil {
+			return ErrPlanoManutencaoNaoEncontrado
+		}
+		if plano.VeiculoID != ordem.VeiculoID {
+			return domain.ErrPlanoOutroVeiculo
+		}
+	}
+
+	if ordem.AgendadaPara.IsZero() {
+		ordem.AgendadaPara = time.Now()
+	}
+
+	if err := ordem.Validar(); err != nil {
+		return err
+	}
+
+	return uc.ordemRepo.Create(ctx, ordem)
+}
+
+func (uc *ManutencaoUseCase) BuscarOrdem(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error) {
+	ordem, err := uc.ordemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrOrdemManutencaoNaoEncontrada
+	}
+	return ordem, nil
+}
+
+// Historico retorna as ordens de manutenção do veículo
+func (uc *ManutencaoUseCase) Historico(ctx context.Context, veiculoID uuid.UUID) ([]*domain.OrdemManutencao, error) {
+	if _, err := uc.veiculoRepo.GetByID(ctx, veiculoID); err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	return uc.ordemRepo.GetByVeiculo(ctx, veiculoID)
+}
+
+// IniciarOrdem coloca a ordem em andamento e o veículo em manutenção
+func (uc *ManutencaoUseCase) IniciarOrdem(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error) {
+	ordem, veiculo, err := uc.carregarOrdem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ordem.Iniciar(veiculo, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.ordemRepo.Update(ctx, ordem); err != nil {
+			return err
+		}
+		return uc.veiculoRepo.Update(ctx, veiculo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ordem, nil
+}
+
+// ConcluirOrdem encerra a ordem com os custos e o odômetro do serviço. O
+// plano atendido recomeça a contagem e o veículo volta a ficar disponível
+// quando não resta outra ordem em andamento.
+func (uc *ManutencaoUseCase) ConcluirOrdem(ctx context.Context, id uuid.UUID, odometro int,
+	custoPecas, custoMaoDeObra float64) (*domain.OrdemManutencao, error) {
+	ordem, veiculo, err := uc.carregarOrdem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	agora := time.Now()
+	if err := ordem.Concluir(veiculo, odometro, custoPecas, custoMaoDeObra, agora); err != nil {
+		return nil, err
+	}
+
+	planos, err := uc.planoRepo.GetByVeiculo(ctx, veiculo.ID)
+	if err != nil {
+		return nil, err
+	}
+	var atendido *domain.PlanoManutencao
+	for _, p := range planos {
+		if ordem.PlanoID != nil && p.ID == *ordem.PlanoID {
+			p.RegistrarExecucao(agora, ordem.Odometro)
+			atendido = p
+		}
+	}
+	proximaData, proximoOdometro := domain.ProximaManutencao(agora, planos)
+	veiculo.RegistrarManutencao(agora, proximaData, proximoOdometro)
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.ordemRepo.Update(ctx, ordem); err != nil {
+			return err
+		}
+		if atendido != nil {
+			if err := uc.planoRepo.Update(ctx, atendido); err != nil {
+				return err
+			}
+		}
+		return uc.liberarVeiculo(ctx, veiculo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ordem, nil
+}
+
+// CancelarOrdem cancela a ordem e, se ela estava em andamento, libera o veículo
+func (uc *ManutencaoUseCase) CancelarOrdem(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, error) {
+	ordem, veiculo, err := uc.carregarOrdem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ordem.Cancelar(time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.ordemRepo.Update(ctx, ordem); err != nil {
+			return err
+		}
+		return uc.liberarVeiculo(ctx, veiculo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ordem, nil
+}
+
+// CriarPlano cadastra um plano de manutenção, contado a partir da situação
+// atual do veículo, e recalcula o próximo vencimento do veículo
+func (uc *ManutencaoUseCase) CriarPlano(ctx context.Context, veiculoID uuid.UUID, descricao string,
+	intervaloMeses, intervaloKm int) (*domain.PlanoManutencao, *domain.Veiculo, error) {
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, veiculoID)
+	if err != nil {
+		return nil, nil, ErrVeiculoNaoEncontrado
+	}
+
+	plano := domain.NewPlanoManutencao(veiculo, descricao, intervaloMeses, intervaloKm)
+	if err := plano.Validar(); err != nil {
+		return nil, nil, err
+	}
+
+	planos, err := uc.planoRepo.GetByVeiculo(ctx, veiculo.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	veiculo.AgendarManutencao(domain.ProximaManutencao(veiculo.UltimaManutencao, append(planos, plano)))
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.planoRepo.Create(ctx, plano); err != nil {
+			return err
+		}
+		return uc.veiculoRepo.Update(ctx, veiculo)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return plano, veiculo, nil
+}
+
+// ListarPlanos retorna os planos de manutenção do veículo
+func (uc *ManutencaoUseCase) ListarPlanos(ctx context.Context, veiculoID uuid.UUID) ([]*domain.PlanoManutencao, *domain.Veiculo, error) {
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, veiculoID)
+	if err != nil {
+		return nil, nil, ErrVeiculoNaoEncontrado
+	}
+
+	planos, err := uc.planoRepo.GetByVeiculo(ctx, veiculoID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return planos, veiculo, nil
+}
+
+// RemoverPlano exclui o plano e recalcula o próximo vencimento do veículo
+func (uc *ManutencaoUseCase) RemoverPlano(ctx context.Context, id uuid.UUID) error {
+	plano, err := uc.planoRepo.GetByID(ctx, id)
+	if err != nil {
+		return ErrPlanoManutencaoNaoEncontrado
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, plano.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	planos, err := uc.planoRepo.GetByVeiculo(ctx, veiculo.ID)
+	if err != nil {
+		return err
+	}
+	var restantes []*domain.PlanoManutencao
+	for _, p := range planos {
+		if p.ID != plano.ID {
+			restantes = append(restantes, p)
+		}
+	}
+	veiculo.AgendarManutencao(domain.ProximaManutencao(veiculo.UltimaManutencao, restantes))
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.planoRepo.Delete(ctx, plano.ID); err != nil {
+			return err
+		}
+		return uc.veiculoRepo.Update(ctx, veiculo)
+	})
+}
+
+func (uc *ManutencaoUseCase) carregarOrdem(ctx context.Context, id uuid.UUID) (*domain.OrdemManutencao, *domain.Veiculo, error) {
+	ordem, err := uc.ordemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, ErrOrdemManutencaoNaoEncontrada
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, ordem.VeiculoID)
+	if err != nil {
+		return nil, nil, ErrVeiculoNaoEncontrado
+	}
+	return ordem, veiculo, nil
+}
+
+// liberarVeiculo grava o veículo, devolvendo-o à operação se não restar outra
+// ordem em andamento. Deve ser chamado depois de gravar a ordem encerrada.
+func (uc *ManutencaoUseCase) liberarVeiculo(ctx context.Context, veiculo *domain.Veiculo) error {
+	emAndamento, err := uc.ordemRepo.CountEmAndamento(ctx, veiculo.ID)
+	if err != nil {
+		return err
+	}
+	if emAndamento == 0 {
+		veiculo.LiberarManutencao()
+	}
+	return uc.veiculoRepo.Update(ctx, veiculo)
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrCheckInNaoRegistrado = errors.New("viagem não possui check-in registrado")
+
+// OperacaoViagemUseCase registra a execução das viagens: o check-in do
+// motorista na saída e o check-out na chegada
+type OperacaoViagemUseCase struct {
+	viagemRepo   repository.ViagemRepository
+	veiculoRepo  repository.VeiculoRepository
+	registroRepo repository.RegistroViagemRepository
+	eventoRepo   repository.EventoViagemRepository
+	inspecaoRepo repository.InspecaoVeiculoRepository
+	txManager    repository.TransactionManager
+
+	bancoHorasUseCase *BancoHorasUseCase
+}
+
+func NewOperacaoViagemUseCase(
+	viagemRepo repository.ViagemRepository,
+	veiculoRepo repository.VeiculoRepository,
+	registroRepo repository.RegistroViagemRepository,
+	eventoRepo repository.EventoViagemRepository,
+	inspecaoRepo repository.InspecaoVeiculoRepository,
+	txManager repository.TransactionManager,
+	bancoHorasUseCase *BancoHorasUseCase,
+) *OperacaoViagemUseCase {
+	return &OperacaoViagemUseCase{
+		viagemRepo:   viagemRepo,
+		veiculoRepo:  veiculoRepo,
+		registroRepo: registroRepo,
+		eventoRepo:   eventoRepo,
+		inspecaoRepo: inspecaoRepo,
+		txManager:    txManager,
+
+		bancoHorasUseCase: bancoHorasUseCase,
+	}
+}
+
+// CheckIn inicia a viagem com as leituras de saída e atualiza o odômetro do
+// veículo. A última inspeção de saída do veículo não pode ter reprovado item
+// crítico.
+func (uc *OperacaoViagemUseCase) CheckIn(ctx context.Context, id uuid.UUID, leitura domain.LeituraViagem) (*domain.Viagem, error) {
+	viagem, err := uc.viagemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
+	if err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	registro := domain.NewRegistroViagem(viagem, domain.RegistroCheckIn, leitura)
+	if err := registro.Validar(); err != nil {
+		return nil, err
+	}
+
+	statusAnterior := viagem.Status
+	if err := viagem.Iniciar(registro); err != nil {
+		return nil, err
+	}
+
+	inspecoes, err := uc.inspecaoRepo.GetByViagem(ctx, viagem.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.VerificarInspecaoSaida(viagem, inspecoes); err != nil {
+		return nil, err
+	}
+	if err := veiculo.IniciarViagem(registro.Odometro); err != nil {
+		return nil, err
+	}
+
+	evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemIniciada, atorDoContexto(ctx),
+		domain.AlteracaoEvento{Campo: "status", Antes: string(statusAnterior), Depois: string(viagem.Status)},
+		domain.AlteracaoEvento{Campo: "inicio_real", Depois: registro.RegistradoEm.Format(time.RFC3339)},
+		domain.AlteracaoEvento{Campo: "odometro", Depois: fmt.Sprint(registro.Odometro)})
+
+	if err := uc.salvar(ctx, viagem, veiculo, nil, registro, evento); err != nil {
+		return nil, err
+	}
+	return viagem, nil
+}
+
+// CheckOut conclui a viagem com as leituras de chegada, calculando a
+// quilometragem percorrida a partir do check-in, e lança o tempo da viagem no
+// banco de horas dos motoristas, dividido entre eles no revezamento
+func (uc *OperacaoViagemUseCase) CheckOut(ctx context.Context, id uuid.UUID, leitura domain.LeituraViagem) (*domain.Viagem, error) {
+	viagem, err := uc.viagemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
+	if err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	registros, err := uc.registroRepo.GetByViagem(ctx, viagem.ID)
+	if err != nil {
+		return nil, err
+	}
+	checkIn := domain.UltimoRegistro(registros, domain.RegistroCheckIn)
+	if checkIn == nil {
+		return nil, ErrCheckInNaoRegistrado
+	}
+
+	registro := domain.NewRegistroViagem(viagem, domain.RegistroCheckOut, leitura)
+	if err := registro.Validar(); err != nil {
+		return nil, err
+	}
+
+	statusAnterior := viagem.Status
+	if err := viagem.Concluir(checkIn, registro); err != nil {
+		return nil, err
+	}
+	if err := veiculo.EncerrarViagem(registro.Odometro); err != nil {
+		return nil, err
+	}
+
+	minutos := viagem.MinutosPorMotorista()
+
+	evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemConcluida, atorDoContexto(ctx),
+		domain.AlteracaoEvento{Campo: "status", Antes: string(statusAnterior), Depois: string(viagem.Status)},
+		domain.AlteracaoEvento{Campo: "fim_real", Depois: registro.RegistradoEm.Format(time.RFC3339)},
+		domain.AlteracaoEvento{Campo: "odometro", Antes: fmt.Sprint(checkIn.Odometro), Depois: fmt.Sprint(registro.Odometro)},
+		domain.AlteracaoEvento{Campo: "km_percorridos", Depois: fmt.Sprint(viagem.KmPercorridos)},
+		domain.AlteracaoEvento{Campo: "minutos_por_motorista", Depois: fmt.Sprint(minutos)})
+
+	if err := uc.salvar(ctx, viagem, veiculo, checkIn, registro, evento); err != nil {
+		return nil, err
+	}
+	return viagem, nil
+}
+
+// Registros retorna os check-ins e check-outs da viagem
+func (uc *OperacaoViagemUseCase) Registros(ctx context.Context, id uuid.UUID) ([]*domain.RegistroViagem, error) {
+	if _, err := uc.viagemRepo.GetByID(ctx, id); err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	return uc.registroRepo.GetByViagem(ctx, id)
+}
+
+// salvar grava a viagem, o veículo e o novo registro. No check-out, checkIn é
+// o registro de saída, e as horas entre os dois vão para o banco de horas.
+func (uc *OperacaoViagemUseCase) salvar(ctx context.Context, viagem *domain.Viagem, veiculo *domain.Veiculo,
+	checkIn, registro *domain.RegistroViagem, evento *domain.EventoViagem) error {
+	// Evita gravar o veículo e os motoristas carregados junto com a viagem por
+	// cima dos atualizados
+	viagem.Veiculo = nil
+	viagem.Motorista = nil
+	viagem.MotoristaSecundario = nil
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.viagemRepo.Update(ctx, viagem); err != nil {
+			return err
+		}
+		if err := uc.veiculoRepo.Update(ctx, veiculo); err != nil {
+			return err
+		}
+		if viagem.Status == domain.StatusConcluida {
+			if err := uc.bancoHorasUseCase.LancarViagem(ctx, viagem, checkIn, registro); err != nil {
+				return err
+			}
+		}
+		if err := uc.registroRepo.Create(ctx, registro); err != nil {
+			return err
+		}
+		return uc.eventoRepo.Create(ctx, evento)
+	})
+}
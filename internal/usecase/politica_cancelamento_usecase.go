@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPoliticaNaoEncontrada = errors.New("política de cancelamento não encontrada")
+	ErrPoliticaDuplicada     = errors.New("já existe uma política de cancelamento para este cliente")
+)
+
+type PoliticaCancelamentoUseCase struct {
+	politicaRepo repository.PoliticaCancelamentoRepository
+}
+
+func NewPoliticaCancelamentoUseCase(politicaRepo repository.PoliticaCancelamentoRepository) *PoliticaCancelamentoUseCase {
+	return &PoliticaCancelamentoUseCase{
+		politicaRepo: politicaRepo,
+	}
+}
+
+func (uc *PoliticaCancelamentoUseCase) Criar(ctx context.Context, politica *domain.PoliticaCancelamento) error {
+	if err := politica.Validar(); err != nil {
+		return err
+	}
+
+	// Cada cliente tem no máximo uma política, e existe uma única política padrão
+	clienteID := uuid.Nil
+	if politica.ClienteID != nil {
+		clienteID = *politica.ClienteID
+	}
+	existente, err := uc.politicaRepo.GetAplicavel(ctx, clienteID)
+	if err != nil {
+		return err
+	}
+	if existente != nil && uuidPtrIgual(existente.ClienteID, politica.ClienteID) {
+		return ErrPoliticaDuplicada
+	}
+
+	return uc.politicaRepo.Create(ctx, politica)
+}
+
+func (uc *PoliticaCancelamentoUseCase) Listar(ctx context.Context) ([]domain.PoliticaCancelamento, error) {
+	politicas, err := uc.politicaRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.PoliticaCancelamento, len(politicas))
+	for i, p := range politicas {
+		result[i] = *p
+	}
+	return result, nil
+}
+
+func (uc *PoliticaCancelamentoUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.PoliticaCancelamento, error) {
+	politica, err := uc.politicaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrPoliticaNaoEncontrada
+	}
+	return politica, nil
+}
+
+// Atualizar altera o nome e substitui as faixas de uma política existente
+func (uc *PoliticaCancelamentoUseCase) Atualizar(ctx context.Context, id uuid.UUID, nome string,
+	faixas []domain.FaixaCancelamento) (*domain.PoliticaCancelamento, error) {
+	politica, err := uc.politicaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrPoliticaNaoEncontrada
+	}
+
+	politica.Nome = nome
+	politica.DefinirFaixas(faixas)
+	if err := politica.Validar(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.politicaRepo.Update(ctx, politica); err != nil {
+		return nil, err
+	}
+	return politica, nil
+}
+
+func (uc *PoliticaCancelamentoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.politicaRepo.GetByID(ctx, id); err != nil {
+		return ErrPoliticaNaoEncontrada
+	}
+
+	return uc.politicaRepo.Delete(ctx, id)
+}
+
+// resolverPoliticaCancelamento retorna a política aplicável ao cliente: a do
+// próprio cliente, a padrão cadastrada ou, na falta de ambas, a padrão do sistema.
+// O segundo retorno indica se a política está gravada no banco.
+func resolverPoliticaCancelamento(ctx context.Context, politicaRepo repository.PoliticaCancelamentoRepository,
+	clienteID uuid.UUID) (*domain.PoliticaCancelamento, bool, error) {
+	politica, err := politicaRepo.GetAplicavel(ctx, clienteID)
+	if err != nil {
+		return nil, false, err
+	}
+	if politica == nil {
+		return domain.PoliticaCancelamentoPadrao(), false, nil
+	}
+	return politica, true, nil
+}
+
+// uuidPtrIgual compara dois ponteiros de UUID, considerando nil igual a nil
+func uuidPtrIgual(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
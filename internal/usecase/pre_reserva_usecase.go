@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"agencia-viagens/internal/domain"
+	"agencia-viagens/internal/notificacao"
+	"agencia-viagens/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrPreReservaNaoEncontrada = errors.New("pré-reserva não encontrada")
+
+// LimiteListagemPreReservas é o tamanho máximo de uma página de pré-reservas
+const LimiteListagemPreReservas = 100
+
+type PreReservaUseCase struct {
+	preReservaRepo repository.PreReservaRepository
+	viagemRepo     repository.ViagemRepository
+	veiculoRepo    repository.VeiculoRepository
+	motoristaRepo  repository.MotoristaRepository
+	eventoRepo     repository.EventoViagemRepository
+	txManager      repository.TransactionManager
+	notificador    notificacao.Notificador
+	viagemUseCase  *ViagemUseCase
+}
+
+func NewPreReservaUseCase(
+	preReservaRepo repository.PreReservaRepository,
+	viagemRepo repository.ViagemRepository,
+	veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository,
+	eventoRepo repository.EventoViagemRepository,
+	txManager repository.TransactionManager,
+	notificador notificacao.Notificador,
+	viagemUseCase *ViagemUseCase,
+) *PreReservaUseCase {
+	return &PreReservaUseCase{
+		preReservaRepo: preReservaRepo,
+		viagemRepo:     viagemRepo,
+		veiculoRepo:    veiculoRepo,
+		motoristaRepo:  motoristaRepo,
+		eventoRepo:     eventoRepo,
+		txManager:      txManager,
+		notificador:    notificador,
+		viagemUseCase:  viagemUseCase,
+	}
+}
+
+// Criar bloqueia o veículo e o motorista até o prazo da pré-reserva
+func (uc *PreReservaUseCase) Criar(ctx context.Context, reserva *domain.PreReserva) error {
+	if err := reserva.Validar(); err != nil {
+		return err
+	}
+
+	if err := validarMotoristaVeiculo(ctx, uc.veiculoRepo, uc.motoristaRepo,
+		reserva.VeiculoID, reserva.MotoristaID, reserva.DataFim); err != nil {
+		return err
+	}
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := bloquearRecursos(ctx, uc.veiculoRepo, uc.motoristaRepo,
+			[]uuid.UUID{reserva.VeiculoID}, []uuid.UUID{reserva.MotoristaID}); err != nil {
+			return err
+		}
+		if err := uc.verificarRecursosLivres(ctx, reserva); err != nil {
+			return err
+		}
+		return uc.preReservaRepo.Create(ctx, reserva)
+	})
+}
+
+// Listar retorna uma página de pré-reservas, das que expiram por último para as primeiras
+func (uc *PreReservaUseCase) Listar(ctx context.Context, offset, limit int) ([]domain.PreReserva, error) {
+	if limit <= 0 || limit > LimiteListagemPreReservas {
+		limit = LimiteListagemPreReservas
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	reservas, err := uc.preReservaRepo.List(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.PreReserva, len(reservas))
+	for i, r := range reservas {
+		result[i] = *r
+	}
+	return result, nil
+}
+
+func (uc *PreReservaUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.PreReserva, error) {
+	reserva, err := uc.preReservaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrPreReservaNaoEncontrada
+	}
+	return reserva, nil
+}
+
+// Confirmar transforma a pré-reserva ativa em uma viagem agendada
+func (uc *PreReservaUseCase) Confirmar(ctx context.Context, id uuid.UUID) (*domain.Viagem, error) {
+	reserva, err := uc.preReservaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrPreReservaNaoEncontrada
+	}
+
+	viagem, err := reserva.Confirmar(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// A pré-reserva é encerrada antes da verificação para não bloquear a
+		// própria viagem. Se outra requisição ou a expiração automática a
+		// encerrou antes, nada é gravado.
+		if err := uc.preReservaRepo.Encerrar(ctx, reserva); err != nil {
+			return err
+		}
+
+		// A viagem confirmada passa pelas mesmas regras de qualquer viagem
+		// agendada. Sem justificativa, violações de jornada a impedem.
+		if _, err := uc.viagemUseCase.verificarAgendamento(ctx, viagem); err != nil {
+			return err
+		}
+
+		if err := uc.viagemRepo.Create(ctx, viagem); err != nil {
+			return err
+		}
+
+		evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemCriada, atorDoContexto(ctx))
+		evento.Descricao = fmt.Sprintf("Confirmação da pré-reserva %s", reserva.ID)
+		return uc.eventoRepo.Create(ctx, evento)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return viagem, nil
+}
+
+// Liberar desfaz a pré-reserva antes do prazo
+func (uc *PreReservaUseCase) Liberar(ctx context.Context, id uuid.UUID) (*domain.PreReserva, error) {
+	reserva, err := uc.preReservaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrPreReservaNaoEncontrada
+	}
+
+	if err := reserva.Liberar(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.preReservaRepo.Encerrar(ctx, reserva); err != nil {
+		return nil, err
+	}
+	return reserva, nil
+}
+
+// ExpirarVencidas marca como expiradas as pré-reservas cujo prazo passou e
+// avisa os responsáveis. Retorna quantas foram expiradas.
+func (uc *PreReservaUseCase) ExpirarVencidas(ctx context.Context) (int, error) {
+	agora := time.Now()
+	reservas, err := uc.preReservaRepo.GetVencidas(ctx, agora)
+	if err != nil {
+		return 0, err
+	}
+
+	expiradas := 0
+	for _, reserva := range reservas {
+		reserva.Expirar(agora)
+		if err := uc.preReservaRepo.Encerrar(ctx, reserva); err != nil {
+			// Confirmada ou liberada desde a consulta: não expira nem avisa
+			if errors.Is(err, domain.ErrPreReservaInativa) {
+				continue
+			}
+			return expiradas, err
+		}
+		expiradas++
+
+		// Falha no aviso não impede a liberação dos recursos
+		if err := uc.notificarExpiracao(ctx, reserva); err != nil {
+			log.Printf("Erro ao notificar expiração da pré-reserva %s: %v", reserva.ID, err)
+		}
+	}
+
+	return expiradas, nil
+}
+
+// IniciarExpiracaoAutomatica executa ExpirarVencidas a cada intervalo até o
+// contexto ser cancelado
+func (uc *PreReservaUseCase) IniciarExpiracaoAutomatica(ctx context.Context, intervalo time.Duration) {
+	ticker := time.NewTicker(intervalo)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := uc.ExpirarVencidas(ctx); err != nil {
+				log.Printf("Erro ao expirar pré-reservas: %v", err)
+			} else if n > 0 {
+				log.Printf("%d pré-reserva(s) expirada(s)", n)
+			}
+		}
+	}
+}
+
+// verificarRecursosLivres verifica se o veículo e o motorista não estão
+// ocupados por viagens ou outras pré-reservas no período
+func (uc *PreReservaUseCase) verificarRecursosLivres(ctx context.Context, reserva *domain.PreReserva) error {
+	disponivel, err := uc.viagemRepo.CheckDisponibilidade(ctx, reserva.VeiculoID, reserva.DataInicio, reserva.DataFim, uuid.Nil)
+	if err != nil {
+		return err
+	}
+	if !disponivel {
+		return ErrVeiculoIndisponivel
+	}
+
+	viagens, err := uc.viagemRepo.GetByMotorista(ctx, reserva.MotoristaID, reserva.DataInicio, reserva.DataFim)
+	if err != nil {
+		return err
+	}
+	for _, v := range viagens {
+		if v.Status != domain.StatusCancelada {
+			return ErrMotoristaIndisponivel
+		}
+	}
+
+	reservado, err := uc.preReservaRepo.CheckMotoristaReservado(ctx, reserva.MotoristaID, reserva.DataInicio, reserva.DataFim)
+	if err != nil {
+		return err
+	}
+	if reservado {
+		return ErrMotoristaIndisponivel
+	}
+	return nil
+}
+
+func (uc *PreReservaUseCase) notificarExpiracao(ctx context.Context, reserva *domain.PreReserva) error {
+	assunto := "Pré-reserva expirada"
+	mensagem := fmt.Sprintf(
+		"Olá %s,\n\nA pré-reserva %s (%s → %s, %s) expirou em %s sem confirmação. "+
+			"O veículo e o motorista foram liberados.",
+		reserva.ResponsavelNome, reserva.ID, reserva.Origem, reserva.Destino,
+		reserva.DataInicio.Format("02/01/2006 15:04"), reserva.ExpiraEm.Format("02/01/2006 15:04"))
+	return uc.notificador.Notificar(ctx, reserva.ResponsavelEmail, assunto, mensagem)
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"agencia-viagens/internal/domain"
 	"agencia-viagens/internal/repository"
@@ -13,30 +15,43 @@ import (
 var (
 	ErrVeiculoNaoEncontrado = errors.New("veículo não encontrado")
 	ErrPlacaInvalida        = errors.New("placa inválida")
+	ErrPlacaJaCadastrada    = errors.New("já existe veículo com esta placa")
 	ErrCapacidadeInvalida   = errors.New("capacidade inválida")
+
+	ErrComodidadeDesconhecida = errors.New("comodidade não cadastrada no catálogo")
 )
 
+// LimiteListagemVeiculos é o tamanho máximo da página de veículos
+const LimiteListagemVeiculos = 100
+
 type VeiculoUseCase struct {
-	veiculoRepo repository.VeiculoRepository
+	veiculoRepo    repository.VeiculoRepository
+	comodidadeRepo repository.ComodidadeRepository
 }
 
-func NewVeiculoUseCase(veiculoRepo repository.VeiculoRepository) *VeiculoUseCase {
+func NewVeiculoUseCase(veiculoRepo repository.VeiculoRepository, comodidadeRepo repository.ComodidadeRepository) *VeiculoUseCase {
 	return &VeiculoUseCase{
-		veiculoRepo: veiculoRepo,
+		veiculoRepo:    veiculoRepo,
+		comodidadeRepo: comodidadeRepo,
 	}
 }
 
 func (uc *VeiculoUseCase) Criar(ctx context.Context, veiculo *domain.Veiculo) error {
 	// Validações básicas
-	if err := uc.validarVeiculo(veiculo); err != nil {
+	if err := uc.validarVeiculo(ctx, veiculo, nil); err != nil {
 		return err
 	}
 
 	return uc.veiculoRepo.Create(ctx, veiculo)
 }
 
-func (uc *VeiculoUseCase) Listar(ctx context.Context) ([]domain.Veiculo, error) {
-	veiculos, err := uc.veiculoRepo.List(ctx, 0, 100) // TODO: Implementar paginação
+// Listar retorna a página de veículos que atende ao filtro
+func (uc *VeiculoUseCase) Listar(ctx context.Context, filtro domain.FiltroVeiculo) ([]domain.Veiculo, error) {
+	if filtro.Limit <= 0 || filtro.Limit > LimiteListagemVeiculos {
+		filtro.Limit = LimiteListagemVeiculos
+	}
+
+	veiculos, err := uc.veiculoRepo.Search(ctx, filtro)
 	if err != nil {
 		return nil, err
 	}
@@ -58,12 +73,13 @@ func (uc *VeiculoUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domai
 
 func (uc *VeiculoUseCase) Atualizar(ctx context.Context, veiculo *domain.Veiculo) error {
 	// Verifica se o veículo existe
-	if _, err := uc.veiculoRepo.GetByID(ctx, veiculo.ID); err != nil {
+	existente, err := uc.veiculoRepo.GetByID(ctx, veiculo.ID)
+	if err != nil {
 		return ErrVeiculoNaoEncontrado
 	}
 
 	// Validações básicas
-	if err := uc.validarVeiculo(veiculo); err != nil {
+	if err := uc.validarVeiculo(ctx, veiculo, existente); err != nil {
 		return err
 	}
 
@@ -79,16 +95,60 @@ func (uc *VeiculoUseCase) Remover(ctx context.Context, id uuid.UUID) error {
 	return uc.veiculoRepo.Delete(ctx, id)
 }
 
-func (uc *VeiculoUseCase) validarVeiculo(veiculo *domain.Veiculo) error {
-	// Validação da placa (formato brasileiro)
-	if len(veiculo.Placa) != 7 {
+// validarVeiculo valida o cadastro; na atualização, existente é o veículo
+// gravado, e RENAVAM e chassi só são conferidos se tiverem mudado
+func (uc *VeiculoUseCase) validarVeiculo(ctx context.Context, veiculo, existente *domain.Veiculo) error {
+	// Validação da placa (padrão antigo ou Mercosul), gravada sempre normalizada
+	veiculo.Placa = domain.NormalizarPlaca(veiculo.Placa)
+	if !domain.PlacaValida(veiculo.Placa) {
 		return ErrPlacaInvalida
 	}
 
+	// A mesma placa nos dois padrões identifica o mesmo veículo. Veículos
+	// já cadastrados em duplicidade podem ser editados sem trocar a placa.
+	if existente == nil || domain.PlacaMercosul(existente.Placa) != domain.PlacaMercosul(veiculo.Placa) {
+		outro, err := uc.veiculoRepo.GetByPlaca(ctx, veiculo.Placa)
+		if errors.Is(err, domain.ErrPlacaAmbigua) || (err == nil && outro.ID != veiculo.ID) {
+			return ErrPlacaJaCadastrada
+		}
+	}
+
 	// Validação da capacidade
 	if veiculo.Capacidade <= 0 {
 		return ErrCapacidadeInvalida
 	}
 
+	// RENAVAM e chassi com dígito verificador, conferidos com marca e ano
+	veiculo.Chassi = domain.NormalizarChassi(veiculo.Chassi)
+	veiculo.Renavam = domain.NormalizarRenavam(veiculo.Renavam)
+	if err := veiculo.Validar(); err != nil {
+		return err
+	}
+	if err := veiculo.ValidarIdentificacao(existente); err != nil {
+		return err
+	}
+
+	// Comodidades precisam existir no catálogo
+	return uc.validarComodidades(ctx, veiculo)
+}
+
+func (uc *VeiculoUseCase) validarComodidades(ctx context.Context, veiculo *domain.Veiculo) error {
+	veiculo.Comodidades = domain.NormalizarComodidades(veiculo.Comodidades)
+	if len(veiculo.Comodidades) == 0 {
+		return nil
+	}
+
+	cadastradas, err := uc.comodidadeRepo.GetByCodigos(ctx, veiculo.Comodidades)
+	if err != nil {
+		return err
+	}
+
+	codigos := make([]string, len(cadastradas))
+	for i, c := range cadastradas {
+		codigos[i] = c.Codigo
+	}
+	if desconhecidas := domain.ComodidadesFaltantes(codigos, veiculo.Comodidades); len(desconhecidas) > 0 {
+		return fmt.Errorf("%w: %s", ErrComodidadeDesconhecida, strings.Join(desconhecidas, ", "))
+	}
 	return nil
 }
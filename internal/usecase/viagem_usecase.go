@@ -3,79 +3,182 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"agencia-viagens/internal/auth"
 	"agencia-viagens/internal/domain"
 	"agencia-viagens/internal/repository"
 
 	"github.com/google/uuid"
 )
 
+// LimiteListagemViagens é o tamanho máximo de uma página de viagens
+const LimiteListagemViagens = 100
+
 var (
 	ErrViagemNaoEncontrada   = errors.New("viagem não encontrada")
 	ErrVeiculoIndisponivel   = errors.New("veículo indisponível para o período")
 	ErrMotoristaIndisponivel = errors.New("motorista indisponível para o período")
 	ErrDataInvalida          = errors.New("data inválida")
+
+	ErrLiberacaoJornadaNaoPermitida = errors.New("somente ADMIN pode liberar viagem fora das regras de jornada")
 )
 
 type ViagemUseCase struct {
-	viagemRepo    repository.ViagemRepository
-	veiculoRepo   repository.VeiculoRepository
-	motoristaRepo repository.MotoristaRepository
+	viagemRepo            repository.ViagemRepository
+	veiculoRepo           repository.VeiculoRepository
+	motoristaRepo         repository.MotoristaRepository
+	politicaRepo          repository.PoliticaCancelamentoRepository
+	cotacaoRepo           repository.CotacaoRepository
+	preReservaRepo        repository.PreReservaRepository
+	eventoRepo            repository.EventoViagemRepository
+	documentoRepo         repository.DocumentoVeiculoRepository
+	indisponibilidadeRepo repository.IndisponibilidadeVeiculoRepository
+	txManager             repository.TransactionManager
+
+	// Direção prevista acima da qual a viagem exige motorista secundário
+	limiteRevezamento time.Duration
+	// Prazo após o fim da viagem em que vencimentos geram aviso
+	antecedenciaAviso time.Duration
 }
 
 func NewViagemUseCase(
 	viagemRepo repository.ViagemRepository,
 	veiculoRepo repository.VeiculoRepository,
 	motoristaRepo repository.MotoristaRepository,
+	politicaRepo repository.PoliticaCancelamentoRepository,
+	cotacaoRepo repository.CotacaoRepository,
+	preReservaRepo repository.PreReservaRepository,
+	eventoRepo repository.EventoViagemRepository,
+	documentoRepo repository.DocumentoVeiculoRepository,
+	indisponibilidadeRepo repository.IndisponibilidadeVeiculoRepository,
+	txManager repository.TransactionManager,
+	limiteRevezamento time.Duration,
+	antecedenciaAviso time.Duration,
 ) *ViagemUseCase {
 	return &ViagemUseCase{
-		viagemRepo:    viagemRepo,
-		veiculoRepo:   veiculoRepo,
-		motoristaRepo: motoristaRepo,
+		viagemRepo:            viagemRepo,
+		veiculoRepo:           veiculoRepo,
+		motoristaRepo:         motoristaRepo,
+		politicaRepo:          politicaRepo,
+		cotacaoRepo:           cotacaoRepo,
+		preReservaRepo:        preReservaRepo,
+		eventoRepo:            eventoRepo,
+		documentoRepo:         documentoRepo,
+		indisponibilidadeRepo: indisponibilidadeRepo,
+		txManager:             txManager,
+
+		limiteRevezamento: limiteRevezamento,
+		antecedenciaAviso: antecedenciaAviso,
 	}
 }
 
 func (uc *ViagemUseCase) Criar(ctx context.Context, viagem *domain.Viagem) error {
+	// Viagem originada de uma cotação usa o trajeto, o período e o valor cotados
+	var cotacao *domain.Cotacao
+	if viagem.CotacaoID != nil {
+		var err error
+		cotacao, err = uc.aplicarCotacao(ctx, viagem)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Validações básicas
 	if viagem.DataInicio.After(viagem.DataFim) {
 		return ErrDataInvalida
 	}
 
-	// Verifica disponibilidade do veículo
-	disponivel, err := uc.viagemRepo.CheckDisponibilidade(ctx, viagem.VeiculoID, viagem.DataInicio, viagem.DataFim)
+	// Define status inicial. Execução e cancelamento só são registrados pelo
+	// check-in, check-out e cancelamento, nunca informados na criação.
+	viagem.Status = domain.StatusAgendada
+	viagem.InicioReal, viagem.FimReal, viagem.KmPercorridos = nil, nil, 0
+	viagem.MotivoCancelamento, viagem.TaxaCancelamento, viagem.ValorReembolso = "", 0, 0
+	viagem.CanceladaEm, viagem.PoliticaCancelamentoID = nil, nil
+	if viagem.ID == uuid.Nil {
+		viagem.ID = uuid.New()
+	}
+
+	ator := atorDoContexto(ctx)
+	evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemCriada, ator)
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		liberadas, err := uc.verificarAgendamento(ctx, viagem)
+		if err != nil {
+			return err
+		}
+		eventos := []*domain.EventoViagem{evento}
+		if len(liberadas) > 0 {
+			eventos = append(eventos, domain.NewEventoLiberacaoJornada(viagem.ID, ator, viagem.JustificativaJornada, liberadas))
+		}
+
+		if cotacao != nil {
+			if err := cotacao.Converter(viagem.ID, time.Now()); err != nil {
+				return err
+			}
+			if err := uc.cotacaoRepo.Converter(ctx, cotacao); err != nil {
+				return err
+			}
+			evento.Descricao = fmt.Sprintf("Originada da cotação %s", cotacao.ID)
+		}
+		if err := uc.viagemRepo.Create(ctx, viagem); err != nil {
+			return err
+		}
+		return uc.eventoRepo.Create(ctx, eventos...)
+	})
+}
+
+// aplicarCotacao copia para a viagem os dados da cotação informada, desde que
+// ela ainda esteja aberta, seja do mesmo cliente e o veículo seja do tipo cotado
+func (uc *ViagemUseCase) aplicarCotacao(ctx context.Context, viagem *domain.Viagem) (*domain.Cotacao, error) {
+	cotacao, err := uc.cotacaoRepo.GetByID(ctx, *viagem.CotacaoID)
 	if err != nil {
-		return err
+		return nil, ErrCotacaoNaoEncontrada
 	}
-	if !disponivel {
-		return ErrVeiculoIndisponivel
+
+	if cotacao.Status != domain.StatusCotacaoAberta {
+		return nil, domain.ErrCotacaoJaConvertida
+	}
+	if cotacao.Expirada(time.Now()) {
+		return nil, domain.ErrCotacaoExpirada
+	}
+	if cotacao.ClienteID != viagem.ClienteID {
+		return nil, domain.ErrCotacaoDivergente
 	}
 
-	// Verifica disponibilidade do motorista
-	viagens, err := uc.viagemRepo.GetByMotorista(ctx, viagem.MotoristaID, viagem.DataInicio, viagem.DataFim)
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
 	if err != nil {
-		return err
+		return nil, ErrVeiculoNaoEncontrado
 	}
-	if len(viagens) > 0 {
-		return ErrMotoristaIndisponivel
+	if veiculo.Tipo != cotacao.TipoVeiculo {
+		return nil, domain.ErrCotacaoDivergente
 	}
 
-	// Define status inicial
-	viagem.Status = domain.StatusAgendada
+	viagem.Origem = cotacao.Origem
+	viagem.Destino = cotacao.Destino
+	viagem.DataInicio = cotacao.DataInicio
+	viagem.DataFim = cotacao.DataFim
+	viagem.Valor = cotacao.ValorTotal
 
-	return uc.viagemRepo.Create(ctx, viagem)
+	return cotacao, nil
 }
 
-func (uc *ViagemUseCase) Listar(ctx context.Context) ([]domain.Viagem, error) {
-	viagens, err := uc.viagemRepo.List(ctx, 0, 100) // TODO: Implementar paginação
-	if err != nil {
-		return nil, err
+// Listar retorna a página de viagens que atende ao filtro e o total encontrado
+func (uc *ViagemUseCase) Listar(ctx context.Context, filtro domain.FiltroViagem) ([]*domain.Viagem, int64, error) {
+	if !filtro.DataInicio.IsZero() && !filtro.DataFim.IsZero() && filtro.DataInicio.After(filtro.DataFim) {
+		return nil, 0, ErrDataInvalida
 	}
-
-	result := make([]domain.Viagem, len(viagens))
-	for i, v := range viagens {
-		result[i] = *v
+	if filtro.Limit <= 0 || filtro.Limit > LimiteListagemViagens {
+		filtro.Limit = LimiteListagemViagens
+	}
+	if filtro.Offset < 0 {
+		filtro.Offset = 0
 	}
-	return result, nil
+
+	return uc.viagemRepo.Search(ctx, filtro)
 }
 
 func (uc *ViagemUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain.Viagem, error) {
@@ -86,47 +189,557 @@ func (uc *ViagemUseCase) BuscarPorID(ctx context.Context, id uuid.UUID) (*domain
 	return viagem, nil
 }
 
-func (uc *ViagemUseCase) Atualizar(ctx context.Context, viagem *domain.Viagem) error {
+// Atualizar grava os dados editáveis da viagem. O status não muda por aqui: a
+// viagem é cancelada, iniciada e concluída pelo cancelamento, check-in e
+// check-out, que calculam os valores de cada etapa.
+func (uc *ViagemUseCase) Atualizar(ctx context.Context, edicao *domain.Viagem) (*domain.Viagem, error) {
 	// Verifica se a viagem existe
-	existente, err := uc.viagemRepo.GetByID(ctx, viagem.ID)
+	existente, err := uc.viagemRepo.GetByID(ctx, edicao.ID)
 	if err != nil {
-		return ErrViagemNaoEncontrada
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	if edicao.Status != "" && edicao.Status != existente.Status {
+		return nil, domain.ErrStatusViagemNaoEditavel
+	}
+	viagem := existente.Editada(edicao)
+
+	periodoAlterado := !existente.DataInicio.Equal(viagem.DataInicio) || !existente.DataFim.Equal(viagem.DataFim)
+	veiculoAlterado := existente.VeiculoID != viagem.VeiculoID
+	viagem.ComodidadesExigidas = domain.NormalizarComodidades(viagem.ComodidadesExigidas)
+	comodidadesAlteradas := !slices.Equal(domain.NormalizarComodidades(existente.ComodidadesExigidas), viagem.ComodidadesExigidas)
+
+	escalaAlterada := periodoAlterado || veiculoAlterado || motoristasAlterados(existente, viagem)
+
+	// Período, veículo e motoristas só mudam antes de a viagem começar
+	if escalaAlterada && existente.Status != domain.StatusAgendada {
+		return nil, domain.ErrViagemNaoReagendavel
+	}
+
+	// O revezamento depende do período, dos motoristas e da direção prevista
+	if periodoAlterado || motoristasAlterados(existente, viagem) ||
+		existente.DirecaoPrevistaMinutos != viagem.DirecaoPrevistaMinutos {
+		if err := viagem.ValidarRevezamento(uc.limiteRevezamento); err != nil {
+			return nil, err
+		}
+	}
+
+	// Enquanto a viagem ainda não começou, verifica documentação, manutenção e
+	// CNH do veículo e dos motoristas quando a escala muda, e as comodidades
+	// quando o veículo ou as exigências mudam. Pendências não impedem a edição
+	// de outros dados da viagem.
+	if existente.Status == domain.StatusAgendada {
+		if escalaAlterada {
+			if err := uc.verificarConformidade(ctx, viagem); err != nil {
+				return nil, err
+			}
+		}
+		if veiculoAlterado || comodidadesAlteradas {
+			if err := uc.verificarComodidades(ctx, viagem); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	// Se a data foi alterada, verifica disponibilidade
-	if !existente.DataInicio.Equal(viagem.DataInicio) || !existente.DataFim.Equal(viagem.DataFim) {
-		if viagem.DataInicio.After(viagem.DataFim) {
-			return ErrDataInvalida
+	// Verifica se os motoristas podem conduzir o veículo e se a CNH vale até o
+	// fim da viagem, o que só muda com a escala
+	if escalaAlterada {
+		if err := uc.validarMotoristaVeiculo(ctx, viagem); err != nil {
+			return nil, err
 		}
+	}
+
+	if periodoAlterado && viagem.DataInicio.After(viagem.DataFim) {
+		return nil, ErrDataInvalida
+	}
 
-		// Verifica disponibilidade do veículo
-		disponivel, err := uc.viagemRepo.CheckDisponibilidade(ctx, viagem.VeiculoID, viagem.DataInicio, viagem.DataFim)
+	// Verifica disponibilidade do veículo no novo período ou do novo veículo
+	if periodoAlterado || veiculoAlterado {
+		disponivel, err := uc.viagemRepo.CheckDisponibilidade(ctx, viagem.VeiculoID, viagem.DataInicio, viagem.DataFim, viagem.ID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !disponivel {
-			return ErrVeiculoIndisponivel
+			return nil, ErrVeiculoIndisponivel
+		}
+	}
+
+	// Verifica disponibilidade dos motoristas no novo período ou dos novos motoristas
+	if periodoAlterado || motoristasAlterados(existente, viagem) {
+		if err := uc.verificarMotoristaLivre(ctx, viagem); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.salvarAlteracao(ctx, existente, viagem); err != nil {
+		return nil, err
+	}
+	return viagem, nil
+}
+
+// Reagendar move a viagem para o novo período mantendo veículo e motorista.
+// Se algum deles estiver ocupado, retorna um *domain.ConflitoReagendamento com
+// as viagens e pré-reservas conflitantes e as alternativas encontradas.
+func (uc *ViagemUseCase) Reagendar(ctx context.Context, id uuid.UUID, dataInicio, dataFim time.Time,
+	justificativaJornada string) (*domain.Viagem, error) {
+	existente, err := uc.viagemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	if existente.Status != domain.StatusAgendada {
+		return nil, domain.ErrViagemNaoReagendavel
+	}
+
+	if dataInicio.After(dataFim) {
+		return nil, ErrDataInvalida
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, existente.VeiculoID)
+	if err != nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+
+	motorista, err := uc.motoristaRepo.GetByID(ctx, existente.MotoristaID)
+	if err != nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+
+	viagem := *existente
+	viagem.DataInicio = dataInicio
+	viagem.DataFim = dataFim
+	if justificativaJornada != "" {
+		viagem.JustificativaJornada = justificativaJornada
+	}
+
+	if err := uc.verificarConformidade(ctx, &viagem); err != nil {
+		return nil, err
+	}
+
+	novo := domain.Periodo{DataInicio: dataInicio, DataFim: dataFim}
+	conflito, err := uc.buscarConflitos(ctx, existente, veiculo, motorista, novo)
+	if err != nil {
+		return nil, err
+	}
+	if conflito != nil {
+		return nil, conflito
+	}
+
+	if err := uc.salvarAlteracao(ctx, existente, &viagem); err != nil {
+		return nil, err
+	}
+	return &viagem, nil
+}
+
+// buscarConflitos verifica se o veículo e o motorista da viagem estão livres
+// no novo período. Se não estiverem, monta o conflito com o que os ocupa, os
+// recursos que podem substituí-los e os horários livres mais próximos.
+func (uc *ViagemUseCase) buscarConflitos(ctx context.Context, viagem *domain.Viagem, veiculo *domain.Veiculo,
+	motorista *domain.Motorista, novo domain.Periodo) (*domain.ConflitoReagendamento, error) {
+	inicio := novo.DataInicio.Add(-domain.HorizonteReagendamento)
+	fim := novo.DataFim.Add(domain.HorizonteReagendamento)
+
+	viagens, err := uc.viagemRepo.GetAtivasPorPeriodo(ctx, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+	reservas, err := uc.preReservaRepo.GetAtivasPorPeriodo(ctx, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+	indisponibilidades, err := uc.indisponibilidadeRepo.GetByVeiculo(ctx, viagem.VeiculoID, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+
+	conflito := &domain.ConflitoReagendamento{}
+	var ocupados []domain.Periodo
+
+	for _, v := range viagens {
+		motoristaOcupado := compartilhaMotorista(v, viagem)
+		if v.ID == viagem.ID || (v.VeiculoID != viagem.VeiculoID && !motoristaOcupado) {
+			continue
+		}
+		periodo := domain.Periodo{DataInicio: v.DataInicio, DataFim: v.DataFim}
+		ocupados = append(ocupados, periodo)
+		if periodo.Sobrepoe(novo) {
+			conflito.Viagens = append(conflito.Viagens, v)
+			conflito.VeiculoOcupado = conflito.VeiculoOcupado || v.VeiculoID == viagem.VeiculoID
+			conflito.MotoristaOcupado = conflito.MotoristaOcupado || motoristaOcupado
+		}
+	}
+
+	for _, r := range reservas {
+		if r.VeiculoID != viagem.VeiculoID && !viagem.EscalaMotorista(r.MotoristaID) {
+			continue
+		}
+		periodo := domain.Periodo{DataInicio: r.DataInicio, DataFim: r.DataFim}
+		ocupados = append(ocupados, periodo)
+		if periodo.Sobrepoe(novo) {
+			conflito.PreReservas = append(conflito.PreReservas, r)
+			conflito.VeiculoOcupado = conflito.VeiculoOcupado || r.VeiculoID == viagem.VeiculoID
+			conflito.MotoristaOcupado = conflito.MotoristaOcupado || viagem.EscalaMotorista(r.MotoristaID)
+		}
+	}
+
+	for _, i := range indisponibilidades {
+		ocupados = append(ocupados, i.Periodo())
+		if i.Periodo().Sobrepoe(novo) {
+			conflito.Indisponibilidades = append(conflito.Indisponibilidades, i)
+			conflito.VeiculoOcupado = true
+		}
+	}
+
+	if !conflito.VeiculoOcupado && !conflito.MotoristaOcupado {
+		return nil, nil
+	}
+
+	// Veículos livres que comportam os passageiros e o motorista pode conduzir
+	if conflito.VeiculoOcupado {
+		veiculos, err := uc.veiculoRepo.GetDisponiveis(ctx, novo.DataInicio, novo.DataFim, viagem.ComodidadesExigidas)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range veiculos {
+			if v.Capacidade >= viagem.QuantidadePassageiros && motorista.PodeConduzir(v) == nil {
+				conflito.VeiculosAlternativos = append(conflito.VeiculosAlternativos, v)
+			}
+		}
+	}
+
+	// Motoristas livres habilitados para o veículo
+	if conflito.MotoristaOcupado {
+		motoristas, err := uc.motoristaRepo.GetDisponiveis(ctx, novo.DataInicio, novo.DataFim,
+			domain.CategoriaMinimaCNH(veiculo.Tipo, veiculo.Capacidade))
+		if err != nil {
+			return nil, err
 		}
+		conflito.MotoristasAlternativos = motoristas
+	}
+
+	conflito.HorariosLivres = domain.HorariosLivres(novo, ocupados, time.Now(),
+		domain.HorizonteReagendamento, domain.LimiteHorariosLivres)
 
-		// Verifica disponibilidade do motorista
-		viagens, err := uc.viagemRepo.GetByMotorista(ctx, viagem.MotoristaID, viagem.DataInicio, viagem.DataFim)
+	return conflito, nil
+}
+
+// salvarAlteracao grava a viagem alterada e registra no histórico os eventos
+// correspondentes. Período ou motoristas novos exigem reavaliar a jornada.
+func (uc *ViagemUseCase) salvarAlteracao(ctx context.Context, existente, viagem *domain.Viagem) error {
+	ator := atorDoContexto(ctx)
+	eventos := domain.EventosAlteracaoViagem(existente, viagem, ator)
+
+	if !existente.DataInicio.Equal(viagem.DataInicio) || !existente.DataFim.Equal(viagem.DataFim) ||
+		motoristasAlterados(existente, viagem) {
+		liberadas, err := uc.verificarJornada(ctx, viagem)
 		if err != nil {
 			return err
 		}
-		if len(viagens) > 0 {
+		if len(liberadas) > 0 {
+			eventos = append(eventos, domain.NewEventoLiberacaoJornada(viagem.ID, ator, viagem.JustificativaJornada, liberadas))
+		}
+	}
+
+	// Evita que o veículo e os motoristas carregados com a viagem sobrescrevam
+	// os escolhidos na alteração
+	viagem.Veiculo = nil
+	viagem.Motorista = nil
+	viagem.MotoristaSecundario = nil
+
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.viagemRepo.Update(ctx, viagem); err != nil {
+			return err
+		}
+		return uc.eventoRepo.Create(ctx, eventos...)
+	})
+}
+
+// verificarAgendamento aplica as regras que toda viagem agendada deve cumprir,
+// qualquer que seja sua origem: revezamento, conformidade, comodidades,
+// habilitação dos motoristas, disponibilidade dos recursos e jornada. Deve
+// ser chamada dentro da transação que grava a viagem, pois trava o veículo e
+// os motoristas até o fim dela. Retorna as violações de jornada liberadas por
+// um ADMIN.
+func (uc *ViagemUseCase) verificarAgendamento(ctx context.Context, viagem *domain.Viagem) ([]domain.ViolacaoJornada, error) {
+	if err := bloquearRecursos(ctx, uc.veiculoRepo, uc.motoristaRepo,
+		[]uuid.UUID{viagem.VeiculoID}, viagem.Motoristas()); err != nil {
+		return nil, err
+	}
+
+	// Viagens longas exigem revezamento entre dois motoristas
+	if err := viagem.ValidarRevezamento(uc.limiteRevezamento); err != nil {
+		return nil, err
+	}
+
+	// Verifica documentação, manutenção e CNH do veículo e dos motoristas
+	if err := uc.verificarConformidade(ctx, viagem); err != nil {
+		return nil, err
+	}
+
+	// Verifica se o veículo oferece as comodidades exigidas
+	if err := uc.verificarComodidades(ctx, viagem); err != nil {
+		return nil, err
+	}
+
+	// Verifica se os motoristas podem conduzir o veículo
+	if err := uc.validarMotoristaVeiculo(ctx, viagem); err != nil {
+		return nil, err
+	}
+
+	// Verifica disponibilidade do veículo
+	disponivel, err := uc.viagemRepo.CheckDisponibilidade(ctx, viagem.VeiculoID, viagem.DataInicio, viagem.DataFim, viagem.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !disponivel {
+		return nil, ErrVeiculoIndisponivel
+	}
+
+	// Verifica disponibilidade dos motoristas
+	if err := uc.verificarMotoristaLivre(ctx, viagem); err != nil {
+		return nil, err
+	}
+
+	// Verifica tempo de direção e descanso dos motoristas
+	return uc.verificarJornada(ctx, viagem)
+}
+
+// validarMotoristaVeiculo verifica se a categoria da CNH de cada motorista é
+// compatível com o veículo e se a CNH permanece válida até o fim da viagem
+func (uc *ViagemUseCase) validarMotoristaVeiculo(ctx context.Context, viagem *domain.Viagem) error {
+	for _, motoristaID := range viagem.Motoristas() {
+		if err := validarMotoristaVeiculo(ctx, uc.veiculoRepo, uc.motoristaRepo, viagem.VeiculoID, motoristaID, viagem.DataFim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verificarComodidades confirma que o veículo oferece todas as comodidades
+// exigidas pela viagem
+func (uc *ViagemUseCase) verificarComodidades(ctx context.Context, viagem *domain.Viagem) error {
+	viagem.ComodidadesExigidas = domain.NormalizarComodidades(viagem.ComodidadesExigidas)
+	if len(viagem.ComodidadesExigidas) == 0 {
+		return nil
+	}
+
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	if faltantes := veiculo.ComodidadesFaltantes(viagem.ComodidadesExigidas); len(faltantes) > 0 {
+		return domain.NewDomainError(fmt.Sprintf("veículo não oferece as comodidades exigidas: %s",
+			strings.Join(faltantes, ", ")))
+	}
+	return nil
+}
+
+// verificarConformidade bloqueia a viagem se a documentação ou a manutenção
+// do veículo, ou a CNH ou a situação de algum motorista, a impedem. Os
+// vencimentos próximos ficam registrados nos avisos da viagem.
+func (uc *ViagemUseCase) verificarConformidade(ctx context.Context, viagem *domain.Viagem) error {
+	veiculo, err := uc.veiculoRepo.GetByID(ctx, viagem.VeiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	documentos, err := uc.documentoRepo.GetByVeiculo(ctx, veiculo.ID)
+	if err != nil {
+		return err
+	}
+
+	var motoristas []*domain.Motorista
+	for _, motoristaID := range viagem.Motoristas() {
+		motorista, err := uc.motoristaRepo.GetByID(ctx, motoristaID)
+		if err != nil {
+			return ErrMotoristaNaoEncontrado
+		}
+		motoristas = append(motoristas, motorista)
+	}
+
+	conformidade := domain.VerificarConformidade(viagem, veiculo, documentos, motoristas, uc.antecedenciaAviso)
+	if conformidade.Bloqueada() {
+		return &domain.ErroConformidade{Conformidade: conformidade}
+	}
+
+	viagem.Avisos = conformidade.Avisos
+	return nil
+}
+
+// verificarMotoristaLivre verifica se os motoristas não têm outra viagem nem
+// pré-reserva ativa no período da viagem
+func (uc *ViagemUseCase) verificarMotoristaLivre(ctx context.Context, viagem *domain.Viagem) error {
+	for _, motoristaID := range viagem.Motoristas() {
+		viagens, err := uc.viagemRepo.GetByMotorista(ctx, motoristaID, viagem.DataInicio, viagem.DataFim)
+		if err != nil {
+			return err
+		}
+		for _, v := range viagens {
+			if v.ID != viagem.ID && v.Status != domain.StatusCancelada {
+				return ErrMotoristaIndisponivel
+			}
+		}
+
+		reservado, err := uc.preReservaRepo.CheckMotoristaReservado(ctx, motoristaID, viagem.DataInicio, viagem.DataFim)
+		if err != nil {
+			return err
+		}
+		if reservado {
 			return ErrMotoristaIndisponivel
 		}
 	}
+	return nil
+}
+
+// verificarJornada aplica as regras de tempo de direção e descanso aos
+// motoristas da viagem. Havendo violações, a viagem só é aceita se um ADMIN
+// informar a justificativa; nesse caso retorna as violações liberadas.
+func (uc *ViagemUseCase) verificarJornada(ctx context.Context, viagem *domain.Viagem) ([]domain.ViolacaoJornada, error) {
+	var violacoes []domain.ViolacaoJornada
+	for _, motoristaID := range viagem.Motoristas() {
+		agenda, err := uc.viagemRepo.GetByMotorista(ctx, motoristaID,
+			viagem.DataInicio.Add(-domain.PeriodoDescansoSemanal), viagem.DataFim.Add(domain.PeriodoDescansoSemanal))
+		if err != nil {
+			return nil, err
+		}
+		violacoes = append(violacoes, domain.VerificarJornada(motoristaID, viagem, agenda)...)
+	}
+
+	if len(violacoes) == 0 {
+		return nil, nil
+	}
+
+	if strings.TrimSpace(viagem.JustificativaJornada) == "" {
+		return nil, &domain.ErroJornada{Violacoes: violacoes}
+	}
+	if atorDoContexto(ctx).Perfil != auth.ProfileAdmin {
+		return nil, ErrLiberacaoJornadaNaoPermitida
+	}
+	return violacoes, nil
+}
+
+// motoristasAlterados indica se a alteração troca algum dos motoristas da viagem
+func motoristasAlterados(antes, depois *domain.Viagem) bool {
+	return antes.MotoristaID != depois.MotoristaID || !uuidPtrIgual(antes.MotoristaSecundarioID, depois.MotoristaSecundarioID)
+}
+
+// compartilhaMotorista indica se as viagens têm algum motorista em comum
+func compartilhaMotorista(a, b *domain.Viagem) bool {
+	for _, motoristaID := range b.Motoristas() {
+		if a.EscalaMotorista(motoristaID) {
+			return true
+		}
+	}
+	return false
+}
+
+func validarMotoristaVeiculo(ctx context.Context, veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository, veiculoID, motoristaID uuid.UUID, dataFim time.Time) error {
+	veiculo, err := veiculoRepo.GetByID(ctx, veiculoID)
+	if err != nil {
+		return ErrVeiculoNaoEncontrado
+	}
+
+	motorista, err := motoristaRepo.GetByID(ctx, motoristaID)
+	if err != nil {
+		return ErrMotoristaNaoEncontrado
+	}
+
+	if err := motorista.PodeConduzir(veiculo); err != nil {
+		return err
+	}
+
+	return motorista.CNHValidaAte(dataFim)
+}
+
+// bloquearRecursos trava os veículos e os motoristas na transação do contexto,
+// para que nenhum agendamento concorrente os ocupe entre a verificação de
+// disponibilidade e a gravação. Todos os veículos são travados antes dos
+// motoristas, cada grupo em ordem de ID, o que evita deadlocks entre
+// transações que disputam os mesmos recursos.
+func bloquearRecursos(ctx context.Context, veiculoRepo repository.VeiculoRepository,
+	motoristaRepo repository.MotoristaRepository, veiculoIDs, motoristaIDs []uuid.UUID) error {
+	for _, id := range ordenarIDs(veiculoIDs) {
+		if err := veiculoRepo.Bloquear(ctx, id); err != nil {
+			return ErrVeiculoNaoEncontrado
+		}
+	}
+	for _, id := range ordenarIDs(motoristaIDs) {
+		if err := motoristaRepo.Bloquear(ctx, id); err != nil {
+			return ErrMotoristaNaoEncontrado
+		}
+	}
+	return nil
+}
 
-	return uc.viagemRepo.Update(ctx, viagem)
+// ordenarIDs retorna uma cópia ordenada dos IDs, sem repetições
+func ordenarIDs(ids []uuid.UUID) []uuid.UUID {
+	ordenados := slices.Clone(ids)
+	slices.SortFunc(ordenados, func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return slices.Compact(ordenados)
 }
 
-func (uc *ViagemUseCase) Cancelar(ctx context.Context, id uuid.UUID) error {
+// Cancelar cancela a viagem aplicando a política de cancelamento do cliente
+// para calcular a taxa retida e o valor a reembolsar
+func (uc *ViagemUseCase) Cancelar(ctx context.Context, id uuid.UUID, motivo string) (*domain.Viagem, error) {
 	viagem, err := uc.viagemRepo.GetByID(ctx, id)
 	if err != nil {
-		return ErrViagemNaoEncontrada
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	politica, gravada, err := resolverPoliticaCancelamento(ctx, uc.politicaRepo, viagem.ClienteID)
+	if err != nil {
+		return nil, err
+	}
+
+	statusAnterior := viagem.Status
+	if err := viagem.Cancelar(motivo, politica, time.Now()); err != nil {
+		return nil, err
+	}
+	if gravada {
+		viagem.PoliticaCancelamentoID = &politica.ID
+	}
+
+	err = uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.viagemRepo.Update(ctx, viagem); err != nil {
+			return err
+		}
+		return uc.eventoRepo.Create(ctx, eventoCancelamento(viagem, statusAnterior, atorDoContexto(ctx)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return viagem, nil
+}
+
+// Eventos retorna o histórico da viagem em ordem cronológica
+func (uc *ViagemUseCase) Eventos(ctx context.Context, id uuid.UUID) ([]*domain.EventoViagem, error) {
+	if _, err := uc.viagemRepo.GetByID(ctx, id); err != nil {
+		return nil, ErrViagemNaoEncontrada
+	}
+
+	return uc.eventoRepo.GetByViagem(ctx, id)
+}
+
+// atorDoContexto identifica o usuário autenticado que originou a requisição
+func atorDoContexto(ctx context.Context) domain.Ator {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return domain.Ator{}
 	}
+	return domain.Ator{ID: claims.UserID, Nome: claims.Name, Perfil: claims.Profile}
+}
 
-	viagem.Status = domain.StatusCancelada
-	return uc.viagemRepo.Update(ctx, viagem)
+// eventoCancelamento monta o evento de uma viagem recém-cancelada, com o motivo
+// e os valores calculados pela política de cancelamento
+func eventoCancelamento(viagem *domain.Viagem, statusAnterior domain.StatusViagem, ator domain.Ator) *domain.EventoViagem {
+	evento := domain.NewEventoViagem(viagem.ID, domain.EventoViagemCancelada, ator,
+		domain.AlteracaoEvento{Campo: "status", Antes: string(statusAnterior), Depois: string(viagem.Status)},
+		domain.AlteracaoEvento{Campo: "taxa_cancelamento", Depois: fmt.Sprintf("%.2f", viagem.TaxaCancelamento)},
+		domain.AlteracaoEvento{Campo: "valor_reembolso", Depois: fmt.Sprintf("%.2f", viagem.ValorReembolso)})
+	evento.Descricao = viagem.MotivoCancelamento
+	return evento
 }
@@ -3,6 +3,7 @@ package validator
 import (
 	"errors"
 	"regexp"
+	"slices"
 	"time"
 
 	"agencia-viagens/internal/domain"
@@ -25,13 +26,14 @@ var (
 	ErrValorInvalido           = errors.New("valor inválido")
 	ErrStatusViagemInvalido    = errors.New("status de viagem inválido")
 	ErrIDInvalido              = errors.New("ID inválido")
+	ErrOrdenacaoInvalida       = errors.New("ordenação inválida")
+	ErrFormatoInvalido         = errors.New("formato inválido")
 )
 
-// ValidarPlaca valida se a placa do veículo está no formato correto
+// ValidarPlaca valida se a placa do veículo está no padrão antigo (ABC1234) ou
+// Mercosul (ABC1D23), aceitando minúsculas e hífen
 func ValidarPlaca(placa string) error {
-	// Formato Mercosul: ABC1D23 ou ABC1234
-	regex := regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z0-9][0-9]{2}$`)
-	if !regex.MatchString(placa) {
+	if !domain.PlacaValida(placa) {
 		return ErrPlacaInvalida
 	}
 	return nil
@@ -278,3 +280,16 @@ func ValidarStatusViagem(status domain.StatusViagem) error {
 		return ErrStatusViagemInvalido
 	}
 }
+
+// ValidarOrdenacao valida se o campo está entre os permitidos e se a ordem é asc ou desc
+func ValidarOrdenacao(campo, ordem string, permitidos []string) error {
+	if !slices.Contains(permitidos, campo) {
+		return ErrOrdenacaoInvalida
+	}
+
+	if ordem != "asc" && ordem != "desc" {
+		return ErrOrdenacaoInvalida
+	}
+
+	return nil
+}